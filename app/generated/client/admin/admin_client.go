@@ -0,0 +1,300 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new admin API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for admin API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption is the option for Client methods
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	AnnotateResult(params *AnnotateResultParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AnnotateResultOK, error)
+
+	GetAuditLog(params *GetAuditLogParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetAuditLogOK, error)
+
+	GetMetrics(params *GetMetricsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetMetricsOK, error)
+
+	ListAdminResults(params *ListAdminResultsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListAdminResultsOK, error)
+
+	RestoreResult(params *RestoreResultParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RestoreResultOK, error)
+
+	TombstoneResult(params *TombstoneResultParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TombstoneResultOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+AnnotateResult attaches a note to a repository s results or the result of one of its commits
+
+The note is returned in the X-Scorecard-Annotation header of the results. An empty annotation removes it. Cached responses are purged.
+*/
+func (a *Client) AnnotateResult(params *AnnotateResultParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AnnotateResultOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAnnotateResultParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "annotateResult",
+		Method:             "POST",
+		PathPattern:        "/admin/projects/{platform}/{org}/{repo}/annotate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &AnnotateResultReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AnnotateResultOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*AnnotateResultDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetAuditLog lists the changes made to a repository s overrides oldest first
+*/
+func (a *Client) GetAuditLog(params *GetAuditLogParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetAuditLogOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetAuditLogParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getAuditLog",
+		Method:             "GET",
+		PathPattern:        "/admin/projects/{platform}/{org}/{repo}/audit",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetAuditLogReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetAuditLogOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetAuditLogDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetMetrics gets the instance s c d n purge queue metrics
+*/
+func (a *Client) GetMetrics(params *GetMetricsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetMetricsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetMetricsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getMetrics",
+		Method:             "GET",
+		PathPattern:        "/admin/metrics",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetMetricsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetMetricsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetMetricsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+ListAdminResults lists a repository s stored results and their overrides
+
+Lists the latest result and the per-commit results stored for the repository, from the Scorecard action and the weekly cron scan, with the overrides set on the repository and its commits.
+*/
+func (a *Client) ListAdminResults(params *ListAdminResultsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListAdminResultsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListAdminResultsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listAdminResults",
+		Method:             "GET",
+		PathPattern:        "/admin/projects/{platform}/{org}/{repo}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListAdminResultsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListAdminResultsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListAdminResultsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+RestoreResult restores tombstoned results
+
+Serves the repository's results, or the result of one of its commits, again. Any annotation is kept. Cached responses are purged.
+*/
+func (a *Client) RestoreResult(params *RestoreResultParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RestoreResultOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRestoreResultParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "restoreResult",
+		Method:             "POST",
+		PathPattern:        "/admin/projects/{platform}/{org}/{repo}/restore",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RestoreResultReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RestoreResultOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RestoreResultDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+TombstoneResult takes down a repository s results or the result of one of its commits
+
+The result requests and the badge of a tombstoned repository, or the result requests of a tombstoned commit, return 410 Gone with the reason. Cached responses are purged.
+*/
+func (a *Client) TombstoneResult(params *TombstoneResultParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TombstoneResultOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTombstoneResultParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "tombstoneResult",
+		Method:             "POST",
+		PathPattern:        "/admin/projects/{platform}/{org}/{repo}/tombstone",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &TombstoneResultReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TombstoneResultOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*TombstoneResultDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
@@ -0,0 +1,231 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// NewAnnotateResultParams creates a new AnnotateResultParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAnnotateResultParams() *AnnotateResultParams {
+	return &AnnotateResultParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAnnotateResultParamsWithTimeout creates a new AnnotateResultParams object
+// with the ability to set a timeout on a request.
+func NewAnnotateResultParamsWithTimeout(timeout time.Duration) *AnnotateResultParams {
+	return &AnnotateResultParams{
+		timeout: timeout,
+	}
+}
+
+// NewAnnotateResultParamsWithContext creates a new AnnotateResultParams object
+// with the ability to set a context for a request.
+func NewAnnotateResultParamsWithContext(ctx context.Context) *AnnotateResultParams {
+	return &AnnotateResultParams{
+		Context: ctx,
+	}
+}
+
+// NewAnnotateResultParamsWithHTTPClient creates a new AnnotateResultParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAnnotateResultParamsWithHTTPClient(client *http.Client) *AnnotateResultParams {
+	return &AnnotateResultParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+AnnotateResultParams contains all the parameters to send to the API endpoint
+
+	for the annotate result operation.
+
+	Typically these are written to a http.Request.
+*/
+type AnnotateResultParams struct {
+
+	/* Org.
+
+	   Name of the owner/organization of the repository
+	*/
+	Org string
+
+	// Override.
+	Override *models.OverrideRequest
+
+	/* Platform.
+
+	   VCS platform. eg. github.com
+	*/
+	Platform string
+
+	/* Repo.
+
+	   Name of the repository
+	*/
+	Repo string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the annotate result params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AnnotateResultParams) WithDefaults() *AnnotateResultParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the annotate result params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AnnotateResultParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the annotate result params
+func (o *AnnotateResultParams) WithTimeout(timeout time.Duration) *AnnotateResultParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the annotate result params
+func (o *AnnotateResultParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the annotate result params
+func (o *AnnotateResultParams) WithContext(ctx context.Context) *AnnotateResultParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the annotate result params
+func (o *AnnotateResultParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the annotate result params
+func (o *AnnotateResultParams) WithHTTPClient(client *http.Client) *AnnotateResultParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the annotate result params
+func (o *AnnotateResultParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithOrg adds the org to the annotate result params
+func (o *AnnotateResultParams) WithOrg(org string) *AnnotateResultParams {
+	o.SetOrg(org)
+	return o
+}
+
+// SetOrg adds the org to the annotate result params
+func (o *AnnotateResultParams) SetOrg(org string) {
+	o.Org = org
+}
+
+// WithOverride adds the override to the annotate result params
+func (o *AnnotateResultParams) WithOverride(override *models.OverrideRequest) *AnnotateResultParams {
+	o.SetOverride(override)
+	return o
+}
+
+// SetOverride adds the override to the annotate result params
+func (o *AnnotateResultParams) SetOverride(override *models.OverrideRequest) {
+	o.Override = override
+}
+
+// WithPlatform adds the platform to the annotate result params
+func (o *AnnotateResultParams) WithPlatform(platform string) *AnnotateResultParams {
+	o.SetPlatform(platform)
+	return o
+}
+
+// SetPlatform adds the platform to the annotate result params
+func (o *AnnotateResultParams) SetPlatform(platform string) {
+	o.Platform = platform
+}
+
+// WithRepo adds the repo to the annotate result params
+func (o *AnnotateResultParams) WithRepo(repo string) *AnnotateResultParams {
+	o.SetRepo(repo)
+	return o
+}
+
+// SetRepo adds the repo to the annotate result params
+func (o *AnnotateResultParams) SetRepo(repo string) {
+	o.Repo = repo
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AnnotateResultParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param org
+	if err := r.SetPathParam("org", o.Org); err != nil {
+		return err
+	}
+	if o.Override != nil {
+		if err := r.SetBodyParam(o.Override); err != nil {
+			return err
+		}
+	}
+
+	// path param platform
+	if err := r.SetPathParam("platform", o.Platform); err != nil {
+		return err
+	}
+
+	// path param repo
+	if err := r.SetPathParam("repo", o.Repo); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
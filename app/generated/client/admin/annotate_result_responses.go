@@ -0,0 +1,353 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// AnnotateResultReader is a Reader for the AnnotateResult structure.
+type AnnotateResultReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AnnotateResultReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAnnotateResultOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewAnnotateResultBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewAnnotateResultUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewAnnotateResultDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewAnnotateResultOK creates a AnnotateResultOK with default headers values
+func NewAnnotateResultOK() *AnnotateResultOK {
+	return &AnnotateResultOK{}
+}
+
+/*
+AnnotateResultOK describes a response with status code 200, with default header values.
+
+The updated override
+*/
+type AnnotateResultOK struct {
+	Payload *models.ResultOverride
+}
+
+// IsSuccess returns true when this annotate result o k response has a 2xx status code
+func (o *AnnotateResultOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this annotate result o k response has a 3xx status code
+func (o *AnnotateResultOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this annotate result o k response has a 4xx status code
+func (o *AnnotateResultOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this annotate result o k response has a 5xx status code
+func (o *AnnotateResultOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this annotate result o k response a status code equal to that given
+func (o *AnnotateResultOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *AnnotateResultOK) Error() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/annotate][%d] annotateResultOK  %+v", 200, o.Payload)
+}
+
+func (o *AnnotateResultOK) String() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/annotate][%d] annotateResultOK  %+v", 200, o.Payload)
+}
+
+func (o *AnnotateResultOK) GetPayload() *models.ResultOverride {
+	return o.Payload
+}
+
+func (o *AnnotateResultOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ResultOverride)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAnnotateResultBadRequest creates a AnnotateResultBadRequest with default headers values
+func NewAnnotateResultBadRequest() *AnnotateResultBadRequest {
+	return &AnnotateResultBadRequest{}
+}
+
+/*
+AnnotateResultBadRequest describes a response with status code 400, with default header values.
+
+The request provided to the server was invalid
+*/
+type AnnotateResultBadRequest struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this annotate result bad request response has a 2xx status code
+func (o *AnnotateResultBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this annotate result bad request response has a 3xx status code
+func (o *AnnotateResultBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this annotate result bad request response has a 4xx status code
+func (o *AnnotateResultBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this annotate result bad request response has a 5xx status code
+func (o *AnnotateResultBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this annotate result bad request response a status code equal to that given
+func (o *AnnotateResultBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *AnnotateResultBadRequest) Error() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/annotate][%d] annotateResultBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *AnnotateResultBadRequest) String() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/annotate][%d] annotateResultBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *AnnotateResultBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *AnnotateResultBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAnnotateResultUnauthorized creates a AnnotateResultUnauthorized with default headers values
+func NewAnnotateResultUnauthorized() *AnnotateResultUnauthorized {
+	return &AnnotateResultUnauthorized{}
+}
+
+/*
+AnnotateResultUnauthorized describes a response with status code 401, with default header values.
+
+The request has no valid admin token
+*/
+type AnnotateResultUnauthorized struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this annotate result unauthorized response has a 2xx status code
+func (o *AnnotateResultUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this annotate result unauthorized response has a 3xx status code
+func (o *AnnotateResultUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this annotate result unauthorized response has a 4xx status code
+func (o *AnnotateResultUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this annotate result unauthorized response has a 5xx status code
+func (o *AnnotateResultUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this annotate result unauthorized response a status code equal to that given
+func (o *AnnotateResultUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *AnnotateResultUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/annotate][%d] annotateResultUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *AnnotateResultUnauthorized) String() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/annotate][%d] annotateResultUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *AnnotateResultUnauthorized) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *AnnotateResultUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAnnotateResultDefault creates a AnnotateResultDefault with default headers values
+func NewAnnotateResultDefault(code int) *AnnotateResultDefault {
+	return &AnnotateResultDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+AnnotateResultDefault describes a response with status code -1, with default header values.
+
+There was an internal error in the server while processing the request
+*/
+type AnnotateResultDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the annotate result default response
+func (o *AnnotateResultDefault) Code() int {
+	return o._statusCode
+}
+
+// IsSuccess returns true when this annotate result default response has a 2xx status code
+func (o *AnnotateResultDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this annotate result default response has a 3xx status code
+func (o *AnnotateResultDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this annotate result default response has a 4xx status code
+func (o *AnnotateResultDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this annotate result default response has a 5xx status code
+func (o *AnnotateResultDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this annotate result default response a status code equal to that given
+func (o *AnnotateResultDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+func (o *AnnotateResultDefault) Error() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/annotate][%d] annotateResult default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *AnnotateResultDefault) String() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/annotate][%d] annotateResult default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *AnnotateResultDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *AnnotateResultDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
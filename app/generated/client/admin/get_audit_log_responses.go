@@ -0,0 +1,353 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// GetAuditLogReader is a Reader for the GetAuditLog structure.
+type GetAuditLogReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetAuditLogReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetAuditLogOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewGetAuditLogBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewGetAuditLogUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewGetAuditLogDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetAuditLogOK creates a GetAuditLogOK with default headers values
+func NewGetAuditLogOK() *GetAuditLogOK {
+	return &GetAuditLogOK{}
+}
+
+/*
+GetAuditLogOK describes a response with status code 200, with default header values.
+
+The repository's audit log
+*/
+type GetAuditLogOK struct {
+	Payload *models.AuditLog
+}
+
+// IsSuccess returns true when this get audit log o k response has a 2xx status code
+func (o *GetAuditLogOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get audit log o k response has a 3xx status code
+func (o *GetAuditLogOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get audit log o k response has a 4xx status code
+func (o *GetAuditLogOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get audit log o k response has a 5xx status code
+func (o *GetAuditLogOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get audit log o k response a status code equal to that given
+func (o *GetAuditLogOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *GetAuditLogOK) Error() string {
+	return fmt.Sprintf("[GET /admin/projects/{platform}/{org}/{repo}/audit][%d] getAuditLogOK  %+v", 200, o.Payload)
+}
+
+func (o *GetAuditLogOK) String() string {
+	return fmt.Sprintf("[GET /admin/projects/{platform}/{org}/{repo}/audit][%d] getAuditLogOK  %+v", 200, o.Payload)
+}
+
+func (o *GetAuditLogOK) GetPayload() *models.AuditLog {
+	return o.Payload
+}
+
+func (o *GetAuditLogOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.AuditLog)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetAuditLogBadRequest creates a GetAuditLogBadRequest with default headers values
+func NewGetAuditLogBadRequest() *GetAuditLogBadRequest {
+	return &GetAuditLogBadRequest{}
+}
+
+/*
+GetAuditLogBadRequest describes a response with status code 400, with default header values.
+
+The request provided to the server was invalid
+*/
+type GetAuditLogBadRequest struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get audit log bad request response has a 2xx status code
+func (o *GetAuditLogBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get audit log bad request response has a 3xx status code
+func (o *GetAuditLogBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get audit log bad request response has a 4xx status code
+func (o *GetAuditLogBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get audit log bad request response has a 5xx status code
+func (o *GetAuditLogBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get audit log bad request response a status code equal to that given
+func (o *GetAuditLogBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *GetAuditLogBadRequest) Error() string {
+	return fmt.Sprintf("[GET /admin/projects/{platform}/{org}/{repo}/audit][%d] getAuditLogBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetAuditLogBadRequest) String() string {
+	return fmt.Sprintf("[GET /admin/projects/{platform}/{org}/{repo}/audit][%d] getAuditLogBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetAuditLogBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetAuditLogBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetAuditLogUnauthorized creates a GetAuditLogUnauthorized with default headers values
+func NewGetAuditLogUnauthorized() *GetAuditLogUnauthorized {
+	return &GetAuditLogUnauthorized{}
+}
+
+/*
+GetAuditLogUnauthorized describes a response with status code 401, with default header values.
+
+The request has no valid admin token
+*/
+type GetAuditLogUnauthorized struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get audit log unauthorized response has a 2xx status code
+func (o *GetAuditLogUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get audit log unauthorized response has a 3xx status code
+func (o *GetAuditLogUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get audit log unauthorized response has a 4xx status code
+func (o *GetAuditLogUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get audit log unauthorized response has a 5xx status code
+func (o *GetAuditLogUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get audit log unauthorized response a status code equal to that given
+func (o *GetAuditLogUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *GetAuditLogUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /admin/projects/{platform}/{org}/{repo}/audit][%d] getAuditLogUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *GetAuditLogUnauthorized) String() string {
+	return fmt.Sprintf("[GET /admin/projects/{platform}/{org}/{repo}/audit][%d] getAuditLogUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *GetAuditLogUnauthorized) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetAuditLogUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetAuditLogDefault creates a GetAuditLogDefault with default headers values
+func NewGetAuditLogDefault(code int) *GetAuditLogDefault {
+	return &GetAuditLogDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetAuditLogDefault describes a response with status code -1, with default header values.
+
+There was an internal error in the server while processing the request
+*/
+type GetAuditLogDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the get audit log default response
+func (o *GetAuditLogDefault) Code() int {
+	return o._statusCode
+}
+
+// IsSuccess returns true when this get audit log default response has a 2xx status code
+func (o *GetAuditLogDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get audit log default response has a 3xx status code
+func (o *GetAuditLogDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get audit log default response has a 4xx status code
+func (o *GetAuditLogDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get audit log default response has a 5xx status code
+func (o *GetAuditLogDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get audit log default response a status code equal to that given
+func (o *GetAuditLogDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+func (o *GetAuditLogDefault) Error() string {
+	return fmt.Sprintf("[GET /admin/projects/{platform}/{org}/{repo}/audit][%d] getAuditLog default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetAuditLogDefault) String() string {
+	return fmt.Sprintf("[GET /admin/projects/{platform}/{org}/{repo}/audit][%d] getAuditLog default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetAuditLogDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetAuditLogDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,273 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// GetMetricsReader is a Reader for the GetMetrics structure.
+type GetMetricsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetMetricsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetMetricsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewGetMetricsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewGetMetricsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetMetricsOK creates a GetMetricsOK with default headers values
+func NewGetMetricsOK() *GetMetricsOK {
+	return &GetMetricsOK{}
+}
+
+/*
+GetMetricsOK describes a response with status code 200, with default header values.
+
+The instance's metrics
+*/
+type GetMetricsOK struct {
+
+	/* Always no-store, the metrics are of the instance serving the request
+	 */
+	CacheControl string
+
+	Payload *models.Metrics
+}
+
+// IsSuccess returns true when this get metrics o k response has a 2xx status code
+func (o *GetMetricsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get metrics o k response has a 3xx status code
+func (o *GetMetricsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get metrics o k response has a 4xx status code
+func (o *GetMetricsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get metrics o k response has a 5xx status code
+func (o *GetMetricsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get metrics o k response a status code equal to that given
+func (o *GetMetricsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *GetMetricsOK) Error() string {
+	return fmt.Sprintf("[GET /admin/metrics][%d] getMetricsOK  %+v", 200, o.Payload)
+}
+
+func (o *GetMetricsOK) String() string {
+	return fmt.Sprintf("[GET /admin/metrics][%d] getMetricsOK  %+v", 200, o.Payload)
+}
+
+func (o *GetMetricsOK) GetPayload() *models.Metrics {
+	return o.Payload
+}
+
+func (o *GetMetricsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	o.Payload = new(models.Metrics)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetMetricsUnauthorized creates a GetMetricsUnauthorized with default headers values
+func NewGetMetricsUnauthorized() *GetMetricsUnauthorized {
+	return &GetMetricsUnauthorized{}
+}
+
+/*
+GetMetricsUnauthorized describes a response with status code 401, with default header values.
+
+The request has no valid admin token
+*/
+type GetMetricsUnauthorized struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get metrics unauthorized response has a 2xx status code
+func (o *GetMetricsUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get metrics unauthorized response has a 3xx status code
+func (o *GetMetricsUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get metrics unauthorized response has a 4xx status code
+func (o *GetMetricsUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get metrics unauthorized response has a 5xx status code
+func (o *GetMetricsUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get metrics unauthorized response a status code equal to that given
+func (o *GetMetricsUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *GetMetricsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /admin/metrics][%d] getMetricsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *GetMetricsUnauthorized) String() string {
+	return fmt.Sprintf("[GET /admin/metrics][%d] getMetricsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *GetMetricsUnauthorized) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetMetricsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetMetricsDefault creates a GetMetricsDefault with default headers values
+func NewGetMetricsDefault(code int) *GetMetricsDefault {
+	return &GetMetricsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetMetricsDefault describes a response with status code -1, with default header values.
+
+There was an internal error in the server while processing the request
+*/
+type GetMetricsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the get metrics default response
+func (o *GetMetricsDefault) Code() int {
+	return o._statusCode
+}
+
+// IsSuccess returns true when this get metrics default response has a 2xx status code
+func (o *GetMetricsDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get metrics default response has a 3xx status code
+func (o *GetMetricsDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get metrics default response has a 4xx status code
+func (o *GetMetricsDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get metrics default response has a 5xx status code
+func (o *GetMetricsDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get metrics default response a status code equal to that given
+func (o *GetMetricsDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+func (o *GetMetricsDefault) Error() string {
+	return fmt.Sprintf("[GET /admin/metrics][%d] getMetrics default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetMetricsDefault) String() string {
+	return fmt.Sprintf("[GET /admin/metrics][%d] getMetrics default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetMetricsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetMetricsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
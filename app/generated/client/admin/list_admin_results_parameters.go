@@ -0,0 +1,210 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListAdminResultsParams creates a new ListAdminResultsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListAdminResultsParams() *ListAdminResultsParams {
+	return &ListAdminResultsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListAdminResultsParamsWithTimeout creates a new ListAdminResultsParams object
+// with the ability to set a timeout on a request.
+func NewListAdminResultsParamsWithTimeout(timeout time.Duration) *ListAdminResultsParams {
+	return &ListAdminResultsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListAdminResultsParamsWithContext creates a new ListAdminResultsParams object
+// with the ability to set a context for a request.
+func NewListAdminResultsParamsWithContext(ctx context.Context) *ListAdminResultsParams {
+	return &ListAdminResultsParams{
+		Context: ctx,
+	}
+}
+
+// NewListAdminResultsParamsWithHTTPClient creates a new ListAdminResultsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListAdminResultsParamsWithHTTPClient(client *http.Client) *ListAdminResultsParams {
+	return &ListAdminResultsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListAdminResultsParams contains all the parameters to send to the API endpoint
+
+	for the list admin results operation.
+
+	Typically these are written to a http.Request.
+*/
+type ListAdminResultsParams struct {
+
+	/* Org.
+
+	   Name of the owner/organization of the repository
+	*/
+	Org string
+
+	/* Platform.
+
+	   VCS platform. eg. github.com
+	*/
+	Platform string
+
+	/* Repo.
+
+	   Name of the repository
+	*/
+	Repo string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list admin results params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListAdminResultsParams) WithDefaults() *ListAdminResultsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list admin results params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListAdminResultsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list admin results params
+func (o *ListAdminResultsParams) WithTimeout(timeout time.Duration) *ListAdminResultsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list admin results params
+func (o *ListAdminResultsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list admin results params
+func (o *ListAdminResultsParams) WithContext(ctx context.Context) *ListAdminResultsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list admin results params
+func (o *ListAdminResultsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list admin results params
+func (o *ListAdminResultsParams) WithHTTPClient(client *http.Client) *ListAdminResultsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list admin results params
+func (o *ListAdminResultsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithOrg adds the org to the list admin results params
+func (o *ListAdminResultsParams) WithOrg(org string) *ListAdminResultsParams {
+	o.SetOrg(org)
+	return o
+}
+
+// SetOrg adds the org to the list admin results params
+func (o *ListAdminResultsParams) SetOrg(org string) {
+	o.Org = org
+}
+
+// WithPlatform adds the platform to the list admin results params
+func (o *ListAdminResultsParams) WithPlatform(platform string) *ListAdminResultsParams {
+	o.SetPlatform(platform)
+	return o
+}
+
+// SetPlatform adds the platform to the list admin results params
+func (o *ListAdminResultsParams) SetPlatform(platform string) {
+	o.Platform = platform
+}
+
+// WithRepo adds the repo to the list admin results params
+func (o *ListAdminResultsParams) WithRepo(repo string) *ListAdminResultsParams {
+	o.SetRepo(repo)
+	return o
+}
+
+// SetRepo adds the repo to the list admin results params
+func (o *ListAdminResultsParams) SetRepo(repo string) {
+	o.Repo = repo
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListAdminResultsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param org
+	if err := r.SetPathParam("org", o.Org); err != nil {
+		return err
+	}
+
+	// path param platform
+	if err := r.SetPathParam("platform", o.Platform); err != nil {
+		return err
+	}
+
+	// path param repo
+	if err := r.SetPathParam("repo", o.Repo); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,353 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// ListAdminResultsReader is a Reader for the ListAdminResults structure.
+type ListAdminResultsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListAdminResultsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListAdminResultsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewListAdminResultsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewListAdminResultsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewListAdminResultsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListAdminResultsOK creates a ListAdminResultsOK with default headers values
+func NewListAdminResultsOK() *ListAdminResultsOK {
+	return &ListAdminResultsOK{}
+}
+
+/*
+ListAdminResultsOK describes a response with status code 200, with default header values.
+
+The repository's stored results and overrides
+*/
+type ListAdminResultsOK struct {
+	Payload *models.AdminResults
+}
+
+// IsSuccess returns true when this list admin results o k response has a 2xx status code
+func (o *ListAdminResultsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list admin results o k response has a 3xx status code
+func (o *ListAdminResultsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list admin results o k response has a 4xx status code
+func (o *ListAdminResultsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list admin results o k response has a 5xx status code
+func (o *ListAdminResultsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list admin results o k response a status code equal to that given
+func (o *ListAdminResultsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *ListAdminResultsOK) Error() string {
+	return fmt.Sprintf("[GET /admin/projects/{platform}/{org}/{repo}][%d] listAdminResultsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListAdminResultsOK) String() string {
+	return fmt.Sprintf("[GET /admin/projects/{platform}/{org}/{repo}][%d] listAdminResultsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListAdminResultsOK) GetPayload() *models.AdminResults {
+	return o.Payload
+}
+
+func (o *ListAdminResultsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.AdminResults)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListAdminResultsBadRequest creates a ListAdminResultsBadRequest with default headers values
+func NewListAdminResultsBadRequest() *ListAdminResultsBadRequest {
+	return &ListAdminResultsBadRequest{}
+}
+
+/*
+ListAdminResultsBadRequest describes a response with status code 400, with default header values.
+
+The request provided to the server was invalid
+*/
+type ListAdminResultsBadRequest struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this list admin results bad request response has a 2xx status code
+func (o *ListAdminResultsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list admin results bad request response has a 3xx status code
+func (o *ListAdminResultsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list admin results bad request response has a 4xx status code
+func (o *ListAdminResultsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list admin results bad request response has a 5xx status code
+func (o *ListAdminResultsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list admin results bad request response a status code equal to that given
+func (o *ListAdminResultsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *ListAdminResultsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /admin/projects/{platform}/{org}/{repo}][%d] listAdminResultsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListAdminResultsBadRequest) String() string {
+	return fmt.Sprintf("[GET /admin/projects/{platform}/{org}/{repo}][%d] listAdminResultsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListAdminResultsBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListAdminResultsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListAdminResultsUnauthorized creates a ListAdminResultsUnauthorized with default headers values
+func NewListAdminResultsUnauthorized() *ListAdminResultsUnauthorized {
+	return &ListAdminResultsUnauthorized{}
+}
+
+/*
+ListAdminResultsUnauthorized describes a response with status code 401, with default header values.
+
+The request has no valid admin token
+*/
+type ListAdminResultsUnauthorized struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this list admin results unauthorized response has a 2xx status code
+func (o *ListAdminResultsUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list admin results unauthorized response has a 3xx status code
+func (o *ListAdminResultsUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list admin results unauthorized response has a 4xx status code
+func (o *ListAdminResultsUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list admin results unauthorized response has a 5xx status code
+func (o *ListAdminResultsUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list admin results unauthorized response a status code equal to that given
+func (o *ListAdminResultsUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *ListAdminResultsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /admin/projects/{platform}/{org}/{repo}][%d] listAdminResultsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ListAdminResultsUnauthorized) String() string {
+	return fmt.Sprintf("[GET /admin/projects/{platform}/{org}/{repo}][%d] listAdminResultsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ListAdminResultsUnauthorized) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListAdminResultsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListAdminResultsDefault creates a ListAdminResultsDefault with default headers values
+func NewListAdminResultsDefault(code int) *ListAdminResultsDefault {
+	return &ListAdminResultsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+ListAdminResultsDefault describes a response with status code -1, with default header values.
+
+There was an internal error in the server while processing the request
+*/
+type ListAdminResultsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list admin results default response
+func (o *ListAdminResultsDefault) Code() int {
+	return o._statusCode
+}
+
+// IsSuccess returns true when this list admin results default response has a 2xx status code
+func (o *ListAdminResultsDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this list admin results default response has a 3xx status code
+func (o *ListAdminResultsDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this list admin results default response has a 4xx status code
+func (o *ListAdminResultsDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this list admin results default response has a 5xx status code
+func (o *ListAdminResultsDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this list admin results default response a status code equal to that given
+func (o *ListAdminResultsDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+func (o *ListAdminResultsDefault) Error() string {
+	return fmt.Sprintf("[GET /admin/projects/{platform}/{org}/{repo}][%d] listAdminResults default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListAdminResultsDefault) String() string {
+	return fmt.Sprintf("[GET /admin/projects/{platform}/{org}/{repo}][%d] listAdminResults default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListAdminResultsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListAdminResultsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
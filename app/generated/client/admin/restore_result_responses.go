@@ -0,0 +1,410 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// RestoreResultReader is a Reader for the RestoreResult structure.
+type RestoreResultReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RestoreResultReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRestoreResultOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewRestoreResultBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewRestoreResultUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewRestoreResultNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewRestoreResultDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewRestoreResultOK creates a RestoreResultOK with default headers values
+func NewRestoreResultOK() *RestoreResultOK {
+	return &RestoreResultOK{}
+}
+
+/*
+RestoreResultOK describes a response with status code 200, with default header values.
+
+The updated override
+*/
+type RestoreResultOK struct {
+	Payload *models.ResultOverride
+}
+
+// IsSuccess returns true when this restore result o k response has a 2xx status code
+func (o *RestoreResultOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this restore result o k response has a 3xx status code
+func (o *RestoreResultOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this restore result o k response has a 4xx status code
+func (o *RestoreResultOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this restore result o k response has a 5xx status code
+func (o *RestoreResultOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this restore result o k response a status code equal to that given
+func (o *RestoreResultOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *RestoreResultOK) Error() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/restore][%d] restoreResultOK  %+v", 200, o.Payload)
+}
+
+func (o *RestoreResultOK) String() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/restore][%d] restoreResultOK  %+v", 200, o.Payload)
+}
+
+func (o *RestoreResultOK) GetPayload() *models.ResultOverride {
+	return o.Payload
+}
+
+func (o *RestoreResultOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ResultOverride)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRestoreResultBadRequest creates a RestoreResultBadRequest with default headers values
+func NewRestoreResultBadRequest() *RestoreResultBadRequest {
+	return &RestoreResultBadRequest{}
+}
+
+/*
+RestoreResultBadRequest describes a response with status code 400, with default header values.
+
+The request provided to the server was invalid
+*/
+type RestoreResultBadRequest struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this restore result bad request response has a 2xx status code
+func (o *RestoreResultBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this restore result bad request response has a 3xx status code
+func (o *RestoreResultBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this restore result bad request response has a 4xx status code
+func (o *RestoreResultBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this restore result bad request response has a 5xx status code
+func (o *RestoreResultBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this restore result bad request response a status code equal to that given
+func (o *RestoreResultBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *RestoreResultBadRequest) Error() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/restore][%d] restoreResultBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *RestoreResultBadRequest) String() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/restore][%d] restoreResultBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *RestoreResultBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *RestoreResultBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRestoreResultUnauthorized creates a RestoreResultUnauthorized with default headers values
+func NewRestoreResultUnauthorized() *RestoreResultUnauthorized {
+	return &RestoreResultUnauthorized{}
+}
+
+/*
+RestoreResultUnauthorized describes a response with status code 401, with default header values.
+
+The request has no valid admin token
+*/
+type RestoreResultUnauthorized struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this restore result unauthorized response has a 2xx status code
+func (o *RestoreResultUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this restore result unauthorized response has a 3xx status code
+func (o *RestoreResultUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this restore result unauthorized response has a 4xx status code
+func (o *RestoreResultUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this restore result unauthorized response has a 5xx status code
+func (o *RestoreResultUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this restore result unauthorized response a status code equal to that given
+func (o *RestoreResultUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *RestoreResultUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/restore][%d] restoreResultUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *RestoreResultUnauthorized) String() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/restore][%d] restoreResultUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *RestoreResultUnauthorized) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *RestoreResultUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRestoreResultNotFound creates a RestoreResultNotFound with default headers values
+func NewRestoreResultNotFound() *RestoreResultNotFound {
+	return &RestoreResultNotFound{}
+}
+
+/*
+RestoreResultNotFound describes a response with status code 404, with default header values.
+
+There is no override to restore
+*/
+type RestoreResultNotFound struct {
+}
+
+// IsSuccess returns true when this restore result not found response has a 2xx status code
+func (o *RestoreResultNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this restore result not found response has a 3xx status code
+func (o *RestoreResultNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this restore result not found response has a 4xx status code
+func (o *RestoreResultNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this restore result not found response has a 5xx status code
+func (o *RestoreResultNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this restore result not found response a status code equal to that given
+func (o *RestoreResultNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *RestoreResultNotFound) Error() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/restore][%d] restoreResultNotFound ", 404)
+}
+
+func (o *RestoreResultNotFound) String() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/restore][%d] restoreResultNotFound ", 404)
+}
+
+func (o *RestoreResultNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRestoreResultDefault creates a RestoreResultDefault with default headers values
+func NewRestoreResultDefault(code int) *RestoreResultDefault {
+	return &RestoreResultDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+RestoreResultDefault describes a response with status code -1, with default header values.
+
+There was an internal error in the server while processing the request
+*/
+type RestoreResultDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the restore result default response
+func (o *RestoreResultDefault) Code() int {
+	return o._statusCode
+}
+
+// IsSuccess returns true when this restore result default response has a 2xx status code
+func (o *RestoreResultDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this restore result default response has a 3xx status code
+func (o *RestoreResultDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this restore result default response has a 4xx status code
+func (o *RestoreResultDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this restore result default response has a 5xx status code
+func (o *RestoreResultDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this restore result default response a status code equal to that given
+func (o *RestoreResultDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+func (o *RestoreResultDefault) Error() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/restore][%d] restoreResult default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *RestoreResultDefault) String() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/restore][%d] restoreResult default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *RestoreResultDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *RestoreResultDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,353 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// TombstoneResultReader is a Reader for the TombstoneResult structure.
+type TombstoneResultReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *TombstoneResultReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewTombstoneResultOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewTombstoneResultBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewTombstoneResultUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewTombstoneResultDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewTombstoneResultOK creates a TombstoneResultOK with default headers values
+func NewTombstoneResultOK() *TombstoneResultOK {
+	return &TombstoneResultOK{}
+}
+
+/*
+TombstoneResultOK describes a response with status code 200, with default header values.
+
+The updated override
+*/
+type TombstoneResultOK struct {
+	Payload *models.ResultOverride
+}
+
+// IsSuccess returns true when this tombstone result o k response has a 2xx status code
+func (o *TombstoneResultOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this tombstone result o k response has a 3xx status code
+func (o *TombstoneResultOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this tombstone result o k response has a 4xx status code
+func (o *TombstoneResultOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this tombstone result o k response has a 5xx status code
+func (o *TombstoneResultOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this tombstone result o k response a status code equal to that given
+func (o *TombstoneResultOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *TombstoneResultOK) Error() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/tombstone][%d] tombstoneResultOK  %+v", 200, o.Payload)
+}
+
+func (o *TombstoneResultOK) String() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/tombstone][%d] tombstoneResultOK  %+v", 200, o.Payload)
+}
+
+func (o *TombstoneResultOK) GetPayload() *models.ResultOverride {
+	return o.Payload
+}
+
+func (o *TombstoneResultOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ResultOverride)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewTombstoneResultBadRequest creates a TombstoneResultBadRequest with default headers values
+func NewTombstoneResultBadRequest() *TombstoneResultBadRequest {
+	return &TombstoneResultBadRequest{}
+}
+
+/*
+TombstoneResultBadRequest describes a response with status code 400, with default header values.
+
+The request provided to the server was invalid
+*/
+type TombstoneResultBadRequest struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this tombstone result bad request response has a 2xx status code
+func (o *TombstoneResultBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this tombstone result bad request response has a 3xx status code
+func (o *TombstoneResultBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this tombstone result bad request response has a 4xx status code
+func (o *TombstoneResultBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this tombstone result bad request response has a 5xx status code
+func (o *TombstoneResultBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this tombstone result bad request response a status code equal to that given
+func (o *TombstoneResultBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *TombstoneResultBadRequest) Error() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/tombstone][%d] tombstoneResultBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *TombstoneResultBadRequest) String() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/tombstone][%d] tombstoneResultBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *TombstoneResultBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *TombstoneResultBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewTombstoneResultUnauthorized creates a TombstoneResultUnauthorized with default headers values
+func NewTombstoneResultUnauthorized() *TombstoneResultUnauthorized {
+	return &TombstoneResultUnauthorized{}
+}
+
+/*
+TombstoneResultUnauthorized describes a response with status code 401, with default header values.
+
+The request has no valid admin token
+*/
+type TombstoneResultUnauthorized struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this tombstone result unauthorized response has a 2xx status code
+func (o *TombstoneResultUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this tombstone result unauthorized response has a 3xx status code
+func (o *TombstoneResultUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this tombstone result unauthorized response has a 4xx status code
+func (o *TombstoneResultUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this tombstone result unauthorized response has a 5xx status code
+func (o *TombstoneResultUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this tombstone result unauthorized response a status code equal to that given
+func (o *TombstoneResultUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *TombstoneResultUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/tombstone][%d] tombstoneResultUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *TombstoneResultUnauthorized) String() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/tombstone][%d] tombstoneResultUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *TombstoneResultUnauthorized) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *TombstoneResultUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewTombstoneResultDefault creates a TombstoneResultDefault with default headers values
+func NewTombstoneResultDefault(code int) *TombstoneResultDefault {
+	return &TombstoneResultDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+TombstoneResultDefault describes a response with status code -1, with default header values.
+
+There was an internal error in the server while processing the request
+*/
+type TombstoneResultDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the tombstone result default response
+func (o *TombstoneResultDefault) Code() int {
+	return o._statusCode
+}
+
+// IsSuccess returns true when this tombstone result default response has a 2xx status code
+func (o *TombstoneResultDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this tombstone result default response has a 3xx status code
+func (o *TombstoneResultDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this tombstone result default response has a 4xx status code
+func (o *TombstoneResultDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this tombstone result default response has a 5xx status code
+func (o *TombstoneResultDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this tombstone result default response a status code equal to that given
+func (o *TombstoneResultDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+func (o *TombstoneResultDefault) Error() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/tombstone][%d] tombstoneResult default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *TombstoneResultDefault) String() string {
+	return fmt.Sprintf("[POST /admin/projects/{platform}/{org}/{repo}/tombstone][%d] tombstoneResult default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *TombstoneResultDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *TombstoneResultDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -44,6 +44,12 @@ func (o *GetBadgeReader) ReadResponse(response runtime.ClientResponse, consumer
 			return nil, err
 		}
 		return nil, result
+	case 410:
+		result := NewGetBadgeGone()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	default:
 		result := NewGetBadgeDefault(response.Code())
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -76,7 +82,7 @@ type GetBadgeFound struct {
 	 */
 	SurrogateControl string
 
-	/* Surrogate key for Fastly CDN purging.
+	/* Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
 	 */
 	SurrogateKey string
 }
@@ -140,6 +146,90 @@ func (o *GetBadgeFound) readResponse(response runtime.ClientResponse, consumer r
 	return nil
 }
 
+// NewGetBadgeGone creates a GetBadgeGone with default headers values
+func NewGetBadgeGone() *GetBadgeGone {
+	return &GetBadgeGone{}
+}
+
+/*
+GetBadgeGone describes a response with status code 410, with default header values.
+
+The repository's results were taken down. The plain text body is the reason.
+*/
+type GetBadgeGone struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	/* Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+	 */
+	SurrogateKey string
+}
+
+// IsSuccess returns true when this get badge gone response has a 2xx status code
+func (o *GetBadgeGone) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get badge gone response has a 3xx status code
+func (o *GetBadgeGone) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get badge gone response has a 4xx status code
+func (o *GetBadgeGone) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get badge gone response has a 5xx status code
+func (o *GetBadgeGone) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get badge gone response a status code equal to that given
+func (o *GetBadgeGone) IsCode(code int) bool {
+	return code == 410
+}
+
+func (o *GetBadgeGone) Error() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}/{repo}/badge][%d] getBadgeGone ", 410)
+}
+
+func (o *GetBadgeGone) String() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}/{repo}/badge][%d] getBadgeGone ", 410)
+}
+
+func (o *GetBadgeGone) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	// hydrates response header Surrogate-Key
+	hdrSurrogateKey := response.GetHeader("Surrogate-Key")
+
+	if hdrSurrogateKey != "" {
+		o.SurrogateKey = hdrSurrogateKey
+	}
+
+	return nil
+}
+
 // NewGetBadgeDefault creates a GetBadgeDefault with default headers values
 func NewGetBadgeDefault(code int) *GetBadgeDefault {
 	return &GetBadgeDefault{
@@ -25,6 +25,7 @@ import (
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/ossf/scorecard-webapp/app/generated/client/admin"
 	"github.com/ossf/scorecard-webapp/app/generated/client/badge"
 	"github.com/ossf/scorecard-webapp/app/generated/client/results"
 )
@@ -71,6 +72,7 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *OpenSSFSco
 
 	cli := new(OpenSSFScorecardAPI)
 	cli.Transport = transport
+	cli.Admin = admin.New(transport, formats)
 	cli.Badge = badge.New(transport, formats)
 	cli.Results = results.New(transport, formats)
 	return cli
@@ -117,6 +119,8 @@ func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
 
 // OpenSSFScorecardAPI is a client for open SSF scorecard API
 type OpenSSFScorecardAPI struct {
+	Admin admin.ClientService
+
 	Badge badge.ClientService
 
 	Results results.ClientService
@@ -127,6 +131,7 @@ type OpenSSFScorecardAPI struct {
 // SetTransport changes the transport on the client and all its subresources
 func (c *OpenSSFScorecardAPI) SetTransport(transport runtime.ClientTransport) {
 	c.Transport = transport
+	c.Admin.SetTransport(transport)
 	c.Badge.SetTransport(transport)
 	c.Results.SetTransport(transport)
 }
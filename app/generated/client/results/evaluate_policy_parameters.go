@@ -0,0 +1,265 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package results
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// NewEvaluatePolicyParams creates a new EvaluatePolicyParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewEvaluatePolicyParams() *EvaluatePolicyParams {
+	return &EvaluatePolicyParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewEvaluatePolicyParamsWithTimeout creates a new EvaluatePolicyParams object
+// with the ability to set a timeout on a request.
+func NewEvaluatePolicyParamsWithTimeout(timeout time.Duration) *EvaluatePolicyParams {
+	return &EvaluatePolicyParams{
+		timeout: timeout,
+	}
+}
+
+// NewEvaluatePolicyParamsWithContext creates a new EvaluatePolicyParams object
+// with the ability to set a context for a request.
+func NewEvaluatePolicyParamsWithContext(ctx context.Context) *EvaluatePolicyParams {
+	return &EvaluatePolicyParams{
+		Context: ctx,
+	}
+}
+
+// NewEvaluatePolicyParamsWithHTTPClient creates a new EvaluatePolicyParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewEvaluatePolicyParamsWithHTTPClient(client *http.Client) *EvaluatePolicyParams {
+	return &EvaluatePolicyParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+EvaluatePolicyParams contains all the parameters to send to the API endpoint
+
+	for the evaluate policy operation.
+
+	Typically these are written to a http.Request.
+*/
+type EvaluatePolicyParams struct {
+
+	/* Commit.
+
+	   SHA1 commit hash expressed in hexadecimal format
+	*/
+	Commit *string
+
+	/* Org.
+
+	   Name of the owner/organization of the repository
+	*/
+	Org string
+
+	/* Platform.
+
+	   VCS platform. eg. github.com
+	*/
+	Platform string
+
+	// Policy.
+	Policy *models.Policy
+
+	/* Repo.
+
+	   Name of the repository
+	*/
+	Repo string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the evaluate policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *EvaluatePolicyParams) WithDefaults() *EvaluatePolicyParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the evaluate policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *EvaluatePolicyParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the evaluate policy params
+func (o *EvaluatePolicyParams) WithTimeout(timeout time.Duration) *EvaluatePolicyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the evaluate policy params
+func (o *EvaluatePolicyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the evaluate policy params
+func (o *EvaluatePolicyParams) WithContext(ctx context.Context) *EvaluatePolicyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the evaluate policy params
+func (o *EvaluatePolicyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the evaluate policy params
+func (o *EvaluatePolicyParams) WithHTTPClient(client *http.Client) *EvaluatePolicyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the evaluate policy params
+func (o *EvaluatePolicyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCommit adds the commit to the evaluate policy params
+func (o *EvaluatePolicyParams) WithCommit(commit *string) *EvaluatePolicyParams {
+	o.SetCommit(commit)
+	return o
+}
+
+// SetCommit adds the commit to the evaluate policy params
+func (o *EvaluatePolicyParams) SetCommit(commit *string) {
+	o.Commit = commit
+}
+
+// WithOrg adds the org to the evaluate policy params
+func (o *EvaluatePolicyParams) WithOrg(org string) *EvaluatePolicyParams {
+	o.SetOrg(org)
+	return o
+}
+
+// SetOrg adds the org to the evaluate policy params
+func (o *EvaluatePolicyParams) SetOrg(org string) {
+	o.Org = org
+}
+
+// WithPlatform adds the platform to the evaluate policy params
+func (o *EvaluatePolicyParams) WithPlatform(platform string) *EvaluatePolicyParams {
+	o.SetPlatform(platform)
+	return o
+}
+
+// SetPlatform adds the platform to the evaluate policy params
+func (o *EvaluatePolicyParams) SetPlatform(platform string) {
+	o.Platform = platform
+}
+
+// WithPolicy adds the policy to the evaluate policy params
+func (o *EvaluatePolicyParams) WithPolicy(policy *models.Policy) *EvaluatePolicyParams {
+	o.SetPolicy(policy)
+	return o
+}
+
+// SetPolicy adds the policy to the evaluate policy params
+func (o *EvaluatePolicyParams) SetPolicy(policy *models.Policy) {
+	o.Policy = policy
+}
+
+// WithRepo adds the repo to the evaluate policy params
+func (o *EvaluatePolicyParams) WithRepo(repo string) *EvaluatePolicyParams {
+	o.SetRepo(repo)
+	return o
+}
+
+// SetRepo adds the repo to the evaluate policy params
+func (o *EvaluatePolicyParams) SetRepo(repo string) {
+	o.Repo = repo
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *EvaluatePolicyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Commit != nil {
+
+		// query param commit
+		var qrCommit string
+
+		if o.Commit != nil {
+			qrCommit = *o.Commit
+		}
+		qCommit := qrCommit
+		if qCommit != "" {
+
+			if err := r.SetQueryParam("commit", qCommit); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param org
+	if err := r.SetPathParam("org", o.Org); err != nil {
+		return err
+	}
+
+	// path param platform
+	if err := r.SetPathParam("platform", o.Platform); err != nil {
+		return err
+	}
+	if o.Policy != nil {
+		if err := r.SetBodyParam(o.Policy); err != nil {
+			return err
+		}
+	}
+
+	// path param repo
+	if err := r.SetPathParam("repo", o.Repo); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
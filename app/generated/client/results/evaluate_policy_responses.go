@@ -0,0 +1,477 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package results
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// EvaluatePolicyReader is a Reader for the EvaluatePolicy structure.
+type EvaluatePolicyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *EvaluatePolicyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewEvaluatePolicyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewEvaluatePolicyBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewEvaluatePolicyNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 410:
+		result := NewEvaluatePolicyGone()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewEvaluatePolicyDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewEvaluatePolicyOK creates a EvaluatePolicyOK with default headers values
+func NewEvaluatePolicyOK() *EvaluatePolicyOK {
+	return &EvaluatePolicyOK{}
+}
+
+/*
+EvaluatePolicyOK describes a response with status code 200, with default header values.
+
+The outcome of the evaluation
+*/
+type EvaluatePolicyOK struct {
+	Payload *models.PolicyEvaluation
+}
+
+// IsSuccess returns true when this evaluate policy o k response has a 2xx status code
+func (o *EvaluatePolicyOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this evaluate policy o k response has a 3xx status code
+func (o *EvaluatePolicyOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this evaluate policy o k response has a 4xx status code
+func (o *EvaluatePolicyOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this evaluate policy o k response has a 5xx status code
+func (o *EvaluatePolicyOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this evaluate policy o k response a status code equal to that given
+func (o *EvaluatePolicyOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *EvaluatePolicyOK) Error() string {
+	return fmt.Sprintf("[POST /projects/{platform}/{org}/{repo}/evaluate][%d] evaluatePolicyOK  %+v", 200, o.Payload)
+}
+
+func (o *EvaluatePolicyOK) String() string {
+	return fmt.Sprintf("[POST /projects/{platform}/{org}/{repo}/evaluate][%d] evaluatePolicyOK  %+v", 200, o.Payload)
+}
+
+func (o *EvaluatePolicyOK) GetPayload() *models.PolicyEvaluation {
+	return o.Payload
+}
+
+func (o *EvaluatePolicyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.PolicyEvaluation)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewEvaluatePolicyBadRequest creates a EvaluatePolicyBadRequest with default headers values
+func NewEvaluatePolicyBadRequest() *EvaluatePolicyBadRequest {
+	return &EvaluatePolicyBadRequest{}
+}
+
+/*
+EvaluatePolicyBadRequest describes a response with status code 400, with default header values.
+
+The request provided to the server was invalid
+*/
+type EvaluatePolicyBadRequest struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this evaluate policy bad request response has a 2xx status code
+func (o *EvaluatePolicyBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this evaluate policy bad request response has a 3xx status code
+func (o *EvaluatePolicyBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this evaluate policy bad request response has a 4xx status code
+func (o *EvaluatePolicyBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this evaluate policy bad request response has a 5xx status code
+func (o *EvaluatePolicyBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this evaluate policy bad request response a status code equal to that given
+func (o *EvaluatePolicyBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *EvaluatePolicyBadRequest) Error() string {
+	return fmt.Sprintf("[POST /projects/{platform}/{org}/{repo}/evaluate][%d] evaluatePolicyBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *EvaluatePolicyBadRequest) String() string {
+	return fmt.Sprintf("[POST /projects/{platform}/{org}/{repo}/evaluate][%d] evaluatePolicyBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *EvaluatePolicyBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *EvaluatePolicyBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewEvaluatePolicyNotFound creates a EvaluatePolicyNotFound with default headers values
+func NewEvaluatePolicyNotFound() *EvaluatePolicyNotFound {
+	return &EvaluatePolicyNotFound{}
+}
+
+/*
+EvaluatePolicyNotFound describes a response with status code 404, with default header values.
+
+The content requested could not be found
+*/
+type EvaluatePolicyNotFound struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	/* Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+	 */
+	SurrogateKey string
+}
+
+// IsSuccess returns true when this evaluate policy not found response has a 2xx status code
+func (o *EvaluatePolicyNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this evaluate policy not found response has a 3xx status code
+func (o *EvaluatePolicyNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this evaluate policy not found response has a 4xx status code
+func (o *EvaluatePolicyNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this evaluate policy not found response has a 5xx status code
+func (o *EvaluatePolicyNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this evaluate policy not found response a status code equal to that given
+func (o *EvaluatePolicyNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *EvaluatePolicyNotFound) Error() string {
+	return fmt.Sprintf("[POST /projects/{platform}/{org}/{repo}/evaluate][%d] evaluatePolicyNotFound ", 404)
+}
+
+func (o *EvaluatePolicyNotFound) String() string {
+	return fmt.Sprintf("[POST /projects/{platform}/{org}/{repo}/evaluate][%d] evaluatePolicyNotFound ", 404)
+}
+
+func (o *EvaluatePolicyNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	// hydrates response header Surrogate-Key
+	hdrSurrogateKey := response.GetHeader("Surrogate-Key")
+
+	if hdrSurrogateKey != "" {
+		o.SurrogateKey = hdrSurrogateKey
+	}
+
+	return nil
+}
+
+// NewEvaluatePolicyGone creates a EvaluatePolicyGone with default headers values
+func NewEvaluatePolicyGone() *EvaluatePolicyGone {
+	return &EvaluatePolicyGone{}
+}
+
+/*
+EvaluatePolicyGone describes a response with status code 410, with default header values.
+
+The result was taken down. The message is the reason.
+*/
+type EvaluatePolicyGone struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	/* Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+	 */
+	SurrogateKey string
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this evaluate policy gone response has a 2xx status code
+func (o *EvaluatePolicyGone) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this evaluate policy gone response has a 3xx status code
+func (o *EvaluatePolicyGone) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this evaluate policy gone response has a 4xx status code
+func (o *EvaluatePolicyGone) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this evaluate policy gone response has a 5xx status code
+func (o *EvaluatePolicyGone) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this evaluate policy gone response a status code equal to that given
+func (o *EvaluatePolicyGone) IsCode(code int) bool {
+	return code == 410
+}
+
+func (o *EvaluatePolicyGone) Error() string {
+	return fmt.Sprintf("[POST /projects/{platform}/{org}/{repo}/evaluate][%d] evaluatePolicyGone  %+v", 410, o.Payload)
+}
+
+func (o *EvaluatePolicyGone) String() string {
+	return fmt.Sprintf("[POST /projects/{platform}/{org}/{repo}/evaluate][%d] evaluatePolicyGone  %+v", 410, o.Payload)
+}
+
+func (o *EvaluatePolicyGone) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *EvaluatePolicyGone) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	// hydrates response header Surrogate-Key
+	hdrSurrogateKey := response.GetHeader("Surrogate-Key")
+
+	if hdrSurrogateKey != "" {
+		o.SurrogateKey = hdrSurrogateKey
+	}
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewEvaluatePolicyDefault creates a EvaluatePolicyDefault with default headers values
+func NewEvaluatePolicyDefault(code int) *EvaluatePolicyDefault {
+	return &EvaluatePolicyDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+EvaluatePolicyDefault describes a response with status code -1, with default header values.
+
+There was an internal error in the server while processing the request
+*/
+type EvaluatePolicyDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the evaluate policy default response
+func (o *EvaluatePolicyDefault) Code() int {
+	return o._statusCode
+}
+
+// IsSuccess returns true when this evaluate policy default response has a 2xx status code
+func (o *EvaluatePolicyDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this evaluate policy default response has a 3xx status code
+func (o *EvaluatePolicyDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this evaluate policy default response has a 4xx status code
+func (o *EvaluatePolicyDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this evaluate policy default response has a 5xx status code
+func (o *EvaluatePolicyDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this evaluate policy default response a status code equal to that given
+func (o *EvaluatePolicyDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+func (o *EvaluatePolicyDefault) Error() string {
+	return fmt.Sprintf("[POST /projects/{platform}/{org}/{repo}/evaluate][%d] evaluatePolicy default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *EvaluatePolicyDefault) String() string {
+	return fmt.Sprintf("[POST /projects/{platform}/{org}/{repo}/evaluate][%d] evaluatePolicy default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *EvaluatePolicyDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *EvaluatePolicyDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
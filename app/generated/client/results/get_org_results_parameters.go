@@ -0,0 +1,273 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package results
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetOrgResultsParams creates a new GetOrgResultsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetOrgResultsParams() *GetOrgResultsParams {
+	return &GetOrgResultsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetOrgResultsParamsWithTimeout creates a new GetOrgResultsParams object
+// with the ability to set a timeout on a request.
+func NewGetOrgResultsParamsWithTimeout(timeout time.Duration) *GetOrgResultsParams {
+	return &GetOrgResultsParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetOrgResultsParamsWithContext creates a new GetOrgResultsParams object
+// with the ability to set a context for a request.
+func NewGetOrgResultsParamsWithContext(ctx context.Context) *GetOrgResultsParams {
+	return &GetOrgResultsParams{
+		Context: ctx,
+	}
+}
+
+// NewGetOrgResultsParamsWithHTTPClient creates a new GetOrgResultsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetOrgResultsParamsWithHTTPClient(client *http.Client) *GetOrgResultsParams {
+	return &GetOrgResultsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetOrgResultsParams contains all the parameters to send to the API endpoint
+
+	for the get org results operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetOrgResultsParams struct {
+
+	/* Limit.
+
+	   Maximum number of repositories to return
+
+	   Default: 100
+	*/
+	Limit *int64
+
+	/* Offset.
+
+	   Number of repositories to skip, for pagination
+	*/
+	Offset *int64
+
+	/* Org.
+
+	   Name of the owner/organization
+	*/
+	Org string
+
+	/* Platform.
+
+	   VCS platform. eg. github.com
+	*/
+	Platform string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get org results params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetOrgResultsParams) WithDefaults() *GetOrgResultsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get org results params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetOrgResultsParams) SetDefaults() {
+	var (
+		limitDefault = int64(100)
+
+		offsetDefault = int64(0)
+	)
+
+	val := GetOrgResultsParams{
+		Limit:  &limitDefault,
+		Offset: &offsetDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the get org results params
+func (o *GetOrgResultsParams) WithTimeout(timeout time.Duration) *GetOrgResultsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get org results params
+func (o *GetOrgResultsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get org results params
+func (o *GetOrgResultsParams) WithContext(ctx context.Context) *GetOrgResultsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get org results params
+func (o *GetOrgResultsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get org results params
+func (o *GetOrgResultsParams) WithHTTPClient(client *http.Client) *GetOrgResultsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get org results params
+func (o *GetOrgResultsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLimit adds the limit to the get org results params
+func (o *GetOrgResultsParams) WithLimit(limit *int64) *GetOrgResultsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the get org results params
+func (o *GetOrgResultsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithOffset adds the offset to the get org results params
+func (o *GetOrgResultsParams) WithOffset(offset *int64) *GetOrgResultsParams {
+	o.SetOffset(offset)
+	return o
+}
+
+// SetOffset adds the offset to the get org results params
+func (o *GetOrgResultsParams) SetOffset(offset *int64) {
+	o.Offset = offset
+}
+
+// WithOrg adds the org to the get org results params
+func (o *GetOrgResultsParams) WithOrg(org string) *GetOrgResultsParams {
+	o.SetOrg(org)
+	return o
+}
+
+// SetOrg adds the org to the get org results params
+func (o *GetOrgResultsParams) SetOrg(org string) {
+	o.Org = org
+}
+
+// WithPlatform adds the platform to the get org results params
+func (o *GetOrgResultsParams) WithPlatform(platform string) *GetOrgResultsParams {
+	o.SetPlatform(platform)
+	return o
+}
+
+// SetPlatform adds the platform to the get org results params
+func (o *GetOrgResultsParams) SetPlatform(platform string) {
+	o.Platform = platform
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetOrgResultsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Offset != nil {
+
+		// query param offset
+		var qrOffset int64
+
+		if o.Offset != nil {
+			qrOffset = *o.Offset
+		}
+		qOffset := swag.FormatInt64(qrOffset)
+		if qOffset != "" {
+
+			if err := r.SetQueryParam("offset", qOffset); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param org
+	if err := r.SetPathParam("org", o.Org); err != nil {
+		return err
+	}
+
+	// path param platform
+	if err := r.SetPathParam("platform", o.Platform); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
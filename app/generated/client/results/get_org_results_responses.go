@@ -0,0 +1,408 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package results
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// GetOrgResultsReader is a Reader for the GetOrgResults structure.
+type GetOrgResultsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetOrgResultsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetOrgResultsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewGetOrgResultsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewGetOrgResultsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewGetOrgResultsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetOrgResultsOK creates a GetOrgResultsOK with default headers values
+func NewGetOrgResultsOK() *GetOrgResultsOK {
+	return &GetOrgResultsOK{}
+}
+
+/*
+GetOrgResultsOK describes a response with status code 200, with default header values.
+
+The organization's repositories and summary statistics
+*/
+type GetOrgResultsOK struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	/* Surrogate keys for Fastly CDN purging, e.g. org:github.com/org
+	 */
+	SurrogateKey string
+
+	Payload *models.OrgResults
+}
+
+// IsSuccess returns true when this get org results o k response has a 2xx status code
+func (o *GetOrgResultsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get org results o k response has a 3xx status code
+func (o *GetOrgResultsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get org results o k response has a 4xx status code
+func (o *GetOrgResultsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get org results o k response has a 5xx status code
+func (o *GetOrgResultsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get org results o k response a status code equal to that given
+func (o *GetOrgResultsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *GetOrgResultsOK) Error() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}][%d] getOrgResultsOK  %+v", 200, o.Payload)
+}
+
+func (o *GetOrgResultsOK) String() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}][%d] getOrgResultsOK  %+v", 200, o.Payload)
+}
+
+func (o *GetOrgResultsOK) GetPayload() *models.OrgResults {
+	return o.Payload
+}
+
+func (o *GetOrgResultsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	// hydrates response header Surrogate-Key
+	hdrSurrogateKey := response.GetHeader("Surrogate-Key")
+
+	if hdrSurrogateKey != "" {
+		o.SurrogateKey = hdrSurrogateKey
+	}
+
+	o.Payload = new(models.OrgResults)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetOrgResultsBadRequest creates a GetOrgResultsBadRequest with default headers values
+func NewGetOrgResultsBadRequest() *GetOrgResultsBadRequest {
+	return &GetOrgResultsBadRequest{}
+}
+
+/*
+GetOrgResultsBadRequest describes a response with status code 400, with default header values.
+
+The request provided to the server was invalid
+*/
+type GetOrgResultsBadRequest struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get org results bad request response has a 2xx status code
+func (o *GetOrgResultsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get org results bad request response has a 3xx status code
+func (o *GetOrgResultsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get org results bad request response has a 4xx status code
+func (o *GetOrgResultsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get org results bad request response has a 5xx status code
+func (o *GetOrgResultsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get org results bad request response a status code equal to that given
+func (o *GetOrgResultsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *GetOrgResultsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}][%d] getOrgResultsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetOrgResultsBadRequest) String() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}][%d] getOrgResultsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetOrgResultsBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetOrgResultsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetOrgResultsNotFound creates a GetOrgResultsNotFound with default headers values
+func NewGetOrgResultsNotFound() *GetOrgResultsNotFound {
+	return &GetOrgResultsNotFound{}
+}
+
+/*
+GetOrgResultsNotFound describes a response with status code 404, with default header values.
+
+The content requested could not be found
+*/
+type GetOrgResultsNotFound struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	/* Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+	 */
+	SurrogateKey string
+}
+
+// IsSuccess returns true when this get org results not found response has a 2xx status code
+func (o *GetOrgResultsNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get org results not found response has a 3xx status code
+func (o *GetOrgResultsNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get org results not found response has a 4xx status code
+func (o *GetOrgResultsNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get org results not found response has a 5xx status code
+func (o *GetOrgResultsNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get org results not found response a status code equal to that given
+func (o *GetOrgResultsNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *GetOrgResultsNotFound) Error() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}][%d] getOrgResultsNotFound ", 404)
+}
+
+func (o *GetOrgResultsNotFound) String() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}][%d] getOrgResultsNotFound ", 404)
+}
+
+func (o *GetOrgResultsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	// hydrates response header Surrogate-Key
+	hdrSurrogateKey := response.GetHeader("Surrogate-Key")
+
+	if hdrSurrogateKey != "" {
+		o.SurrogateKey = hdrSurrogateKey
+	}
+
+	return nil
+}
+
+// NewGetOrgResultsDefault creates a GetOrgResultsDefault with default headers values
+func NewGetOrgResultsDefault(code int) *GetOrgResultsDefault {
+	return &GetOrgResultsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetOrgResultsDefault describes a response with status code -1, with default header values.
+
+There was an internal error in the server while processing the request
+*/
+type GetOrgResultsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the get org results default response
+func (o *GetOrgResultsDefault) Code() int {
+	return o._statusCode
+}
+
+// IsSuccess returns true when this get org results default response has a 2xx status code
+func (o *GetOrgResultsDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get org results default response has a 3xx status code
+func (o *GetOrgResultsDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get org results default response has a 4xx status code
+func (o *GetOrgResultsDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get org results default response has a 5xx status code
+func (o *GetOrgResultsDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get org results default response a status code equal to that given
+func (o *GetOrgResultsDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+func (o *GetOrgResultsDefault) Error() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}][%d] getOrgResults default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetOrgResultsDefault) String() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}][%d] getOrgResults default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetOrgResultsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetOrgResultsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
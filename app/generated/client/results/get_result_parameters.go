@@ -29,6 +29,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewGetResultParams creates a new GetResultParams object,
@@ -76,12 +77,48 @@ GetResultParams contains all the parameters to send to the API endpoint
 */
 type GetResultParams struct {
 
+	/* IfModifiedSince.
+
+	   HTTP date from a previous Last-Modified header. Returns 304 if the result hasn't changed since. Ignored when If-None-Match is present.
+
+	*/
+	IfModifiedSince *string
+
+	/* IfNoneMatch.
+
+	   ETag from a previous response. Returns 304 if the result hasn't changed.
+	*/
+	IfNoneMatch *string
+
 	/* Commit.
 
 	   SHA1 commit hash expressed in hexadecimal format
 	*/
 	Commit *string
 
+	/* Envelope.
+
+	   Wrap the result in a ResultEnvelope with its source and age. Only supported with the json format.
+
+	*/
+	Envelope *bool
+
+	/* Format.
+
+	   Output format of the result. `json` returns the checks of the result, without probe findings. `sarif` converts the stored checks into a SARIF 2.1.0 log (application/sarif+json) with one rule per check. `probe` returns the structured probe findings of Scorecard v5 results as a ScorecardProbeResult, or 404 if the result has none.
+
+
+	   Default: "json"
+	*/
+	Format *string
+
+	/* MaxAge.
+
+	   Maximum age in seconds of the stored result, from when it was last written. Returns 422 if the best available result, from the requested source if any, is older.
+
+	*/
+	MaxAge *int64
+
 	/* Org.
 
 	   Name of the owner/organization of the repository
@@ -100,6 +137,13 @@ type GetResultParams struct {
 	*/
 	Repo string
 
+	/* Source.
+
+	   Only serve the result published by the Scorecard action, or computed by the weekly cron scan. By default, the most recently written of the two is served.
+
+	*/
+	Source *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -117,7 +161,21 @@ func (o *GetResultParams) WithDefaults() *GetResultParams {
 //
 // All values with no default are reset to their zero value.
 func (o *GetResultParams) SetDefaults() {
-	// no default values defined for this parameter
+	var (
+		envelopeDefault = bool(false)
+
+		formatDefault = string("json")
+	)
+
+	val := GetResultParams{
+		Envelope: &envelopeDefault,
+		Format:   &formatDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
 }
 
 // WithTimeout adds the timeout to the get result params
@@ -153,6 +211,28 @@ func (o *GetResultParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfModifiedSince adds the ifModifiedSince to the get result params
+func (o *GetResultParams) WithIfModifiedSince(ifModifiedSince *string) *GetResultParams {
+	o.SetIfModifiedSince(ifModifiedSince)
+	return o
+}
+
+// SetIfModifiedSince adds the ifModifiedSince to the get result params
+func (o *GetResultParams) SetIfModifiedSince(ifModifiedSince *string) {
+	o.IfModifiedSince = ifModifiedSince
+}
+
+// WithIfNoneMatch adds the ifNoneMatch to the get result params
+func (o *GetResultParams) WithIfNoneMatch(ifNoneMatch *string) *GetResultParams {
+	o.SetIfNoneMatch(ifNoneMatch)
+	return o
+}
+
+// SetIfNoneMatch adds the ifNoneMatch to the get result params
+func (o *GetResultParams) SetIfNoneMatch(ifNoneMatch *string) {
+	o.IfNoneMatch = ifNoneMatch
+}
+
 // WithCommit adds the commit to the get result params
 func (o *GetResultParams) WithCommit(commit *string) *GetResultParams {
 	o.SetCommit(commit)
@@ -164,6 +244,39 @@ func (o *GetResultParams) SetCommit(commit *string) {
 	o.Commit = commit
 }
 
+// WithEnvelope adds the envelope to the get result params
+func (o *GetResultParams) WithEnvelope(envelope *bool) *GetResultParams {
+	o.SetEnvelope(envelope)
+	return o
+}
+
+// SetEnvelope adds the envelope to the get result params
+func (o *GetResultParams) SetEnvelope(envelope *bool) {
+	o.Envelope = envelope
+}
+
+// WithFormat adds the format to the get result params
+func (o *GetResultParams) WithFormat(format *string) *GetResultParams {
+	o.SetFormat(format)
+	return o
+}
+
+// SetFormat adds the format to the get result params
+func (o *GetResultParams) SetFormat(format *string) {
+	o.Format = format
+}
+
+// WithMaxAge adds the maxAge to the get result params
+func (o *GetResultParams) WithMaxAge(maxAge *int64) *GetResultParams {
+	o.SetMaxAge(maxAge)
+	return o
+}
+
+// SetMaxAge adds the maxAge to the get result params
+func (o *GetResultParams) SetMaxAge(maxAge *int64) {
+	o.MaxAge = maxAge
+}
+
 // WithOrg adds the org to the get result params
 func (o *GetResultParams) WithOrg(org string) *GetResultParams {
 	o.SetOrg(org)
@@ -197,6 +310,17 @@ func (o *GetResultParams) SetRepo(repo string) {
 	o.Repo = repo
 }
 
+// WithSource adds the source to the get result params
+func (o *GetResultParams) WithSource(source *string) *GetResultParams {
+	o.SetSource(source)
+	return o
+}
+
+// SetSource adds the source to the get result params
+func (o *GetResultParams) SetSource(source *string) {
+	o.Source = source
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *GetResultParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -205,6 +329,22 @@ func (o *GetResultParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Reg
 	}
 	var res []error
 
+	if o.IfModifiedSince != nil {
+
+		// header param If-Modified-Since
+		if err := r.SetHeaderParam("If-Modified-Since", *o.IfModifiedSince); err != nil {
+			return err
+		}
+	}
+
+	if o.IfNoneMatch != nil {
+
+		// header param If-None-Match
+		if err := r.SetHeaderParam("If-None-Match", *o.IfNoneMatch); err != nil {
+			return err
+		}
+	}
+
 	if o.Commit != nil {
 
 		// query param commit
@@ -222,6 +362,57 @@ func (o *GetResultParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Reg
 		}
 	}
 
+	if o.Envelope != nil {
+
+		// query param envelope
+		var qrEnvelope bool
+
+		if o.Envelope != nil {
+			qrEnvelope = *o.Envelope
+		}
+		qEnvelope := swag.FormatBool(qrEnvelope)
+		if qEnvelope != "" {
+
+			if err := r.SetQueryParam("envelope", qEnvelope); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Format != nil {
+
+		// query param format
+		var qrFormat string
+
+		if o.Format != nil {
+			qrFormat = *o.Format
+		}
+		qFormat := qrFormat
+		if qFormat != "" {
+
+			if err := r.SetQueryParam("format", qFormat); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.MaxAge != nil {
+
+		// query param maxAge
+		var qrMaxAge int64
+
+		if o.MaxAge != nil {
+			qrMaxAge = *o.MaxAge
+		}
+		qMaxAge := swag.FormatInt64(qrMaxAge)
+		if qMaxAge != "" {
+
+			if err := r.SetQueryParam("maxAge", qMaxAge); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param org
 	if err := r.SetPathParam("org", o.Org); err != nil {
 		return err
@@ -237,6 +428,23 @@ func (o *GetResultParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Reg
 		return err
 	}
 
+	if o.Source != nil {
+
+		// query param source
+		var qrSource string
+
+		if o.Source != nil {
+			qrSource = *o.Source
+		}
+		qSource := qrSource
+		if qSource != "" {
+
+			if err := r.SetQueryParam("source", qSource); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
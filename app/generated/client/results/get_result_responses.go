@@ -24,8 +24,10 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/ossf/scorecard-webapp/app/generated/models"
 )
@@ -44,6 +46,12 @@ func (o *GetResultReader) ReadResponse(response runtime.ClientResponse, consumer
 			return nil, err
 		}
 		return result, nil
+	case 304:
+		result := NewGetResultNotModified()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 400:
 		result := NewGetResultBadRequest()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -56,6 +64,24 @@ func (o *GetResultReader) ReadResponse(response runtime.ClientResponse, consumer
 			return nil, err
 		}
 		return nil, result
+	case 406:
+		result := NewGetResultNotAcceptable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 410:
+		result := NewGetResultGone()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewGetResultUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	default:
 		result := NewGetResultDefault(response.Code())
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -84,10 +110,35 @@ type GetResultOK struct {
 	 */
 	CacheControl string
 
+	/* Strong validator for the stored result, for use with If-None-Match.
+	 */
+	ETag string
+
+	/* Time the stored result was last written, for use with If-Modified-Since.
+	 */
+	LastModified string
+
 	/* TTL for Fastly CDN caching. Example: max-age=3600
 	 */
 	SurrogateControl string
 
+	/* Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+	 */
+	SurrogateKey string
+
+	/* Age in seconds of the stored result when the response was generated
+	 */
+	XScorecardAge int64
+
+	/* Note attached to the result by the server operator, if any
+	 */
+	XScorecardAnnotation string
+
+	/* Where the stored result comes from: published by the Scorecard action, or computed by the weekly cron scan.
+
+	 */
+	XScorecardSource string
+
 	Payload *models.ScorecardResult
 }
 
@@ -137,6 +188,20 @@ func (o *GetResultOK) readResponse(response runtime.ClientResponse, consumer run
 		o.CacheControl = hdrCacheControl
 	}
 
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	// hydrates response header Last-Modified
+	hdrLastModified := response.GetHeader("Last-Modified")
+
+	if hdrLastModified != "" {
+		o.LastModified = hdrLastModified
+	}
+
 	// hydrates response header Surrogate-Control
 	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
 
@@ -144,6 +209,38 @@ func (o *GetResultOK) readResponse(response runtime.ClientResponse, consumer run
 		o.SurrogateControl = hdrSurrogateControl
 	}
 
+	// hydrates response header Surrogate-Key
+	hdrSurrogateKey := response.GetHeader("Surrogate-Key")
+
+	if hdrSurrogateKey != "" {
+		o.SurrogateKey = hdrSurrogateKey
+	}
+
+	// hydrates response header X-Scorecard-Age
+	hdrXScorecardAge := response.GetHeader("X-Scorecard-Age")
+
+	if hdrXScorecardAge != "" {
+		valxScorecardAge, err := swag.ConvertInt64(hdrXScorecardAge)
+		if err != nil {
+			return errors.InvalidType("X-Scorecard-Age", "header", "int64", hdrXScorecardAge)
+		}
+		o.XScorecardAge = valxScorecardAge
+	}
+
+	// hydrates response header X-Scorecard-Annotation
+	hdrXScorecardAnnotation := response.GetHeader("X-Scorecard-Annotation")
+
+	if hdrXScorecardAnnotation != "" {
+		o.XScorecardAnnotation = hdrXScorecardAnnotation
+	}
+
+	// hydrates response header X-Scorecard-Source
+	hdrXScorecardSource := response.GetHeader("X-Scorecard-Source")
+
+	if hdrXScorecardSource != "" {
+		o.XScorecardSource = hdrXScorecardSource
+	}
+
 	o.Payload = new(models.ScorecardResult)
 
 	// response payload
@@ -154,6 +251,150 @@ func (o *GetResultOK) readResponse(response runtime.ClientResponse, consumer run
 	return nil
 }
 
+// NewGetResultNotModified creates a GetResultNotModified with default headers values
+func NewGetResultNotModified() *GetResultNotModified {
+	return &GetResultNotModified{}
+}
+
+/*
+GetResultNotModified describes a response with status code 304, with default header values.
+
+The stored result hasn't changed since the conditional request's validators
+*/
+type GetResultNotModified struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* Strong validator for the stored result, for use with If-None-Match.
+	 */
+	ETag string
+
+	/* Time the stored result was last written, for use with If-Modified-Since.
+	 */
+	LastModified string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	/* Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+	 */
+	SurrogateKey string
+
+	/* Age in seconds of the stored result when the response was generated
+	 */
+	XScorecardAge int64
+
+	/* Note attached to the result by the server operator, if any
+	 */
+	XScorecardAnnotation string
+
+	/* Where the stored result comes from: published by the Scorecard action, or computed by the weekly cron scan.
+
+	 */
+	XScorecardSource string
+}
+
+// IsSuccess returns true when this get result not modified response has a 2xx status code
+func (o *GetResultNotModified) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get result not modified response has a 3xx status code
+func (o *GetResultNotModified) IsRedirect() bool {
+	return true
+}
+
+// IsClientError returns true when this get result not modified response has a 4xx status code
+func (o *GetResultNotModified) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get result not modified response has a 5xx status code
+func (o *GetResultNotModified) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get result not modified response a status code equal to that given
+func (o *GetResultNotModified) IsCode(code int) bool {
+	return code == 304
+}
+
+func (o *GetResultNotModified) Error() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}/{repo}][%d] getResultNotModified ", 304)
+}
+
+func (o *GetResultNotModified) String() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}/{repo}][%d] getResultNotModified ", 304)
+}
+
+func (o *GetResultNotModified) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	// hydrates response header Last-Modified
+	hdrLastModified := response.GetHeader("Last-Modified")
+
+	if hdrLastModified != "" {
+		o.LastModified = hdrLastModified
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	// hydrates response header Surrogate-Key
+	hdrSurrogateKey := response.GetHeader("Surrogate-Key")
+
+	if hdrSurrogateKey != "" {
+		o.SurrogateKey = hdrSurrogateKey
+	}
+
+	// hydrates response header X-Scorecard-Age
+	hdrXScorecardAge := response.GetHeader("X-Scorecard-Age")
+
+	if hdrXScorecardAge != "" {
+		valxScorecardAge, err := swag.ConvertInt64(hdrXScorecardAge)
+		if err != nil {
+			return errors.InvalidType("X-Scorecard-Age", "header", "int64", hdrXScorecardAge)
+		}
+		o.XScorecardAge = valxScorecardAge
+	}
+
+	// hydrates response header X-Scorecard-Annotation
+	hdrXScorecardAnnotation := response.GetHeader("X-Scorecard-Annotation")
+
+	if hdrXScorecardAnnotation != "" {
+		o.XScorecardAnnotation = hdrXScorecardAnnotation
+	}
+
+	// hydrates response header X-Scorecard-Source
+	hdrXScorecardSource := response.GetHeader("X-Scorecard-Source")
+
+	if hdrXScorecardSource != "" {
+		o.XScorecardSource = hdrXScorecardSource
+	}
+
+	return nil
+}
+
 // NewGetResultBadRequest creates a GetResultBadRequest with default headers values
 func NewGetResultBadRequest() *GetResultBadRequest {
 	return &GetResultBadRequest{}
@@ -259,6 +500,10 @@ type GetResultNotFound struct {
 	/* TTL for Fastly CDN caching. Example: max-age=3600
 	 */
 	SurrogateControl string
+
+	/* Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+	 */
+	SurrogateKey string
 }
 
 // IsSuccess returns true when this get result not found response has a 2xx status code
@@ -310,6 +555,313 @@ func (o *GetResultNotFound) readResponse(response runtime.ClientResponse, consum
 		o.SurrogateControl = hdrSurrogateControl
 	}
 
+	// hydrates response header Surrogate-Key
+	hdrSurrogateKey := response.GetHeader("Surrogate-Key")
+
+	if hdrSurrogateKey != "" {
+		o.SurrogateKey = hdrSurrogateKey
+	}
+
+	return nil
+}
+
+// NewGetResultNotAcceptable creates a GetResultNotAcceptable with default headers values
+func NewGetResultNotAcceptable() *GetResultNotAcceptable {
+	return &GetResultNotAcceptable{}
+}
+
+/*
+GetResultNotAcceptable describes a response with status code 406, with default header values.
+
+The sarif and probe formats, and envelopes, are only available as JSON, which the Accept header doesn't accept. The response depends on the Accept header, so it isn't cached.
+*/
+type GetResultNotAcceptable struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get result not acceptable response has a 2xx status code
+func (o *GetResultNotAcceptable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get result not acceptable response has a 3xx status code
+func (o *GetResultNotAcceptable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get result not acceptable response has a 4xx status code
+func (o *GetResultNotAcceptable) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get result not acceptable response has a 5xx status code
+func (o *GetResultNotAcceptable) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get result not acceptable response a status code equal to that given
+func (o *GetResultNotAcceptable) IsCode(code int) bool {
+	return code == 406
+}
+
+func (o *GetResultNotAcceptable) Error() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}/{repo}][%d] getResultNotAcceptable  %+v", 406, o.Payload)
+}
+
+func (o *GetResultNotAcceptable) String() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}/{repo}][%d] getResultNotAcceptable  %+v", 406, o.Payload)
+}
+
+func (o *GetResultNotAcceptable) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetResultNotAcceptable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetResultGone creates a GetResultGone with default headers values
+func NewGetResultGone() *GetResultGone {
+	return &GetResultGone{}
+}
+
+/*
+GetResultGone describes a response with status code 410, with default header values.
+
+The result was taken down. The message is the reason.
+*/
+type GetResultGone struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	/* Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+	 */
+	SurrogateKey string
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get result gone response has a 2xx status code
+func (o *GetResultGone) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get result gone response has a 3xx status code
+func (o *GetResultGone) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get result gone response has a 4xx status code
+func (o *GetResultGone) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get result gone response has a 5xx status code
+func (o *GetResultGone) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get result gone response a status code equal to that given
+func (o *GetResultGone) IsCode(code int) bool {
+	return code == 410
+}
+
+func (o *GetResultGone) Error() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}/{repo}][%d] getResultGone  %+v", 410, o.Payload)
+}
+
+func (o *GetResultGone) String() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}/{repo}][%d] getResultGone  %+v", 410, o.Payload)
+}
+
+func (o *GetResultGone) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetResultGone) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	// hydrates response header Surrogate-Key
+	hdrSurrogateKey := response.GetHeader("Surrogate-Key")
+
+	if hdrSurrogateKey != "" {
+		o.SurrogateKey = hdrSurrogateKey
+	}
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetResultUnprocessableEntity creates a GetResultUnprocessableEntity with default headers values
+func NewGetResultUnprocessableEntity() *GetResultUnprocessableEntity {
+	return &GetResultUnprocessableEntity{}
+}
+
+/*
+GetResultUnprocessableEntity describes a response with status code 422, with default header values.
+
+The best available result is older than maxAge
+*/
+type GetResultUnprocessableEntity struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	/* Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+	 */
+	SurrogateKey     string
+	XScorecardAge    int64
+	XScorecardSource string
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get result unprocessable entity response has a 2xx status code
+func (o *GetResultUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get result unprocessable entity response has a 3xx status code
+func (o *GetResultUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get result unprocessable entity response has a 4xx status code
+func (o *GetResultUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get result unprocessable entity response has a 5xx status code
+func (o *GetResultUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get result unprocessable entity response a status code equal to that given
+func (o *GetResultUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+func (o *GetResultUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}/{repo}][%d] getResultUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *GetResultUnprocessableEntity) String() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}/{repo}][%d] getResultUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *GetResultUnprocessableEntity) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetResultUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	// hydrates response header Surrogate-Key
+	hdrSurrogateKey := response.GetHeader("Surrogate-Key")
+
+	if hdrSurrogateKey != "" {
+		o.SurrogateKey = hdrSurrogateKey
+	}
+
+	// hydrates response header X-Scorecard-Age
+	hdrXScorecardAge := response.GetHeader("X-Scorecard-Age")
+
+	if hdrXScorecardAge != "" {
+		valxScorecardAge, err := swag.ConvertInt64(hdrXScorecardAge)
+		if err != nil {
+			return errors.InvalidType("X-Scorecard-Age", "header", "int64", hdrXScorecardAge)
+		}
+		o.XScorecardAge = valxScorecardAge
+	}
+
+	// hydrates response header X-Scorecard-Source
+	hdrXScorecardSource := response.GetHeader("X-Scorecard-Source")
+
+	if hdrXScorecardSource != "" {
+		o.XScorecardSource = hdrXScorecardSource
+	}
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,291 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package results
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetScoreParams creates a new GetScoreParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetScoreParams() *GetScoreParams {
+	return &GetScoreParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetScoreParamsWithTimeout creates a new GetScoreParams object
+// with the ability to set a timeout on a request.
+func NewGetScoreParamsWithTimeout(timeout time.Duration) *GetScoreParams {
+	return &GetScoreParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetScoreParamsWithContext creates a new GetScoreParams object
+// with the ability to set a context for a request.
+func NewGetScoreParamsWithContext(ctx context.Context) *GetScoreParams {
+	return &GetScoreParams{
+		Context: ctx,
+	}
+}
+
+// NewGetScoreParamsWithHTTPClient creates a new GetScoreParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetScoreParamsWithHTTPClient(client *http.Client) *GetScoreParams {
+	return &GetScoreParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetScoreParams contains all the parameters to send to the API endpoint
+
+	for the get score operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetScoreParams struct {
+
+	/* Commit.
+
+	   SHA1 commit hash expressed in hexadecimal format
+	*/
+	Commit *string
+
+	/* Org.
+
+	   Name of the owner/organization of the repository
+	*/
+	Org string
+
+	/* Platform.
+
+	   VCS platform. eg. github.com
+	*/
+	Platform string
+
+	/* Repo.
+
+	   Name of the repository
+	*/
+	Repo string
+
+	/* Weights.
+
+	   Name of the weight profile
+
+	   Default: "default"
+	*/
+	Weights *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get score params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetScoreParams) WithDefaults() *GetScoreParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get score params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetScoreParams) SetDefaults() {
+	var (
+		weightsDefault = string("default")
+	)
+
+	val := GetScoreParams{
+		Weights: &weightsDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the get score params
+func (o *GetScoreParams) WithTimeout(timeout time.Duration) *GetScoreParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get score params
+func (o *GetScoreParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get score params
+func (o *GetScoreParams) WithContext(ctx context.Context) *GetScoreParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get score params
+func (o *GetScoreParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get score params
+func (o *GetScoreParams) WithHTTPClient(client *http.Client) *GetScoreParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get score params
+func (o *GetScoreParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCommit adds the commit to the get score params
+func (o *GetScoreParams) WithCommit(commit *string) *GetScoreParams {
+	o.SetCommit(commit)
+	return o
+}
+
+// SetCommit adds the commit to the get score params
+func (o *GetScoreParams) SetCommit(commit *string) {
+	o.Commit = commit
+}
+
+// WithOrg adds the org to the get score params
+func (o *GetScoreParams) WithOrg(org string) *GetScoreParams {
+	o.SetOrg(org)
+	return o
+}
+
+// SetOrg adds the org to the get score params
+func (o *GetScoreParams) SetOrg(org string) {
+	o.Org = org
+}
+
+// WithPlatform adds the platform to the get score params
+func (o *GetScoreParams) WithPlatform(platform string) *GetScoreParams {
+	o.SetPlatform(platform)
+	return o
+}
+
+// SetPlatform adds the platform to the get score params
+func (o *GetScoreParams) SetPlatform(platform string) {
+	o.Platform = platform
+}
+
+// WithRepo adds the repo to the get score params
+func (o *GetScoreParams) WithRepo(repo string) *GetScoreParams {
+	o.SetRepo(repo)
+	return o
+}
+
+// SetRepo adds the repo to the get score params
+func (o *GetScoreParams) SetRepo(repo string) {
+	o.Repo = repo
+}
+
+// WithWeights adds the weights to the get score params
+func (o *GetScoreParams) WithWeights(weights *string) *GetScoreParams {
+	o.SetWeights(weights)
+	return o
+}
+
+// SetWeights adds the weights to the get score params
+func (o *GetScoreParams) SetWeights(weights *string) {
+	o.Weights = weights
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetScoreParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Commit != nil {
+
+		// query param commit
+		var qrCommit string
+
+		if o.Commit != nil {
+			qrCommit = *o.Commit
+		}
+		qCommit := qrCommit
+		if qCommit != "" {
+
+			if err := r.SetQueryParam("commit", qCommit); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param org
+	if err := r.SetPathParam("org", o.Org); err != nil {
+		return err
+	}
+
+	// path param platform
+	if err := r.SetPathParam("platform", o.Platform); err != nil {
+		return err
+	}
+
+	// path param repo
+	if err := r.SetPathParam("repo", o.Repo); err != nil {
+		return err
+	}
+
+	if o.Weights != nil {
+
+		// query param weights
+		var qrWeights string
+
+		if o.Weights != nil {
+			qrWeights = *o.Weights
+		}
+		qWeights := qrWeights
+		if qWeights != "" {
+
+			if err := r.SetQueryParam("weights", qWeights); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,511 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package results
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// GetScoreReader is a Reader for the GetScore structure.
+type GetScoreReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetScoreReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetScoreOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewGetScoreBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewGetScoreNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 410:
+		result := NewGetScoreGone()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewGetScoreDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetScoreOK creates a GetScoreOK with default headers values
+func NewGetScoreOK() *GetScoreOK {
+	return &GetScoreOK{}
+}
+
+/*
+GetScoreOK describes a response with status code 200, with default header values.
+
+The recomputed score
+*/
+type GetScoreOK struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	/* Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+	 */
+	SurrogateKey string
+
+	Payload *models.WeightedScore
+}
+
+// IsSuccess returns true when this get score o k response has a 2xx status code
+func (o *GetScoreOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get score o k response has a 3xx status code
+func (o *GetScoreOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get score o k response has a 4xx status code
+func (o *GetScoreOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get score o k response has a 5xx status code
+func (o *GetScoreOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get score o k response a status code equal to that given
+func (o *GetScoreOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *GetScoreOK) Error() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}/{repo}/score][%d] getScoreOK  %+v", 200, o.Payload)
+}
+
+func (o *GetScoreOK) String() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}/{repo}/score][%d] getScoreOK  %+v", 200, o.Payload)
+}
+
+func (o *GetScoreOK) GetPayload() *models.WeightedScore {
+	return o.Payload
+}
+
+func (o *GetScoreOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	// hydrates response header Surrogate-Key
+	hdrSurrogateKey := response.GetHeader("Surrogate-Key")
+
+	if hdrSurrogateKey != "" {
+		o.SurrogateKey = hdrSurrogateKey
+	}
+
+	o.Payload = new(models.WeightedScore)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetScoreBadRequest creates a GetScoreBadRequest with default headers values
+func NewGetScoreBadRequest() *GetScoreBadRequest {
+	return &GetScoreBadRequest{}
+}
+
+/*
+GetScoreBadRequest describes a response with status code 400, with default header values.
+
+The request provided to the server was invalid
+*/
+type GetScoreBadRequest struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get score bad request response has a 2xx status code
+func (o *GetScoreBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get score bad request response has a 3xx status code
+func (o *GetScoreBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get score bad request response has a 4xx status code
+func (o *GetScoreBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get score bad request response has a 5xx status code
+func (o *GetScoreBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get score bad request response a status code equal to that given
+func (o *GetScoreBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *GetScoreBadRequest) Error() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}/{repo}/score][%d] getScoreBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetScoreBadRequest) String() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}/{repo}/score][%d] getScoreBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetScoreBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetScoreBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetScoreNotFound creates a GetScoreNotFound with default headers values
+func NewGetScoreNotFound() *GetScoreNotFound {
+	return &GetScoreNotFound{}
+}
+
+/*
+GetScoreNotFound describes a response with status code 404, with default header values.
+
+The content requested could not be found
+*/
+type GetScoreNotFound struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	/* Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+	 */
+	SurrogateKey string
+}
+
+// IsSuccess returns true when this get score not found response has a 2xx status code
+func (o *GetScoreNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get score not found response has a 3xx status code
+func (o *GetScoreNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get score not found response has a 4xx status code
+func (o *GetScoreNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get score not found response has a 5xx status code
+func (o *GetScoreNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get score not found response a status code equal to that given
+func (o *GetScoreNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *GetScoreNotFound) Error() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}/{repo}/score][%d] getScoreNotFound ", 404)
+}
+
+func (o *GetScoreNotFound) String() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}/{repo}/score][%d] getScoreNotFound ", 404)
+}
+
+func (o *GetScoreNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	// hydrates response header Surrogate-Key
+	hdrSurrogateKey := response.GetHeader("Surrogate-Key")
+
+	if hdrSurrogateKey != "" {
+		o.SurrogateKey = hdrSurrogateKey
+	}
+
+	return nil
+}
+
+// NewGetScoreGone creates a GetScoreGone with default headers values
+func NewGetScoreGone() *GetScoreGone {
+	return &GetScoreGone{}
+}
+
+/*
+GetScoreGone describes a response with status code 410, with default header values.
+
+The result was taken down. The message is the reason.
+*/
+type GetScoreGone struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	/* Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+	 */
+	SurrogateKey string
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this get score gone response has a 2xx status code
+func (o *GetScoreGone) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get score gone response has a 3xx status code
+func (o *GetScoreGone) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get score gone response has a 4xx status code
+func (o *GetScoreGone) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get score gone response has a 5xx status code
+func (o *GetScoreGone) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get score gone response a status code equal to that given
+func (o *GetScoreGone) IsCode(code int) bool {
+	return code == 410
+}
+
+func (o *GetScoreGone) Error() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}/{repo}/score][%d] getScoreGone  %+v", 410, o.Payload)
+}
+
+func (o *GetScoreGone) String() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}/{repo}/score][%d] getScoreGone  %+v", 410, o.Payload)
+}
+
+func (o *GetScoreGone) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetScoreGone) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	// hydrates response header Surrogate-Key
+	hdrSurrogateKey := response.GetHeader("Surrogate-Key")
+
+	if hdrSurrogateKey != "" {
+		o.SurrogateKey = hdrSurrogateKey
+	}
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetScoreDefault creates a GetScoreDefault with default headers values
+func NewGetScoreDefault(code int) *GetScoreDefault {
+	return &GetScoreDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetScoreDefault describes a response with status code -1, with default header values.
+
+There was an internal error in the server while processing the request
+*/
+type GetScoreDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the get score default response
+func (o *GetScoreDefault) Code() int {
+	return o._statusCode
+}
+
+// IsSuccess returns true when this get score default response has a 2xx status code
+func (o *GetScoreDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get score default response has a 3xx status code
+func (o *GetScoreDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get score default response has a 4xx status code
+func (o *GetScoreDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get score default response has a 5xx status code
+func (o *GetScoreDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get score default response a status code equal to that given
+func (o *GetScoreDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+func (o *GetScoreDefault) Error() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}/{repo}/score][%d] getScore default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetScoreDefault) String() string {
+	return fmt.Sprintf("[GET /projects/{platform}/{org}/{repo}/score][%d] getScore default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetScoreDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetScoreDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -43,15 +43,103 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	EvaluatePolicy(params *EvaluatePolicyParams, opts ...ClientOption) (*EvaluatePolicyOK, error)
+
+	GetOrgResults(params *GetOrgResultsParams, opts ...ClientOption) (*GetOrgResultsOK, error)
+
 	GetResult(params *GetResultParams, opts ...ClientOption) (*GetResultOK, error)
 
+	GetScore(params *GetScoreParams, opts ...ClientOption) (*GetScoreOK, error)
+
 	PostResult(params *PostResultParams, opts ...ClientOption) (*PostResultCreated, error)
 
+	SearchResults(params *SearchResultsParams, opts ...ClientOption) (*SearchResultsOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+EvaluatePolicy evaluates a repository s scorecard result against a policy
+
+Evaluates the stored result against the policy, and returns whether it passes with the reason for every failed rule. A failing result is still a successful evaluation, so it's returned with a 200.
+*/
+func (a *Client) EvaluatePolicy(params *EvaluatePolicyParams, opts ...ClientOption) (*EvaluatePolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewEvaluatePolicyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "evaluatePolicy",
+		Method:             "POST",
+		PathPattern:        "/projects/{platform}/{org}/{repo}/evaluate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &EvaluatePolicyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*EvaluatePolicyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*EvaluatePolicyDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetOrgResults gets the latest scorecard results of every repository in an organization
+
+Lists the repositories of the organization in the search index, with their latest aggregate and per-check scores, and summary statistics over those repositories. Inconclusive check scores (-1) are excluded from means and medians, but included in the distributions. Repositories are paginated by name with limit and offset; truncated is set when there are more. The statistics are over every repository of the organization, not only the page's, so they are the same on every page. Repositories without a latest result, or whose latest result was taken down, are left out, so a page can have fewer than limit repositories.
+*/
+func (a *Client) GetOrgResults(params *GetOrgResultsParams, opts ...ClientOption) (*GetOrgResultsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetOrgResultsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getOrgResults",
+		Method:             "GET",
+		PathPattern:        "/projects/{platform}/{org}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetOrgResultsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetOrgResultsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetOrgResultsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 GetResult gets a repository s scorecard result
+
+The json format is also available as YAML, as a CSV table of the checks, or as a Markdown summary, depending on the Accept header. It's the only operation with these media types: the others only produce JSON.
 */
 func (a *Client) GetResult(params *GetResultParams, opts ...ClientOption) (*GetResultOK, error) {
 	// TODO: Validate the params before sending
@@ -62,7 +150,7 @@ func (a *Client) GetResult(params *GetResultParams, opts ...ClientOption) (*GetR
 		ID:                 "getResult",
 		Method:             "GET",
 		PathPattern:        "/projects/{platform}/{org}/{repo}",
-		ProducesMediaTypes: []string{"application/json"},
+		ProducesMediaTypes: []string{"application/json", "application/yaml", "text/csv", "text/markdown"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
@@ -87,6 +175,45 @@ func (a *Client) GetResult(params *GetResultParams, opts ...ClientOption) (*GetR
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+GetScore recomputes a repository s aggregate score with a weight profile
+
+Recomputes the aggregate score from the stored check scores, rather than returning the score the result was published with, so repositories can be ranked consistently. The `default` profile uses Scorecard's risk weights (Critical 10, High 7.5, Medium 5, Low 2.5); other profiles are configured by the server operator. Inconclusive checks (-1) and checks with no weight don't count, and the score is -1 if no check does.
+*/
+func (a *Client) GetScore(params *GetScoreParams, opts ...ClientOption) (*GetScoreOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetScoreParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getScore",
+		Method:             "GET",
+		PathPattern:        "/projects/{platform}/{org}/{repo}/score",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetScoreReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetScoreOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetScoreDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 PostResult publishes a repository s o ID c verified scorecard result
 */
@@ -124,6 +251,45 @@ func (a *Client) PostResult(params *PostResultParams, opts ...ClientOption) (*Po
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+SearchResults searches the latest scorecard results of repositories
+
+Searches the index of the latest result of each repository. The index is updated whenever a result is published, and backfilled from the weekly scan. Results are paginated with limit and offset; truncated is set when there are more. Score filters apply to the given check, or to the aggregate score without one. Inconclusive check scores (-1) never match score filters. Taken down results are left out, so a page can have fewer than limit results even when truncated is set. Unless the server has a database index, searches of many repositories must filter by platform and org.
+*/
+func (a *Client) SearchResults(params *SearchResultsParams, opts ...ClientOption) (*SearchResultsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSearchResultsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "searchResults",
+		Method:             "GET",
+		PathPattern:        "/search",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SearchResultsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SearchResultsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*SearchResultsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -0,0 +1,507 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package results
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewSearchResultsParams creates a new SearchResultsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSearchResultsParams() *SearchResultsParams {
+	return &SearchResultsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSearchResultsParamsWithTimeout creates a new SearchResultsParams object
+// with the ability to set a timeout on a request.
+func NewSearchResultsParamsWithTimeout(timeout time.Duration) *SearchResultsParams {
+	return &SearchResultsParams{
+		timeout: timeout,
+	}
+}
+
+// NewSearchResultsParamsWithContext creates a new SearchResultsParams object
+// with the ability to set a context for a request.
+func NewSearchResultsParamsWithContext(ctx context.Context) *SearchResultsParams {
+	return &SearchResultsParams{
+		Context: ctx,
+	}
+}
+
+// NewSearchResultsParamsWithHTTPClient creates a new SearchResultsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSearchResultsParamsWithHTTPClient(client *http.Client) *SearchResultsParams {
+	return &SearchResultsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SearchResultsParams contains all the parameters to send to the API endpoint
+
+	for the search results operation.
+
+	Typically these are written to a http.Request.
+*/
+type SearchResultsParams struct {
+
+	/* Check.
+
+	   Name of the check the score filters apply to. eg. Branch-Protection
+	*/
+	Check *string
+
+	/* Limit.
+
+	   Maximum number of results to return
+
+	   Default: 100
+	*/
+	Limit *int64
+
+	/* MaxScore.
+
+	   Maximum score, inclusive
+	*/
+	MaxScore *float64
+
+	/* MinScore.
+
+	   Minimum score, inclusive
+	*/
+	MinScore *float64
+
+	/* Offset.
+
+	   Number of matching results to skip, for pagination
+	*/
+	Offset *int64
+
+	/* Org.
+
+	   Name of the owner/organization of the repositories
+	*/
+	Org *string
+
+	/* Platform.
+
+	   VCS platform. eg. github.com
+	*/
+	Platform *string
+
+	/* ScorecardVersion.
+
+	   Scorecard version, with * and ? wildcards. eg. v5.*
+	*/
+	ScorecardVersion *string
+
+	/* Sort.
+
+	   Order of the results. score is the score of the given check, or the aggregate score without one. A leading - sorts in descending order. Ties are sorted by name.
+
+
+	   Default: "name"
+	*/
+	Sort *string
+
+	/* UpdatedSince.
+
+	   Only results computed on or after this date (YYYY-MM-DD or RFC 3339)
+	*/
+	UpdatedSince *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the search results params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SearchResultsParams) WithDefaults() *SearchResultsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the search results params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SearchResultsParams) SetDefaults() {
+	var (
+		limitDefault = int64(100)
+
+		offsetDefault = int64(0)
+
+		sortDefault = string("name")
+	)
+
+	val := SearchResultsParams{
+		Limit:  &limitDefault,
+		Offset: &offsetDefault,
+		Sort:   &sortDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the search results params
+func (o *SearchResultsParams) WithTimeout(timeout time.Duration) *SearchResultsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the search results params
+func (o *SearchResultsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the search results params
+func (o *SearchResultsParams) WithContext(ctx context.Context) *SearchResultsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the search results params
+func (o *SearchResultsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the search results params
+func (o *SearchResultsParams) WithHTTPClient(client *http.Client) *SearchResultsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the search results params
+func (o *SearchResultsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCheck adds the check to the search results params
+func (o *SearchResultsParams) WithCheck(check *string) *SearchResultsParams {
+	o.SetCheck(check)
+	return o
+}
+
+// SetCheck adds the check to the search results params
+func (o *SearchResultsParams) SetCheck(check *string) {
+	o.Check = check
+}
+
+// WithLimit adds the limit to the search results params
+func (o *SearchResultsParams) WithLimit(limit *int64) *SearchResultsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the search results params
+func (o *SearchResultsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithMaxScore adds the maxScore to the search results params
+func (o *SearchResultsParams) WithMaxScore(maxScore *float64) *SearchResultsParams {
+	o.SetMaxScore(maxScore)
+	return o
+}
+
+// SetMaxScore adds the maxScore to the search results params
+func (o *SearchResultsParams) SetMaxScore(maxScore *float64) {
+	o.MaxScore = maxScore
+}
+
+// WithMinScore adds the minScore to the search results params
+func (o *SearchResultsParams) WithMinScore(minScore *float64) *SearchResultsParams {
+	o.SetMinScore(minScore)
+	return o
+}
+
+// SetMinScore adds the minScore to the search results params
+func (o *SearchResultsParams) SetMinScore(minScore *float64) {
+	o.MinScore = minScore
+}
+
+// WithOffset adds the offset to the search results params
+func (o *SearchResultsParams) WithOffset(offset *int64) *SearchResultsParams {
+	o.SetOffset(offset)
+	return o
+}
+
+// SetOffset adds the offset to the search results params
+func (o *SearchResultsParams) SetOffset(offset *int64) {
+	o.Offset = offset
+}
+
+// WithOrg adds the org to the search results params
+func (o *SearchResultsParams) WithOrg(org *string) *SearchResultsParams {
+	o.SetOrg(org)
+	return o
+}
+
+// SetOrg adds the org to the search results params
+func (o *SearchResultsParams) SetOrg(org *string) {
+	o.Org = org
+}
+
+// WithPlatform adds the platform to the search results params
+func (o *SearchResultsParams) WithPlatform(platform *string) *SearchResultsParams {
+	o.SetPlatform(platform)
+	return o
+}
+
+// SetPlatform adds the platform to the search results params
+func (o *SearchResultsParams) SetPlatform(platform *string) {
+	o.Platform = platform
+}
+
+// WithScorecardVersion adds the scorecardVersion to the search results params
+func (o *SearchResultsParams) WithScorecardVersion(scorecardVersion *string) *SearchResultsParams {
+	o.SetScorecardVersion(scorecardVersion)
+	return o
+}
+
+// SetScorecardVersion adds the scorecardVersion to the search results params
+func (o *SearchResultsParams) SetScorecardVersion(scorecardVersion *string) {
+	o.ScorecardVersion = scorecardVersion
+}
+
+// WithSort adds the sort to the search results params
+func (o *SearchResultsParams) WithSort(sort *string) *SearchResultsParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the search results params
+func (o *SearchResultsParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
+// WithUpdatedSince adds the updatedSince to the search results params
+func (o *SearchResultsParams) WithUpdatedSince(updatedSince *string) *SearchResultsParams {
+	o.SetUpdatedSince(updatedSince)
+	return o
+}
+
+// SetUpdatedSince adds the updatedSince to the search results params
+func (o *SearchResultsParams) SetUpdatedSince(updatedSince *string) {
+	o.UpdatedSince = updatedSince
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SearchResultsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Check != nil {
+
+		// query param check
+		var qrCheck string
+
+		if o.Check != nil {
+			qrCheck = *o.Check
+		}
+		qCheck := qrCheck
+		if qCheck != "" {
+
+			if err := r.SetQueryParam("check", qCheck); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.MaxScore != nil {
+
+		// query param maxScore
+		var qrMaxScore float64
+
+		if o.MaxScore != nil {
+			qrMaxScore = *o.MaxScore
+		}
+		qMaxScore := swag.FormatFloat64(qrMaxScore)
+		if qMaxScore != "" {
+
+			if err := r.SetQueryParam("maxScore", qMaxScore); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.MinScore != nil {
+
+		// query param minScore
+		var qrMinScore float64
+
+		if o.MinScore != nil {
+			qrMinScore = *o.MinScore
+		}
+		qMinScore := swag.FormatFloat64(qrMinScore)
+		if qMinScore != "" {
+
+			if err := r.SetQueryParam("minScore", qMinScore); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Offset != nil {
+
+		// query param offset
+		var qrOffset int64
+
+		if o.Offset != nil {
+			qrOffset = *o.Offset
+		}
+		qOffset := swag.FormatInt64(qrOffset)
+		if qOffset != "" {
+
+			if err := r.SetQueryParam("offset", qOffset); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Org != nil {
+
+		// query param org
+		var qrOrg string
+
+		if o.Org != nil {
+			qrOrg = *o.Org
+		}
+		qOrg := qrOrg
+		if qOrg != "" {
+
+			if err := r.SetQueryParam("org", qOrg); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Platform != nil {
+
+		// query param platform
+		var qrPlatform string
+
+		if o.Platform != nil {
+			qrPlatform = *o.Platform
+		}
+		qPlatform := qrPlatform
+		if qPlatform != "" {
+
+			if err := r.SetQueryParam("platform", qPlatform); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.ScorecardVersion != nil {
+
+		// query param scorecardVersion
+		var qrScorecardVersion string
+
+		if o.ScorecardVersion != nil {
+			qrScorecardVersion = *o.ScorecardVersion
+		}
+		qScorecardVersion := qrScorecardVersion
+		if qScorecardVersion != "" {
+
+			if err := r.SetQueryParam("scorecardVersion", qScorecardVersion); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.UpdatedSince != nil {
+
+		// query param updatedSince
+		var qrUpdatedSince string
+
+		if o.UpdatedSince != nil {
+			qrUpdatedSince = *o.UpdatedSince
+		}
+		qUpdatedSince := qrUpdatedSince
+		if qUpdatedSince != "" {
+
+			if err := r.SetQueryParam("updatedSince", qUpdatedSince); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
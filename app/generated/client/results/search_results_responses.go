@@ -0,0 +1,307 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package results
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// SearchResultsReader is a Reader for the SearchResults structure.
+type SearchResultsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SearchResultsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSearchResultsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewSearchResultsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewSearchResultsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewSearchResultsOK creates a SearchResultsOK with default headers values
+func NewSearchResultsOK() *SearchResultsOK {
+	return &SearchResultsOK{}
+}
+
+/*
+SearchResultsOK describes a response with status code 200, with default header values.
+
+The matching repositories
+*/
+type SearchResultsOK struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	Payload *models.SearchResults
+}
+
+// IsSuccess returns true when this search results o k response has a 2xx status code
+func (o *SearchResultsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this search results o k response has a 3xx status code
+func (o *SearchResultsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this search results o k response has a 4xx status code
+func (o *SearchResultsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this search results o k response has a 5xx status code
+func (o *SearchResultsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this search results o k response a status code equal to that given
+func (o *SearchResultsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *SearchResultsOK) Error() string {
+	return fmt.Sprintf("[GET /search][%d] searchResultsOK  %+v", 200, o.Payload)
+}
+
+func (o *SearchResultsOK) String() string {
+	return fmt.Sprintf("[GET /search][%d] searchResultsOK  %+v", 200, o.Payload)
+}
+
+func (o *SearchResultsOK) GetPayload() *models.SearchResults {
+	return o.Payload
+}
+
+func (o *SearchResultsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	o.Payload = new(models.SearchResults)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSearchResultsBadRequest creates a SearchResultsBadRequest with default headers values
+func NewSearchResultsBadRequest() *SearchResultsBadRequest {
+	return &SearchResultsBadRequest{}
+}
+
+/*
+SearchResultsBadRequest describes a response with status code 400, with default header values.
+
+The request provided to the server was invalid
+*/
+type SearchResultsBadRequest struct {
+
+	/* TTL for browser caching. Example: max-age=3600
+	 */
+	CacheControl string
+
+	/* TTL for Fastly CDN caching. Example: max-age=3600
+	 */
+	SurrogateControl string
+
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this search results bad request response has a 2xx status code
+func (o *SearchResultsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this search results bad request response has a 3xx status code
+func (o *SearchResultsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this search results bad request response has a 4xx status code
+func (o *SearchResultsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this search results bad request response has a 5xx status code
+func (o *SearchResultsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this search results bad request response a status code equal to that given
+func (o *SearchResultsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *SearchResultsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /search][%d] searchResultsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *SearchResultsBadRequest) String() string {
+	return fmt.Sprintf("[GET /search][%d] searchResultsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *SearchResultsBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *SearchResultsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Cache-Control
+	hdrCacheControl := response.GetHeader("Cache-Control")
+
+	if hdrCacheControl != "" {
+		o.CacheControl = hdrCacheControl
+	}
+
+	// hydrates response header Surrogate-Control
+	hdrSurrogateControl := response.GetHeader("Surrogate-Control")
+
+	if hdrSurrogateControl != "" {
+		o.SurrogateControl = hdrSurrogateControl
+	}
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSearchResultsDefault creates a SearchResultsDefault with default headers values
+func NewSearchResultsDefault(code int) *SearchResultsDefault {
+	return &SearchResultsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+SearchResultsDefault describes a response with status code -1, with default header values.
+
+There was an internal error in the server while processing the request
+*/
+type SearchResultsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the search results default response
+func (o *SearchResultsDefault) Code() int {
+	return o._statusCode
+}
+
+// IsSuccess returns true when this search results default response has a 2xx status code
+func (o *SearchResultsDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this search results default response has a 3xx status code
+func (o *SearchResultsDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this search results default response has a 4xx status code
+func (o *SearchResultsDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this search results default response has a 5xx status code
+func (o *SearchResultsDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this search results default response a status code equal to that given
+func (o *SearchResultsDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+func (o *SearchResultsDefault) Error() string {
+	return fmt.Sprintf("[GET /search][%d] searchResults default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *SearchResultsDefault) String() string {
+	return fmt.Sprintf("[GET /search][%d] searchResults default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *SearchResultsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *SearchResultsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AuditEvent audit event
+//
+// swagger:model AuditEvent
+type AuditEvent struct {
+
+	// time
+	// Format: date-time
+	Time strfmt.DateTime `json:"time,omitempty"`
+
+	// Name of the admin token used
+	Actor string `json:"actor,omitempty"`
+
+	// action
+	// Enum: [tombstone restore annotate]
+	Action string `json:"action,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// commit
+	Commit string `json:"commit,omitempty"`
+
+	// reason
+	Reason string `json:"reason,omitempty"`
+
+	// annotation
+	Annotation string `json:"annotation,omitempty"`
+}
+
+// Validate validates this audit event
+func (m *AuditEvent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AuditEvent) validateTime(formats strfmt.Registry) error {
+	if swag.IsZero(m.Time) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("time", "body", "date-time", m.Time.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var auditEventTypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["tombstone","restore","annotate"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		auditEventTypeActionPropEnum = append(auditEventTypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// AuditEventActionTombstone captures enum value "tombstone"
+	AuditEventActionTombstone string = "tombstone"
+
+	// AuditEventActionRestore captures enum value "restore"
+	AuditEventActionRestore string = "restore"
+
+	// AuditEventActionAnnotate captures enum value "annotate"
+	AuditEventActionAnnotate string = "annotate"
+)
+
+// prop value enum
+func (m *AuditEvent) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, auditEventTypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *AuditEvent) validateAction(formats strfmt.Registry) error {
+	if swag.IsZero(m.Action) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateActionEnum("action", "body", m.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this audit event based on context it is used
+func (m *AuditEvent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AuditEvent) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AuditEvent) UnmarshalBinary(b []byte) error {
+	var res AuditEvent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,77 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// FindingLocation finding location
+//
+// swagger:model FindingLocation
+type FindingLocation struct {
+
+	// Scorecard file type, e.g. 1 for source files and 4 for URLs
+	Type int64 `json:"type,omitempty"`
+
+	// path
+	Path string `json:"path,omitempty"`
+
+	// line start
+	LineStart int64 `json:"lineStart,omitempty"`
+
+	// line end
+	LineEnd int64 `json:"lineEnd,omitempty"`
+
+	// snippet
+	Snippet string `json:"snippet,omitempty"`
+}
+
+// Validate validates this finding location
+func (m *FindingLocation) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this finding location based on context it is used
+func (m *FindingLocation) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *FindingLocation) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *FindingLocation) UnmarshalBinary(b []byte) error {
+	var res FindingLocation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,74 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// FindingRemediation finding remediation
+//
+// swagger:model FindingRemediation
+type FindingRemediation struct {
+
+	// text
+	Text string `json:"text,omitempty"`
+
+	// markdown
+	Markdown string `json:"markdown,omitempty"`
+
+	// Scorecard remediation effort, 1 (low) to 3 (high)
+	Effort int64 `json:"effort,omitempty"`
+
+	// patch
+	Patch string `json:"patch,omitempty"`
+}
+
+// Validate validates this finding remediation
+func (m *FindingRemediation) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this finding remediation based on context it is used
+func (m *FindingRemediation) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *FindingRemediation) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *FindingRemediation) UnmarshalBinary(b []byte) error {
+	var res FindingRemediation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,180 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// OrgSummary org summary
+//
+// swagger:model OrgSummary
+type OrgSummary struct {
+
+	// Number of repositories of the organization with results, across all pages
+	Repos int64 `json:"repos"`
+
+	// score
+	Score *ScoreStats `json:"score,omitempty"`
+
+	// checks
+	Checks []*CheckStats `json:"checks"`
+}
+
+// Validate validates this org summary
+func (m *OrgSummary) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateScore(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateChecks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OrgSummary) validateScore(formats strfmt.Registry) error {
+	if swag.IsZero(m.Score) { // not required
+		return nil
+	}
+
+	if m.Score != nil {
+		if err := m.Score.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("score")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("score")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *OrgSummary) validateChecks(formats strfmt.Registry) error {
+	if swag.IsZero(m.Checks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Checks); i++ {
+		if swag.IsZero(m.Checks[i]) { // not required
+			continue
+		}
+
+		if m.Checks[i] != nil {
+			if err := m.Checks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("checks" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("checks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this org summary based on the context it is used
+func (m *OrgSummary) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateScore(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateChecks(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OrgSummary) contextValidateScore(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Score != nil {
+		if err := m.Score.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("score")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("score")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *OrgSummary) contextValidateChecks(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Checks); i++ {
+
+		if m.Checks[i] != nil {
+			if err := m.Checks[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("checks" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("checks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *OrgSummary) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *OrgSummary) UnmarshalBinary(b []byte) error {
+	var res OrgSummary
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
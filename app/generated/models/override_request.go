@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// OverrideRequest override request
+//
+// swagger:model OverrideRequest
+type OverrideRequest struct {
+
+	// SHA1 of the commit whose result to override, or empty for the whole repository
+	// Pattern: ^[0-9a-fA-F]{40}$
+	Commit string `json:"commit,omitempty"`
+
+	// Why the change is made, recorded in the audit log and returned with 410 responses
+	// Required: true
+	// Min Length: 1
+	Reason *string `json:"reason"`
+
+	// Note to attach to the results, for annotateResult
+	Annotation string `json:"annotation,omitempty"`
+}
+
+// Validate validates this override request
+func (m *OverrideRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCommit(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReason(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OverrideRequest) validateCommit(formats strfmt.Registry) error {
+	if swag.IsZero(m.Commit) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("commit", "body", m.Commit, `^[0-9a-fA-F]{40}$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OverrideRequest) validateReason(formats strfmt.Registry) error {
+
+	if err := validate.Required("reason", "body", m.Reason); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("reason", "body", *m.Reason, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this override request based on context it is used
+func (m *OverrideRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *OverrideRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *OverrideRequest) UnmarshalBinary(b []byte) error {
+	var res OverrideRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
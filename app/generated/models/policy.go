@@ -0,0 +1,78 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// Policy Rules a result must satisfy. Rules which aren't set aren't checked.
+//
+// swagger:model Policy
+type Policy struct {
+
+	// Minimum aggregate score, 0 to 10
+	MinScore float64 `json:"minScore,omitempty"`
+
+	// Minimum score, 0 to 10, of each listed check. Listed checks which are missing or inconclusive fail.
+	//
+	MinCheckScores map[string]int64 `json:"minCheckScores,omitempty"`
+
+	// Checks which must have a conclusive score, whatever it is
+	RequiredChecks []string `json:"requiredChecks"`
+
+	// Maximum age of the result in days, from its date
+	MaxAgeDays int64 `json:"maxAgeDays,omitempty"`
+
+	// Minimum semantic version of Scorecard the result was computed with, e.g. v5.0.0
+	MinScorecardVersion string `json:"minScorecardVersion,omitempty"`
+}
+
+// Validate validates this policy
+func (m *Policy) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this policy based on context it is used
+func (m *Policy) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Policy) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Policy) UnmarshalBinary(b []byte) error {
+	var res Policy
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
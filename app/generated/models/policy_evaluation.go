@@ -0,0 +1,146 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// PolicyEvaluation policy evaluation
+//
+// swagger:model PolicyEvaluation
+type PolicyEvaluation struct {
+
+	// pass
+	Pass bool `json:"pass"`
+
+	// Full name of the repository, e.g. github.com/ossf/scorecard
+	Name string `json:"name,omitempty"`
+
+	// date
+	Date string `json:"date,omitempty"`
+
+	// commit
+	Commit string `json:"commit,omitempty"`
+
+	// scorecard version
+	ScorecardVersion string `json:"scorecardVersion,omitempty"`
+
+	// Every rule the result failed
+	Violations []*PolicyViolation `json:"violations"`
+}
+
+// Validate validates this policy evaluation
+func (m *PolicyEvaluation) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateViolations(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PolicyEvaluation) validateViolations(formats strfmt.Registry) error {
+	if swag.IsZero(m.Violations) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Violations); i++ {
+		if swag.IsZero(m.Violations[i]) { // not required
+			continue
+		}
+
+		if m.Violations[i] != nil {
+			if err := m.Violations[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("violations" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("violations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this policy evaluation based on the context it is used
+func (m *PolicyEvaluation) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateViolations(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PolicyEvaluation) contextValidateViolations(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Violations); i++ {
+
+		if m.Violations[i] != nil {
+			if err := m.Violations[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("violations" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("violations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PolicyEvaluation) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PolicyEvaluation) UnmarshalBinary(b []byte) error {
+	var res PolicyEvaluation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
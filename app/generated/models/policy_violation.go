@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PolicyViolation policy violation
+//
+// swagger:model PolicyViolation
+type PolicyViolation struct {
+
+	// rule
+	// Enum: [minScore minCheckScores requiredChecks maxAgeDays minScorecardVersion]
+	Rule string `json:"rule,omitempty"`
+
+	// Check the violation is about, for per-check rules
+	Check string `json:"check,omitempty"`
+
+	// message
+	Message string `json:"message,omitempty"`
+}
+
+// Validate validates this policy violation
+func (m *PolicyViolation) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRule(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var policyViolationTypeRulePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["minScore","minCheckScores","requiredChecks","maxAgeDays","minScorecardVersion"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		policyViolationTypeRulePropEnum = append(policyViolationTypeRulePropEnum, v)
+	}
+}
+
+const (
+
+	// PolicyViolationRuleMinScore captures enum value "minScore"
+	PolicyViolationRuleMinScore string = "minScore"
+
+	// PolicyViolationRuleMinCheckScores captures enum value "minCheckScores"
+	PolicyViolationRuleMinCheckScores string = "minCheckScores"
+
+	// PolicyViolationRuleRequiredChecks captures enum value "requiredChecks"
+	PolicyViolationRuleRequiredChecks string = "requiredChecks"
+
+	// PolicyViolationRuleMaxAgeDays captures enum value "maxAgeDays"
+	PolicyViolationRuleMaxAgeDays string = "maxAgeDays"
+
+	// PolicyViolationRuleMinScorecardVersion captures enum value "minScorecardVersion"
+	PolicyViolationRuleMinScorecardVersion string = "minScorecardVersion"
+)
+
+// prop value enum
+func (m *PolicyViolation) validateRuleEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, policyViolationTypeRulePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PolicyViolation) validateRule(formats strfmt.Registry) error {
+	if swag.IsZero(m.Rule) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateRuleEnum("rule", "body", m.Rule); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this policy violation based on context it is used
+func (m *PolicyViolation) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PolicyViolation) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PolicyViolation) UnmarshalBinary(b []byte) error {
+	var res PolicyViolation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
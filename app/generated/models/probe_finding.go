@@ -0,0 +1,238 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ProbeFinding probe finding
+//
+// swagger:model ProbeFinding
+type ProbeFinding struct {
+
+	// Name of the probe, e.g. hasDangerousWorkflowScriptInjection
+	Probe string `json:"probe,omitempty"`
+
+	// outcome
+	// Enum: [True False NotAvailable Error NotSupported NotApplicable]
+	Outcome string `json:"outcome,omitempty"`
+
+	// message
+	Message string `json:"message,omitempty"`
+
+	// location
+	Location *FindingLocation `json:"location,omitempty"`
+
+	// remediation
+	Remediation *FindingRemediation `json:"remediation,omitempty"`
+
+	// values
+	Values map[string]string `json:"values,omitempty"`
+}
+
+// Validate validates this probe finding
+func (m *ProbeFinding) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateOutcome(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLocation(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRemediation(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var probeFindingTypeOutcomePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["True","False","NotAvailable","Error","NotSupported","NotApplicable"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		probeFindingTypeOutcomePropEnum = append(probeFindingTypeOutcomePropEnum, v)
+	}
+}
+
+const (
+
+	// ProbeFindingOutcomeTrue captures enum value "True"
+	ProbeFindingOutcomeTrue string = "True"
+
+	// ProbeFindingOutcomeFalse captures enum value "False"
+	ProbeFindingOutcomeFalse string = "False"
+
+	// ProbeFindingOutcomeNotAvailable captures enum value "NotAvailable"
+	ProbeFindingOutcomeNotAvailable string = "NotAvailable"
+
+	// ProbeFindingOutcomeError captures enum value "Error"
+	ProbeFindingOutcomeError string = "Error"
+
+	// ProbeFindingOutcomeNotSupported captures enum value "NotSupported"
+	ProbeFindingOutcomeNotSupported string = "NotSupported"
+
+	// ProbeFindingOutcomeNotApplicable captures enum value "NotApplicable"
+	ProbeFindingOutcomeNotApplicable string = "NotApplicable"
+)
+
+// prop value enum
+func (m *ProbeFinding) validateOutcomeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, probeFindingTypeOutcomePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ProbeFinding) validateOutcome(formats strfmt.Registry) error {
+	if swag.IsZero(m.Outcome) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateOutcomeEnum("outcome", "body", m.Outcome); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ProbeFinding) validateLocation(formats strfmt.Registry) error {
+	if swag.IsZero(m.Location) { // not required
+		return nil
+	}
+
+	if m.Location != nil {
+		if err := m.Location.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("location")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("location")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ProbeFinding) validateRemediation(formats strfmt.Registry) error {
+	if swag.IsZero(m.Remediation) { // not required
+		return nil
+	}
+
+	if m.Remediation != nil {
+		if err := m.Remediation.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("remediation")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("remediation")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this probe finding based on the context it is used
+func (m *ProbeFinding) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateLocation(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRemediation(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ProbeFinding) contextValidateLocation(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Location != nil {
+		if err := m.Location.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("location")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("location")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ProbeFinding) contextValidateRemediation(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Remediation != nil {
+		if err := m.Remediation.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("remediation")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("remediation")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ProbeFinding) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ProbeFinding) UnmarshalBinary(b []byte) error {
+	var res ProbeFinding
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
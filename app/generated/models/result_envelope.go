@@ -0,0 +1,194 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ResultEnvelope A ScorecardResult with where it comes from and how old it is
+//
+// swagger:model ResultEnvelope
+type ResultEnvelope struct {
+
+	// source
+	// Enum: [action cron]
+	Source string `json:"source,omitempty"`
+
+	// Time the stored result was last written
+	// Format: date-time
+	LastModified strfmt.DateTime `json:"lastModified,omitempty"`
+
+	// Age in seconds of the stored result when the response was generated
+	Age int64 `json:"age"`
+
+	// result
+	Result *ScorecardResult `json:"result,omitempty"`
+}
+
+// Validate validates this result envelope
+func (m *ResultEnvelope) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSource(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastModified(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateResult(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var resultEnvelopeTypeSourcePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["action","cron"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		resultEnvelopeTypeSourcePropEnum = append(resultEnvelopeTypeSourcePropEnum, v)
+	}
+}
+
+const (
+
+	// ResultEnvelopeSourceAction captures enum value "action"
+	ResultEnvelopeSourceAction string = "action"
+
+	// ResultEnvelopeSourceCron captures enum value "cron"
+	ResultEnvelopeSourceCron string = "cron"
+)
+
+// prop value enum
+func (m *ResultEnvelope) validateSourceEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, resultEnvelopeTypeSourcePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ResultEnvelope) validateSource(formats strfmt.Registry) error {
+	if swag.IsZero(m.Source) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateSourceEnum("source", "body", m.Source); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ResultEnvelope) validateLastModified(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastModified) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("lastModified", "body", "date-time", m.LastModified.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ResultEnvelope) validateResult(formats strfmt.Registry) error {
+	if swag.IsZero(m.Result) { // not required
+		return nil
+	}
+
+	if m.Result != nil {
+		if err := m.Result.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("result")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("result")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this result envelope based on the context it is used
+func (m *ResultEnvelope) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateResult(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ResultEnvelope) contextValidateResult(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Result != nil {
+		if err := m.Result.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("result")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("result")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ResultEnvelope) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ResultEnvelope) UnmarshalBinary(b []byte) error {
+	var res ResultEnvelope
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // ScorecardCheck scorecard check
@@ -36,7 +37,9 @@ type ScorecardCheck struct {
 	// name
 	Name string `json:"name,omitempty"`
 
-	// score
+	// Score of the check, or -1 if inconclusive
+	// Maximum: 10
+	// Minimum: -1
 	Score int64 `json:"score"`
 
 	// reason
@@ -53,6 +56,10 @@ type ScorecardCheck struct {
 func (m *ScorecardCheck) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateScore(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDocumentation(formats); err != nil {
 		res = append(res, err)
 	}
@@ -63,6 +70,22 @@ func (m *ScorecardCheck) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ScorecardCheck) validateScore(formats strfmt.Registry) error {
+	if swag.IsZero(m.Score) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("score", "body", m.Score, -1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("score", "body", m.Score, 10, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *ScorecardCheck) validateDocumentation(formats strfmt.Registry) error {
 	if swag.IsZero(m.Documentation) { // not required
 		return nil
@@ -27,6 +27,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // ScorecardResult scorecard result
@@ -43,12 +44,17 @@ type ScorecardResult struct {
 	// scorecard
 	Scorecard *ScorecardVersion `json:"scorecard,omitempty"`
 
-	// Aggregate score of the repository
+	// Aggregate score of the repository, or -1 if inconclusive
+	// Maximum: 10
+	// Minimum: -1
 	Score float64 `json:"score"`
 
 	// checks
 	Checks []*ScorecardCheck `json:"checks"`
 
+	// Probe findings, published by Scorecard v5 and later
+	Findings []*ProbeFinding `json:"findings,omitempty"`
+
 	// metadata
 	Metadata string `json:"metadata,omitempty"`
 }
@@ -65,10 +71,18 @@ func (m *ScorecardResult) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateScore(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateChecks(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateFindings(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -113,6 +127,22 @@ func (m *ScorecardResult) validateScorecard(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ScorecardResult) validateScore(formats strfmt.Registry) error {
+	if swag.IsZero(m.Score) { // not required
+		return nil
+	}
+
+	if err := validate.Minimum("score", "body", m.Score, -1, false); err != nil {
+		return err
+	}
+
+	if err := validate.Maximum("score", "body", m.Score, 10, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *ScorecardResult) validateChecks(formats strfmt.Registry) error {
 	if swag.IsZero(m.Checks) { // not required
 		return nil
@@ -139,6 +169,32 @@ func (m *ScorecardResult) validateChecks(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ScorecardResult) validateFindings(formats strfmt.Registry) error {
+	if swag.IsZero(m.Findings) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Findings); i++ {
+		if swag.IsZero(m.Findings[i]) { // not required
+			continue
+		}
+
+		if m.Findings[i] != nil {
+			if err := m.Findings[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("findings" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("findings" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this scorecard result based on the context it is used
 func (m *ScorecardResult) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -155,6 +211,10 @@ func (m *ScorecardResult) ContextValidate(ctx context.Context, formats strfmt.Re
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateFindings(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -213,6 +273,26 @@ func (m *ScorecardResult) contextValidateChecks(ctx context.Context, formats str
 	return nil
 }
 
+func (m *ScorecardResult) contextValidateFindings(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Findings); i++ {
+
+		if m.Findings[i] != nil {
+			if err := m.Findings[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("findings" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("findings" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ScorecardResult) MarshalBinary() ([]byte, error) {
 	if m == nil {
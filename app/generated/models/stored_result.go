@@ -0,0 +1,143 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// StoredResult stored result
+//
+// swagger:model StoredResult
+type StoredResult struct {
+
+	// SHA1 of the commit, or empty for the latest result
+	Commit string `json:"commit,omitempty"`
+
+	// source
+	// Enum: [action cron]
+	Source string `json:"source,omitempty"`
+
+	// last modified
+	// Format: date-time
+	LastModified strfmt.DateTime `json:"lastModified,omitempty"`
+}
+
+// Validate validates this stored result
+func (m *StoredResult) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSource(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastModified(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var storedResultTypeSourcePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["action","cron"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		storedResultTypeSourcePropEnum = append(storedResultTypeSourcePropEnum, v)
+	}
+}
+
+const (
+
+	// StoredResultSourceAction captures enum value "action"
+	StoredResultSourceAction string = "action"
+
+	// StoredResultSourceCron captures enum value "cron"
+	StoredResultSourceCron string = "cron"
+)
+
+// prop value enum
+func (m *StoredResult) validateSourceEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, storedResultTypeSourcePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *StoredResult) validateSource(formats strfmt.Registry) error {
+	if swag.IsZero(m.Source) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateSourceEnum("source", "body", m.Source); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *StoredResult) validateLastModified(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastModified) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("lastModified", "body", "date-time", m.LastModified.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this stored result based on context it is used
+func (m *StoredResult) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StoredResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StoredResult) UnmarshalBinary(b []byte) error {
+	var res StoredResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// WeightedCheck weighted check
+//
+// swagger:model WeightedCheck
+type WeightedCheck struct {
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// score
+	Score int64 `json:"score"`
+
+	// weight
+	Weight float64 `json:"weight"`
+}
+
+// Validate validates this weighted check
+func (m *WeightedCheck) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this weighted check based on context it is used
+func (m *WeightedCheck) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *WeightedCheck) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *WeightedCheck) UnmarshalBinary(b []byte) error {
+	var res WeightedCheck
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
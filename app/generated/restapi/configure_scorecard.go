@@ -17,11 +17,13 @@
 package restapi
 
 import (
+	"context"
 	"crypto/tls"
 	"embed"
 	"encoding/json"
 	"io"
 	"io/fs"
+	"log"
 	"net/http"
 
 	"github.com/go-openapi/errors"
@@ -30,6 +32,7 @@ import (
 	flag "github.com/spf13/pflag"
 
 	"github.com/ossf/scorecard-webapp/app/generated/restapi/operations"
+	"github.com/ossf/scorecard-webapp/app/generated/restapi/operations/admin"
 	"github.com/ossf/scorecard-webapp/app/generated/restapi/operations/badge"
 	"github.com/ossf/scorecard-webapp/app/generated/restapi/operations/results"
 	"github.com/ossf/scorecard-webapp/app/server"
@@ -68,11 +71,44 @@ func configureAPI(api *operations.ScorecardAPI) http.Handler {
 		enc.SetEscapeHTML(false)
 		return enc.Encode(data)
 	})
+	api.YamlProducer = server.YAMLProducer()
+	api.CsvProducer = server.CSVProducer()
+	api.MarkdownProducer = server.MarkdownProducer()
 
+	api.ResultsGetOrgResultsHandler = results.GetOrgResultsHandlerFunc(server.GetOrgResultsHandler)
 	api.ResultsGetResultHandler = results.GetResultHandlerFunc(server.GetResultHandler)
+	api.ResultsEvaluatePolicyHandler = results.EvaluatePolicyHandlerFunc(server.EvaluatePolicyHandler)
+	api.ResultsGetScoreHandler = results.GetScoreHandlerFunc(server.GetScoreHandler)
 	api.ResultsPostResultHandler = results.PostResultHandlerFunc(server.PostResultsHandler)
+	api.ResultsSearchResultsHandler = results.SearchResultsHandlerFunc(server.SearchResultsHandler)
 	api.BadgeGetBadgeHandler = badge.GetBadgeHandlerFunc(server.GetBadgeHandler)
 
+	// Serving results without their overrides would serve the ones taken down.
+	if err := server.OpenOverrides(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+	// Results would otherwise be verified against the public good Rekor and Fulcio instances.
+	if err := server.OpenRekor(); err != nil {
+		log.Fatal(err)
+	}
+	if err := server.LoadFulcio(); err != nil {
+		log.Fatal(err)
+	}
+	if err := server.CheckSearchIndex(); err != nil {
+		log.Fatal(err)
+	}
+	if err := server.LoadWeightProfiles(); err != nil {
+		log.Fatal(err)
+	}
+	server.StartPurgeQueue()
+	api.AdminTokenAuth = server.AdminTokenAuth
+	api.AdminListAdminResultsHandler = admin.ListAdminResultsHandlerFunc(server.ListAdminResultsHandler)
+	api.AdminTombstoneResultHandler = admin.TombstoneResultHandlerFunc(server.TombstoneResultHandler)
+	api.AdminRestoreResultHandler = admin.RestoreResultHandlerFunc(server.RestoreResultHandler)
+	api.AdminAnnotateResultHandler = admin.AnnotateResultHandlerFunc(server.AnnotateResultHandler)
+	api.AdminGetAuditLogHandler = admin.GetAuditLogHandlerFunc(server.GetAuditLogHandler)
+	api.AdminGetMetricsHandler = admin.GetMetricsHandlerFunc(server.GetMetricsHandler)
+
 	api.PreServerShutdown = func() {}
 
 	api.ServerShutdown = func() {}
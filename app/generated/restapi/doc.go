@@ -28,7 +28,10 @@
 //
 //	Produces:
 //	  - image/svg+xml
+//	  - text/csv
 //	  - application/json
+//	  - text/markdown
+//	  - application/yaml
 //
 // swagger:meta
 package restapi
@@ -50,76 +50,119 @@ func init() {
   },
   "host": "api.securityscorecards.dev",
   "paths": {
-    "/projects/{platform}/{org}/{repo}": {
+    "/admin/metrics": {
       "get": {
-        "tags": [
-          "results"
-        ],
-        "summary": "Get a repository's ScorecardResult",
-        "operationId": "getResult",
-        "parameters": [
+        "security": [
           {
-            "pattern": "^[0-9a-fA-F]{40}$",
-            "type": "string",
-            "description": "SHA1 commit hash expressed in hexadecimal format",
-            "name": "commit",
-            "in": "query"
+            "adminToken": []
           }
         ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Get the instance's CDN purge queue metrics",
+        "operationId": "getMetrics",
         "responses": {
           "200": {
-            "description": "A JSON object of the repository's ScorecardResult",
+            "description": "The instance's metrics",
             "schema": {
-              "$ref": "#/definitions/ScorecardResult"
+              "$ref": "#/definitions/Metrics"
             },
             "headers": {
               "Cache-Control": {
                 "type": "string",
-                "description": "TTL for browser caching. Example: max-age=3600"
-              },
-              "Surrogate-Control": {
-                "type": "string",
-                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+                "description": "Always no-store, the metrics are of the instance serving the request"
               }
             }
           },
+          "401": {
+            "$ref": "#/responses/Unauthorized"
+          },
+          "default": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
+    },
+    "/admin/projects/{platform}/{org}/{repo}": {
+      "get": {
+        "security": [
+          {
+            "adminToken": []
+          }
+        ],
+        "description": "Lists the latest result and the per-commit results stored for the repository, from the Scorecard action and the weekly cron scan, with the overrides set on the repository and its commits.\n",
+        "tags": [
+          "admin"
+        ],
+        "summary": "List a repository's stored results and their overrides",
+        "operationId": "listAdminResults",
+        "responses": {
+          "200": {
+            "description": "The repository's stored results and overrides",
+            "schema": {
+              "$ref": "#/definitions/AdminResults"
+            }
+          },
           "400": {
             "$ref": "#/responses/BadRequest"
           },
-          "404": {
-            "$ref": "#/responses/NotFound"
+          "401": {
+            "$ref": "#/responses/Unauthorized"
           },
           "default": {
             "$ref": "#/responses/InternalServerError"
           }
         }
       },
+      "parameters": [
+        {
+          "$ref": "#/parameters/platform"
+        },
+        {
+          "$ref": "#/parameters/org"
+        },
+        {
+          "$ref": "#/parameters/repo"
+        }
+      ]
+    },
+    "/admin/projects/{platform}/{org}/{repo}/annotate": {
       "post": {
+        "security": [
+          {
+            "adminToken": []
+          }
+        ],
+        "description": "The note is returned in the X-Scorecard-Annotation header of the results. An empty annotation removes it. Cached responses are purged.\n",
         "tags": [
-          "results"
+          "admin"
         ],
-        "summary": "Publish a repository's OIDC verified ScorecardResult",
-        "operationId": "postResult",
+        "summary": "Attach a note to a repository's results, or the result of one of its commits",
+        "operationId": "annotateResult",
         "parameters": [
           {
-            "name": "publish",
+            "name": "override",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/VerifiedScorecardResult"
+              "$ref": "#/definitions/OverrideRequest"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Successfully updated ScorecardResult",
+          "200": {
+            "description": "The updated override",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/ResultOverride"
             }
           },
           "400": {
             "$ref": "#/responses/BadRequest"
           },
+          "401": {
+            "$ref": "#/responses/Unauthorized"
+          },
           "default": {
             "$ref": "#/responses/InternalServerError"
           }
@@ -127,53 +170,176 @@ func init() {
       },
       "parameters": [
         {
-          "type": "string",
-          "description": "VCS platform. eg. github.com",
-          "name": "platform",
-          "in": "path",
-          "required": true
+          "$ref": "#/parameters/platform"
         },
         {
-          "type": "string",
-          "description": "Name of the owner/organization of the repository",
-          "name": "org",
-          "in": "path",
-          "required": true
+          "$ref": "#/parameters/org"
         },
         {
-          "type": "string",
-          "description": "Name of the repository",
-          "name": "repo",
-          "in": "path",
-          "required": true
+          "$ref": "#/parameters/repo"
         }
       ]
     },
-    "/projects/{platform}/{org}/{repo}/badge": {
+    "/admin/projects/{platform}/{org}/{repo}/audit": {
       "get": {
-        "produces": [
-          "image/svg+xml"
+        "security": [
+          {
+            "adminToken": []
+          }
         ],
         "tags": [
-          "badge"
+          "admin"
         ],
-        "summary": "Get a repository's Scorecard badge",
-        "operationId": "getBadge",
+        "summary": "List the changes made to a repository's overrides, oldest first",
+        "operationId": "getAuditLog",
+        "responses": {
+          "200": {
+            "description": "The repository's audit log",
+            "schema": {
+              "$ref": "#/definitions/AuditLog"
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "401": {
+            "$ref": "#/responses/Unauthorized"
+          },
+          "default": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/platform"
+        },
+        {
+          "$ref": "#/parameters/org"
+        },
+        {
+          "$ref": "#/parameters/repo"
+        }
+      ]
+    },
+    "/admin/projects/{platform}/{org}/{repo}/restore": {
+      "post": {
+        "security": [
+          {
+            "adminToken": []
+          }
+        ],
+        "description": "Serves the repository's results, or the result of one of its commits, again. Any annotation is kept. Cached responses are purged.\n",
+        "tags": [
+          "admin"
+        ],
+        "summary": "Restore tombstoned results",
+        "operationId": "restoreResult",
         "parameters": [
           {
-            "enum": [
-              "plastic",
-              "flat",
-              "flat-square",
-              "for-the-badge",
-              "social"
-            ],
-            "type": "string",
-            "default": "flat",
-            "description": "Style to render the badge",
-            "name": "style",
-            "in": "query"
+            "name": "override",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/OverrideRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The updated override",
+            "schema": {
+              "$ref": "#/definitions/ResultOverride"
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "401": {
+            "$ref": "#/responses/Unauthorized"
+          },
+          "404": {
+            "description": "There is no override to restore"
+          },
+          "default": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/platform"
+        },
+        {
+          "$ref": "#/parameters/org"
+        },
+        {
+          "$ref": "#/parameters/repo"
+        }
+      ]
+    },
+    "/admin/projects/{platform}/{org}/{repo}/tombstone": {
+      "post": {
+        "security": [
+          {
+            "adminToken": []
+          }
+        ],
+        "description": "The result requests and the badge of a tombstoned repository, or the result requests of a tombstoned commit, return 410 Gone with the reason. Cached responses are purged.\n",
+        "tags": [
+          "admin"
+        ],
+        "summary": "Take down a repository's results, or the result of one of its commits",
+        "operationId": "tombstoneResult",
+        "parameters": [
+          {
+            "name": "override",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/OverrideRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The updated override",
+            "schema": {
+              "$ref": "#/definitions/ResultOverride"
+            }
           },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "401": {
+            "$ref": "#/responses/Unauthorized"
+          },
+          "default": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/platform"
+        },
+        {
+          "$ref": "#/parameters/org"
+        },
+        {
+          "$ref": "#/parameters/repo"
+        }
+      ]
+    },
+    "/projects/{platform}/{org}": {
+      "get": {
+        "description": "Lists the repositories of the organization in the search index, with their latest aggregate and per-check scores, and summary statistics over those repositories. Inconclusive check scores (-1) are excluded from means and medians, but included in the distributions. Repositories are paginated by name with limit and offset; truncated is set when there are more. The statistics are over every repository of the organization, not only the page's, so they are the same on every page. Repositories without a latest result, or whose latest result was taken down, are left out, so a page can have fewer than limit repositories.\n",
+        "tags": [
+          "results"
+        ],
+        "summary": "Get the latest ScorecardResults of every repository in an organization",
+        "operationId": "getOrgResults",
+        "parameters": [
           {
             "type": "string",
             "description": "VCS platform. eg. github.com",
@@ -183,22 +349,34 @@ func init() {
           },
           {
             "type": "string",
-            "description": "Name of the owner/organization of the repository",
+            "description": "Name of the owner/organization",
             "name": "org",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "Name of the repository",
-            "name": "repo",
-            "in": "path",
-            "required": true
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "Maximum number of repositories to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "default": 0,
+            "description": "Number of repositories to skip, for pagination",
+            "name": "offset",
+            "in": "query"
           }
         ],
         "responses": {
-          "302": {
-            "description": "Scorecard badge for the repository",
+          "200": {
+            "description": "The organization's repositories and summary statistics",
+            "schema": {
+              "$ref": "#/definitions/OrgResults"
+            },
             "headers": {
               "Cache-Control": {
                 "type": "string",
@@ -210,214 +388,31 @@ func init() {
               },
               "Surrogate-Key": {
                 "type": "string",
-                "description": "Surrogate key for Fastly CDN purging."
+                "description": "Surrogate keys for Fastly CDN purging, e.g. org:github.com/org"
               }
             }
           },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "404": {
+            "$ref": "#/responses/NotFound"
+          },
           "default": {
             "$ref": "#/responses/InternalServerError"
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "Error": {
-      "type": "object",
-      "properties": {
-        "code": {
-          "type": "integer"
-        },
-        "message": {
-          "type": "string"
-        }
-      }
-    },
-    "Repo": {
-      "type": "object",
-      "properties": {
-        "commit": {
-          "description": "SHA1 value of the analyzed commit expressed as hexadecimal",
-          "type": "string",
-          "pattern": "^[0-9a-fA-F]{40}$",
-          "x-order": 1
-        },
-        "name": {
-          "description": "respository that was analyzed",
-          "type": "string",
-          "x-order": 0
-        }
-      }
     },
-    "ScorecardCheck": {
-      "type": "object",
-      "properties": {
-        "details": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "x-order": 4
-        },
-        "documentation": {
-          "type": "object",
-          "properties": {
-            "short": {
-              "type": "string"
-            },
-            "url": {
-              "type": "string"
-            }
-          },
-          "x-order": 3
-        },
-        "name": {
-          "type": "string",
-          "x-order": 0
-        },
-        "reason": {
-          "type": "string",
-          "x-order": 2
-        },
-        "score": {
-          "type": "integer",
-          "x-omitempty": false,
-          "x-order": 1
-        }
-      }
-    },
-    "ScorecardResult": {
-      "type": "object",
-      "properties": {
-        "checks": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ScorecardCheck"
-          },
-          "x-order": 4
-        },
-        "date": {
-          "type": "string",
-          "x-order": 0
-        },
-        "metadata": {
-          "type": "string",
-          "x-order": 5
-        },
-        "repo": {
-          "x-order": 1,
-          "$ref": "#/definitions/Repo"
-        },
-        "score": {
-          "description": "Aggregate score of the repository",
-          "type": "number",
-          "x-omitempty": false,
-          "x-order": 3
-        },
-        "scorecard": {
-          "type": "object",
-          "x-order": 2,
-          "$ref": "#/definitions/ScorecardVersion"
-        }
-      }
-    },
-    "ScorecardVersion": {
-      "type": "object",
-      "properties": {
-        "commit": {
-          "description": "SHA1 value of the Scorecard commit used for analysis",
-          "type": "string",
-          "pattern": "^[0-9a-fA-F]{40}$",
-          "x-order": 1
-        },
-        "version": {
-          "description": "Scorecard version used for this analysis",
-          "type": "string",
-          "x-order": 0
-        }
-      }
-    },
-    "VerifiedScorecardResult": {
-      "type": "object",
-      "properties": {
-        "accessToken": {
-          "type": "string"
-        },
-        "branch": {
-          "type": "string"
-        },
-        "result": {
-          "type": "string"
-        },
-        "tlogIndex": {
-          "type": "integer"
-        }
-      }
-    }
-  },
-  "responses": {
-    "BadRequest": {
-      "description": "The request provided to the server was invalid",
-      "schema": {
-        "$ref": "#/definitions/Error"
-      },
-      "headers": {
-        "Cache-Control": {
-          "type": "string",
-          "description": "TTL for browser caching. Example: max-age=3600"
-        },
-        "Surrogate-Control": {
-          "type": "string",
-          "description": "TTL for Fastly CDN caching. Example: max-age=3600"
-        }
-      }
-    },
-    "InternalServerError": {
-      "description": "There was an internal error in the server while processing the request",
-      "schema": {
-        "$ref": "#/definitions/Error"
-      }
-    },
-    "NotFound": {
-      "description": "The content requested could not be found",
-      "headers": {
-        "Cache-Control": {
-          "type": "string",
-          "description": "TTL for browser caching. Example: max-age=3600"
-        },
-        "Surrogate-Control": {
-          "type": "string",
-          "description": "TTL for Fastly CDN caching. Example: max-age=3600"
-        }
-      }
-    }
-  },
-  "x-google-allow": "all",
-  "x-google-backend": {
-    "address": "https://scorecard-api-prod-x3pmld2cbq-uc.a.run.app",
-    "protocol": "h2"
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "API to interact with a project's published Scorecard result",
-    "title": "OpenSSF Scorecard API",
-    "version": "1.0.0"
-  },
-  "host": "api.securityscorecards.dev",
-  "paths": {
     "/projects/{platform}/{org}/{repo}": {
       "get": {
+        "description": "The json format is also available as YAML, as a CSV table of the checks, or as a Markdown summary, depending on the Accept header. It's the only operation with these media types: the others only produce JSON.\n",
+        "produces": [
+          "application/json",
+          "application/yaml",
+          "text/csv",
+          "text/markdown"
+        ],
         "tags": [
           "results"
         ],
@@ -430,6 +425,53 @@ func init() {
             "description": "SHA1 commit hash expressed in hexadecimal format",
             "name": "commit",
             "in": "query"
+          },
+          {
+            "enum": [
+              "json",
+              "sarif",
+              "probe"
+            ],
+            "type": "string",
+            "default": "json",
+            "description": "Output format of the result. ` + "`" + `json` + "`" + ` returns the checks of the result, without probe findings. ` + "`" + `sarif` + "`" + ` converts the stored checks into a SARIF 2.1.0 log (application/sarif+json) with one rule per check. ` + "`" + `probe` + "`" + ` returns the structured probe findings of Scorecard v5 results as a ScorecardProbeResult, or 404 if the result has none.\n",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "action",
+              "cron"
+            ],
+            "type": "string",
+            "description": "Only serve the result published by the Scorecard action, or computed by the weekly cron scan. By default, the most recently written of the two is served.\n",
+            "name": "source",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Maximum age in seconds of the stored result, from when it was last written. Returns 422 if the best available result, from the requested source if any, is older.\n",
+            "name": "maxAge",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Wrap the result in a ResultEnvelope with its source and age. Only supported with the json format.\n",
+            "name": "envelope",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "ETag from a previous response. Returns 304 if the result hasn't changed.",
+            "name": "If-None-Match",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "HTTP date from a previous Last-Modified header. Returns 304 if the result hasn't changed since. Ignored when If-None-Match is present.\n",
+            "name": "If-Modified-Since",
+            "in": "header"
           }
         ],
         "responses": {
@@ -443,14 +485,89 @@ func init() {
                 "type": "string",
                 "description": "TTL for browser caching. Example: max-age=3600"
               },
+              "ETag": {
+                "type": "string",
+                "description": "Strong validator for the stored result, for use with If-None-Match."
+              },
+              "Last-Modified": {
+                "type": "string",
+                "description": "Time the stored result was last written, for use with If-Modified-Since."
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              },
+              "Surrogate-Key": {
+                "type": "string",
+                "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
+              },
+              "X-Scorecard-Age": {
+                "type": "integer",
+                "description": "Age in seconds of the stored result when the response was generated"
+              },
+              "X-Scorecard-Annotation": {
+                "type": "string",
+                "description": "Note attached to the result by the server operator, if any"
+              },
+              "X-Scorecard-Source": {
+                "enum": [
+                  "action",
+                  "cron"
+                ],
+                "type": "string",
+                "description": "Where the stored result comes from: published by the Scorecard action, or computed by the weekly cron scan.\n"
+              }
+            }
+          },
+          "304": {
+            "description": "The stored result hasn't changed since the conditional request's validators",
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "ETag": {
+                "type": "string",
+                "description": "Strong validator for the stored result, for use with If-None-Match."
+              },
+              "Last-Modified": {
+                "type": "string",
+                "description": "Time the stored result was last written, for use with If-Modified-Since."
+              },
               "Surrogate-Control": {
                 "type": "string",
                 "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              },
+              "Surrogate-Key": {
+                "type": "string",
+                "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
+              },
+              "X-Scorecard-Age": {
+                "type": "integer",
+                "description": "Age in seconds of the stored result when the response was generated"
+              },
+              "X-Scorecard-Annotation": {
+                "type": "string",
+                "description": "Note attached to the result by the server operator, if any"
+              },
+              "X-Scorecard-Source": {
+                "enum": [
+                  "action",
+                  "cron"
+                ],
+                "type": "string",
+                "description": "Where the stored result comes from: published by the Scorecard action, or computed by the weekly cron scan.\n"
               }
             }
           },
           "400": {
-            "description": "The request provided to the server was invalid",
+            "$ref": "#/responses/BadRequest"
+          },
+          "404": {
+            "$ref": "#/responses/NotFound"
+          },
+          "406": {
+            "description": "The sarif and probe formats, and envelopes, are only available as JSON, which the Accept header doesn't accept. The response depends on the Accept header, so it isn't cached.\n",
             "schema": {
               "$ref": "#/definitions/Error"
             },
@@ -465,8 +582,14 @@ func init() {
               }
             }
           },
-          "404": {
-            "description": "The content requested could not be found",
+          "410": {
+            "$ref": "#/responses/Gone"
+          },
+          "422": {
+            "description": "The best available result is older than maxAge",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
             "headers": {
               "Cache-Control": {
                 "type": "string",
@@ -475,14 +598,25 @@ func init() {
               "Surrogate-Control": {
                 "type": "string",
                 "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              },
+              "Surrogate-Key": {
+                "type": "string",
+                "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
+              },
+              "X-Scorecard-Age": {
+                "type": "integer"
+              },
+              "X-Scorecard-Source": {
+                "enum": [
+                  "action",
+                  "cron"
+                ],
+                "type": "string"
               }
             }
           },
           "default": {
-            "description": "There was an internal error in the server while processing the request",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
+            "$ref": "#/responses/InternalServerError"
           }
         }
       },
@@ -510,26 +644,10 @@ func init() {
             }
           },
           "400": {
-            "description": "The request provided to the server was invalid",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            },
-            "headers": {
-              "Cache-Control": {
-                "type": "string",
-                "description": "TTL for browser caching. Example: max-age=3600"
-              },
-              "Surrogate-Control": {
-                "type": "string",
-                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
-              }
-            }
+            "$ref": "#/responses/BadRequest"
           },
           "default": {
-            "description": "There was an internal error in the server while processing the request",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
+            "$ref": "#/responses/InternalServerError"
           }
         }
       },
@@ -618,29 +736,2986 @@ func init() {
               },
               "Surrogate-Key": {
                 "type": "string",
-                "description": "Surrogate key for Fastly CDN purging."
+                "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
+              }
+            }
+          },
+          "410": {
+            "description": "The repository's results were taken down. The plain text body is the reason.",
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              },
+              "Surrogate-Key": {
+                "type": "string",
+                "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
               }
             }
           },
           "default": {
-            "description": "There was an internal error in the server while processing the request",
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
+    },
+    "/projects/{platform}/{org}/{repo}/evaluate": {
+      "post": {
+        "description": "Evaluates the stored result against the policy, and returns whether it passes with the reason for every failed rule. A failing result is still a successful evaluation, so it's returned with a 200.\n",
+        "tags": [
+          "results"
+        ],
+        "summary": "Evaluate a repository's ScorecardResult against a policy",
+        "operationId": "evaluatePolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "VCS platform. eg. github.com",
+            "name": "platform",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the owner/organization of the repository",
+            "name": "org",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the repository",
+            "name": "repo",
+            "in": "path",
+            "required": true
+          },
+          {
+            "pattern": "^[0-9a-fA-F]{40}$",
+            "type": "string",
+            "description": "SHA1 commit hash expressed in hexadecimal format",
+            "name": "commit",
+            "in": "query"
+          },
+          {
+            "name": "policy",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/Error"
+              "$ref": "#/definitions/Policy"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The outcome of the evaluation",
+            "schema": {
+              "$ref": "#/definitions/PolicyEvaluation"
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "404": {
+            "$ref": "#/responses/NotFound"
+          },
+          "410": {
+            "$ref": "#/responses/Gone"
+          },
+          "default": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
+    },
+    "/projects/{platform}/{org}/{repo}/score": {
+      "get": {
+        "description": "Recomputes the aggregate score from the stored check scores, rather than returning the score the result was published with, so repositories can be ranked consistently. The ` + "`" + `default` + "`" + ` profile uses Scorecard's risk weights (Critical 10, High 7.5, Medium 5, Low 2.5); other profiles are configured by the server operator. Inconclusive checks (-1) and checks with no weight don't count, and the score is -1 if no check does.\n",
+        "tags": [
+          "results"
+        ],
+        "summary": "Recompute a repository's aggregate score with a weight profile",
+        "operationId": "getScore",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "VCS platform. eg. github.com",
+            "name": "platform",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the owner/organization of the repository",
+            "name": "org",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the repository",
+            "name": "repo",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "default": "default",
+            "description": "Name of the weight profile",
+            "name": "weights",
+            "in": "query"
+          },
+          {
+            "pattern": "^[0-9a-fA-F]{40}$",
+            "type": "string",
+            "description": "SHA1 commit hash expressed in hexadecimal format",
+            "name": "commit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The recomputed score",
+            "schema": {
+              "$ref": "#/definitions/WeightedScore"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              },
+              "Surrogate-Key": {
+                "type": "string",
+                "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
+              }
+            }
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "404": {
+            "$ref": "#/responses/NotFound"
+          },
+          "410": {
+            "$ref": "#/responses/Gone"
+          },
+          "default": {
+            "$ref": "#/responses/InternalServerError"
+          }
+        }
+      }
+    },
+    "/search": {
+      "get": {
+        "description": "Searches the index of the latest result of each repository. The index is updated whenever a result is published, and backfilled from the weekly scan. Results are paginated with limit and offset; truncated is set when there are more. Score filters apply to the given check, or to the aggregate score without one. Inconclusive check scores (-1) never match score filters. Taken down results are left out, so a page can have fewer than limit results even when truncated is set. Unless the server has a database index, searches of many repositories must filter by platform and org.\n",
+        "tags": [
+          "results"
+        ],
+        "summary": "Search the latest ScorecardResults of repositories",
+        "operationId": "searchResults",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "VCS platform. eg. github.com",
+            "name": "platform",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Name of the owner/organization of the repositories",
+            "name": "org",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Name of the check the score filters apply to. eg. Branch-Protection",
+            "name": "check",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "description": "Minimum score, inclusive",
+            "name": "minScore",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "description": "Maximum score, inclusive",
+            "name": "maxScore",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Scorecard version, with * and ? wildcards. eg. v5.*",
+            "name": "scorecardVersion",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only results computed on or after this date (YYYY-MM-DD or RFC 3339)",
+            "name": "updatedSince",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "Maximum number of results to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "default": 0,
+            "description": "Number of matching results to skip, for pagination",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "name",
+              "-name",
+              "score",
+              "-score",
+              "date",
+              "-date"
+            ],
+            "type": "string",
+            "default": "name",
+            "description": "Order of the results. score is the score of the given check, or the aggregate score without one. A leading - sorts in descending order. Ties are sorted by name.\n",
+            "name": "sort",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The matching repositories",
+            "schema": {
+              "$ref": "#/definitions/SearchResults"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              }
             }
+          },
+          "400": {
+            "$ref": "#/responses/BadRequest"
+          },
+          "default": {
+            "$ref": "#/responses/InternalServerError"
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "Error": {
+    }
+  },
+  "definitions": {
+    "AdminResults": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "x-order": 0
+        },
+        "overrides": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ResultOverride"
+          },
+          "x-omitempty": true,
+          "x-order": 2
+        },
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/StoredResult"
+          },
+          "x-omitempty": true,
+          "x-order": 1
+        }
+      }
+    },
+    "AuditEvent": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "tombstone",
+            "restore",
+            "annotate"
+          ],
+          "x-order": 2
+        },
+        "actor": {
+          "description": "Name of the admin token used",
+          "type": "string",
+          "x-order": 1
+        },
+        "annotation": {
+          "type": "string",
+          "x-order": 6
+        },
+        "commit": {
+          "type": "string",
+          "x-order": 4
+        },
+        "name": {
+          "type": "string",
+          "x-order": 3
+        },
+        "reason": {
+          "type": "string",
+          "x-order": 5
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time",
+          "x-order": 0
+        }
+      }
+    },
+    "AuditLog": {
+      "type": "object",
+      "properties": {
+        "events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AuditEvent"
+          },
+          "x-omitempty": true,
+          "x-order": 0
+        }
+      }
+    },
+    "CheckScore": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "x-order": 0
+        },
+        "score": {
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 1
+        }
+      }
+    },
+    "CheckStats": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "x-order": 0
+        },
+        "stats": {
+          "x-order": 1,
+          "$ref": "#/definitions/ScoreStats"
+        }
+      }
+    },
+    "Error": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer"
+        },
+        "fields": {
+          "description": "The invalid fields of the request, when known",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FieldError"
+          }
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "FieldError": {
+      "type": "object",
+      "properties": {
+        "field": {
+          "description": "Path of the invalid field in the request body, e.g. result.checks.0.score",
+          "type": "string",
+          "x-order": 0
+        },
+        "message": {
+          "type": "string",
+          "x-order": 1
+        }
+      }
+    },
+    "FindingLocation": {
+      "type": "object",
+      "properties": {
+        "lineEnd": {
+          "type": "integer",
+          "x-order": 3
+        },
+        "lineStart": {
+          "type": "integer",
+          "x-order": 2
+        },
+        "path": {
+          "type": "string",
+          "x-order": 1
+        },
+        "snippet": {
+          "type": "string",
+          "x-order": 4
+        },
+        "type": {
+          "description": "Scorecard file type, e.g. 1 for source files and 4 for URLs",
+          "type": "integer",
+          "x-order": 0
+        }
+      }
+    },
+    "FindingRemediation": {
+      "type": "object",
+      "properties": {
+        "effort": {
+          "description": "Scorecard remediation effort, 1 (low) to 3 (high)",
+          "type": "integer",
+          "x-order": 2
+        },
+        "markdown": {
+          "type": "string",
+          "x-order": 1
+        },
+        "patch": {
+          "type": "string",
+          "x-order": 3
+        },
+        "text": {
+          "type": "string",
+          "x-order": 0
+        }
+      }
+    },
+    "Metrics": {
+      "type": "object",
+      "properties": {
+        "cdnPurgeBacklog": {
+          "description": "Number of CDN purges pending, including the ones being retried",
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 0
+        },
+        "cdnPurgeDeadLetters": {
+          "description": "Number of CDN purges given up on since the instance started",
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 1
+        }
+      }
+    },
+    "OrgResults": {
+      "type": "object",
+      "properties": {
+        "org": {
+          "type": "string",
+          "x-order": 1
+        },
+        "platform": {
+          "type": "string",
+          "x-order": 0
+        },
+        "repos": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RepoSummary"
+          },
+          "x-order": 2
+        },
+        "summary": {
+          "x-order": 3,
+          "$ref": "#/definitions/OrgSummary"
+        },
+        "truncated": {
+          "description": "Whether the organization has more repositories past the offset and limit",
+          "type": "boolean",
+          "x-omitempty": false,
+          "x-order": 4
+        }
+      }
+    },
+    "OrgSummary": {
+      "type": "object",
+      "properties": {
+        "checks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CheckStats"
+          },
+          "x-order": 2
+        },
+        "repos": {
+          "description": "Number of repositories of the organization with results, across all pages",
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 0
+        },
+        "score": {
+          "x-order": 1,
+          "$ref": "#/definitions/ScoreStats"
+        }
+      }
+    },
+    "OverrideRequest": {
+      "type": "object",
+      "required": [
+        "reason"
+      ],
+      "properties": {
+        "annotation": {
+          "description": "Note to attach to the results, for annotateResult",
+          "type": "string",
+          "x-order": 2
+        },
+        "commit": {
+          "description": "SHA1 of the commit whose result to override, or empty for the whole repository",
+          "type": "string",
+          "pattern": "^[0-9a-fA-F]{40}$",
+          "x-order": 0
+        },
+        "reason": {
+          "description": "Why the change is made, recorded in the audit log and returned with 410 responses",
+          "type": "string",
+          "minLength": 1,
+          "x-order": 1
+        }
+      }
+    },
+    "Policy": {
+      "description": "Rules a result must satisfy. Rules which aren't set aren't checked.",
+      "type": "object",
+      "properties": {
+        "maxAgeDays": {
+          "description": "Maximum age of the result in days, from its date",
+          "type": "integer",
+          "x-order": 3
+        },
+        "minCheckScores": {
+          "description": "Minimum score, 0 to 10, of each listed check. Listed checks which are missing or inconclusive fail.\n",
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer"
+          },
+          "x-order": 1
+        },
+        "minScore": {
+          "description": "Minimum aggregate score, 0 to 10",
+          "type": "number",
+          "x-order": 0
+        },
+        "minScorecardVersion": {
+          "description": "Minimum semantic version of Scorecard the result was computed with, e.g. v5.0.0",
+          "type": "string",
+          "x-order": 4
+        },
+        "requiredChecks": {
+          "description": "Checks which must have a conclusive score, whatever it is",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-order": 2
+        }
+      }
+    },
+    "PolicyEvaluation": {
+      "type": "object",
+      "properties": {
+        "commit": {
+          "type": "string",
+          "x-order": 3
+        },
+        "date": {
+          "type": "string",
+          "x-order": 2
+        },
+        "name": {
+          "description": "Full name of the repository, e.g. github.com/ossf/scorecard",
+          "type": "string",
+          "x-order": 1
+        },
+        "pass": {
+          "type": "boolean",
+          "x-omitempty": false,
+          "x-order": 0
+        },
+        "scorecardVersion": {
+          "type": "string",
+          "x-order": 4
+        },
+        "violations": {
+          "description": "Every rule the result failed",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/PolicyViolation"
+          },
+          "x-order": 5
+        }
+      }
+    },
+    "PolicyViolation": {
+      "type": "object",
+      "properties": {
+        "check": {
+          "description": "Check the violation is about, for per-check rules",
+          "type": "string",
+          "x-order": 1
+        },
+        "message": {
+          "type": "string",
+          "x-order": 2
+        },
+        "rule": {
+          "type": "string",
+          "enum": [
+            "minScore",
+            "minCheckScores",
+            "requiredChecks",
+            "maxAgeDays",
+            "minScorecardVersion"
+          ],
+          "x-order": 0
+        }
+      }
+    },
+    "ProbeFinding": {
+      "type": "object",
+      "properties": {
+        "location": {
+          "x-order": 3,
+          "$ref": "#/definitions/FindingLocation"
+        },
+        "message": {
+          "type": "string",
+          "x-order": 2
+        },
+        "outcome": {
+          "type": "string",
+          "enum": [
+            "True",
+            "False",
+            "NotAvailable",
+            "Error",
+            "NotSupported",
+            "NotApplicable"
+          ],
+          "x-order": 1
+        },
+        "probe": {
+          "description": "Name of the probe, e.g. hasDangerousWorkflowScriptInjection",
+          "type": "string",
+          "x-order": 0
+        },
+        "remediation": {
+          "x-order": 4,
+          "$ref": "#/definitions/FindingRemediation"
+        },
+        "values": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "x-order": 5
+        }
+      }
+    },
+    "Repo": {
+      "type": "object",
+      "properties": {
+        "commit": {
+          "description": "SHA1 value of the analyzed commit expressed as hexadecimal",
+          "type": "string",
+          "pattern": "^[0-9a-fA-F]{40}$",
+          "x-order": 1
+        },
+        "name": {
+          "description": "respository that was analyzed",
+          "type": "string",
+          "x-order": 0
+        }
+      }
+    },
+    "RepoSummary": {
+      "type": "object",
+      "properties": {
+        "checks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CheckScore"
+          },
+          "x-order": 4
+        },
+        "date": {
+          "type": "string",
+          "x-order": 1
+        },
+        "name": {
+          "description": "repository that was analyzed, e.g. github.com/org/repo",
+          "type": "string",
+          "x-order": 0
+        },
+        "score": {
+          "description": "Aggregate score of the repository",
+          "type": "number",
+          "x-omitempty": false,
+          "x-order": 2
+        },
+        "scorecardVersion": {
+          "description": "Scorecard version used for the analysis",
+          "type": "string",
+          "x-order": 3
+        }
+      }
+    },
+    "ResultEnvelope": {
+      "description": "A ScorecardResult with where it comes from and how old it is",
+      "type": "object",
+      "properties": {
+        "age": {
+          "description": "Age in seconds of the stored result when the response was generated",
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 2
+        },
+        "lastModified": {
+          "description": "Time the stored result was last written",
+          "type": "string",
+          "format": "date-time",
+          "x-order": 1
+        },
+        "result": {
+          "x-order": 3,
+          "$ref": "#/definitions/ScorecardResult"
+        },
+        "source": {
+          "type": "string",
+          "enum": [
+            "action",
+            "cron"
+          ],
+          "x-order": 0
+        }
+      }
+    },
+    "ResultOverride": {
+      "type": "object",
+      "properties": {
+        "annotation": {
+          "type": "string",
+          "x-order": 5
+        },
+        "commit": {
+          "description": "SHA1 of the overridden commit, or empty for the whole repository",
+          "type": "string",
+          "x-order": 1
+        },
+        "name": {
+          "description": "Full name of the repository, e.g. github.com/ossf/scorecard",
+          "type": "string",
+          "x-order": 0
+        },
+        "reason": {
+          "description": "Reason of the latest change",
+          "type": "string",
+          "x-order": 3
+        },
+        "tombstoneReason": {
+          "description": "Why the results were taken down, while they are",
+          "type": "string",
+          "x-order": 4
+        },
+        "tombstoned": {
+          "type": "boolean",
+          "x-omitempty": false,
+          "x-order": 2
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-order": 7
+        },
+        "updatedBy": {
+          "type": "string",
+          "x-order": 6
+        }
+      }
+    },
+    "ScoreStats": {
+      "type": "object",
+      "properties": {
+        "distribution": {
+          "description": "Number of repositories per score. Aggregate scores are rounded down.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer"
+          },
+          "x-order": 2
+        },
+        "mean": {
+          "type": "number",
+          "x-omitempty": false,
+          "x-order": 0
+        },
+        "median": {
+          "type": "number",
+          "x-omitempty": false,
+          "x-order": 1
+        }
+      }
+    },
+    "ScorecardCheck": {
+      "type": "object",
+      "properties": {
+        "details": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-order": 4
+        },
+        "documentation": {
+          "type": "object",
+          "properties": {
+            "short": {
+              "type": "string"
+            },
+            "url": {
+              "type": "string"
+            }
+          },
+          "x-order": 3
+        },
+        "name": {
+          "type": "string",
+          "x-order": 0
+        },
+        "reason": {
+          "type": "string",
+          "x-order": 2
+        },
+        "score": {
+          "description": "Score of the check, or -1 if inconclusive",
+          "type": "integer",
+          "maximum": 10,
+          "minimum": -1,
+          "x-nullable": false,
+          "x-omitempty": false,
+          "x-order": 1
+        }
+      }
+    },
+    "ScorecardProbeResult": {
+      "description": "The probe findings of a ScorecardResult, as output by scorecard --format=probe",
+      "type": "object",
+      "properties": {
+        "date": {
+          "type": "string",
+          "x-order": 0
+        },
+        "findings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ProbeFinding"
+          },
+          "x-order": 3
+        },
+        "metadata": {
+          "type": "string",
+          "x-order": 4
+        },
+        "repo": {
+          "x-order": 1,
+          "$ref": "#/definitions/Repo"
+        },
+        "scorecard": {
+          "x-order": 2,
+          "$ref": "#/definitions/ScorecardVersion"
+        }
+      }
+    },
+    "ScorecardResult": {
+      "type": "object",
+      "properties": {
+        "checks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ScorecardCheck"
+          },
+          "x-order": 4
+        },
+        "date": {
+          "type": "string",
+          "x-order": 0
+        },
+        "findings": {
+          "description": "Probe findings, published by Scorecard v5 and later",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ProbeFinding"
+          },
+          "x-omitempty": true,
+          "x-order": 5
+        },
+        "metadata": {
+          "type": "string",
+          "x-order": 6
+        },
+        "repo": {
+          "x-order": 1,
+          "$ref": "#/definitions/Repo"
+        },
+        "score": {
+          "description": "Aggregate score of the repository, or -1 if inconclusive",
+          "type": "number",
+          "maximum": 10,
+          "minimum": -1,
+          "x-nullable": false,
+          "x-omitempty": false,
+          "x-order": 3
+        },
+        "scorecard": {
+          "type": "object",
+          "x-order": 2,
+          "$ref": "#/definitions/ScorecardVersion"
+        }
+      }
+    },
+    "ScorecardVersion": {
+      "type": "object",
+      "properties": {
+        "commit": {
+          "description": "SHA1 value of the Scorecard commit used for analysis",
+          "type": "string",
+          "pattern": "^[0-9a-fA-F]{40}$",
+          "x-order": 1
+        },
+        "version": {
+          "description": "Scorecard version used for this analysis",
+          "type": "string",
+          "x-order": 0
+        }
+      }
+    },
+    "SearchResults": {
+      "type": "object",
+      "properties": {
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RepoSummary"
+          },
+          "x-order": 0
+        },
+        "truncated": {
+          "description": "Whether more repositories matched past the offset and limit",
+          "type": "boolean",
+          "x-omitempty": false,
+          "x-order": 1
+        }
+      }
+    },
+    "StoredResult": {
+      "type": "object",
+      "properties": {
+        "commit": {
+          "description": "SHA1 of the commit, or empty for the latest result",
+          "type": "string",
+          "x-order": 0
+        },
+        "lastModified": {
+          "type": "string",
+          "format": "date-time",
+          "x-order": 2
+        },
+        "source": {
+          "type": "string",
+          "enum": [
+            "action",
+            "cron"
+          ],
+          "x-order": 1
+        }
+      }
+    },
+    "VerifiedScorecardResult": {
+      "type": "object",
+      "properties": {
+        "accessToken": {
+          "type": "string"
+        },
+        "branch": {
+          "type": "string"
+        },
+        "result": {
+          "type": "string"
+        },
+        "tlogIndex": {
+          "type": "integer"
+        }
+      }
+    },
+    "WeightedCheck": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "x-order": 0
+        },
+        "score": {
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 1
+        },
+        "weight": {
+          "type": "number",
+          "x-omitempty": false,
+          "x-order": 2
+        }
+      }
+    },
+    "WeightedScore": {
+      "type": "object",
+      "properties": {
+        "checks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/WeightedCheck"
+          },
+          "x-order": 6
+        },
+        "commit": {
+          "type": "string",
+          "x-order": 2
+        },
+        "date": {
+          "type": "string",
+          "x-order": 1
+        },
+        "name": {
+          "description": "Full name of the repository, e.g. github.com/ossf/scorecard",
+          "type": "string",
+          "x-order": 0
+        },
+        "profile": {
+          "description": "Name of the weight profile used",
+          "type": "string",
+          "x-order": 3
+        },
+        "publishedScore": {
+          "description": "Aggregate score the result was published with",
+          "type": "number",
+          "x-omitempty": false,
+          "x-order": 5
+        },
+        "score": {
+          "description": "Weighted aggregate score, or -1 if no check counted",
+          "type": "number",
+          "x-omitempty": false,
+          "x-order": 4
+        }
+      }
+    }
+  },
+  "parameters": {
+    "org": {
+      "type": "string",
+      "description": "Name of the owner/organization of the repository",
+      "name": "org",
+      "in": "path",
+      "required": true
+    },
+    "platform": {
+      "type": "string",
+      "description": "VCS platform. eg. github.com",
+      "name": "platform",
+      "in": "path",
+      "required": true
+    },
+    "repo": {
+      "type": "string",
+      "description": "Name of the repository",
+      "name": "repo",
+      "in": "path",
+      "required": true
+    }
+  },
+  "responses": {
+    "BadRequest": {
+      "description": "The request provided to the server was invalid",
+      "schema": {
+        "$ref": "#/definitions/Error"
+      },
+      "headers": {
+        "Cache-Control": {
+          "type": "string",
+          "description": "TTL for browser caching. Example: max-age=3600"
+        },
+        "Surrogate-Control": {
+          "type": "string",
+          "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+        }
+      }
+    },
+    "Gone": {
+      "description": "The result was taken down. The message is the reason.",
+      "schema": {
+        "$ref": "#/definitions/Error"
+      },
+      "headers": {
+        "Cache-Control": {
+          "type": "string",
+          "description": "TTL for browser caching. Example: max-age=3600"
+        },
+        "Surrogate-Control": {
+          "type": "string",
+          "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+        },
+        "Surrogate-Key": {
+          "type": "string",
+          "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
+        }
+      }
+    },
+    "InternalServerError": {
+      "description": "There was an internal error in the server while processing the request",
+      "schema": {
+        "$ref": "#/definitions/Error"
+      }
+    },
+    "NotFound": {
+      "description": "The content requested could not be found",
+      "headers": {
+        "Cache-Control": {
+          "type": "string",
+          "description": "TTL for browser caching. Example: max-age=3600"
+        },
+        "Surrogate-Control": {
+          "type": "string",
+          "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+        },
+        "Surrogate-Key": {
+          "type": "string",
+          "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
+        }
+      }
+    },
+    "Unauthorized": {
+      "description": "The request has no valid admin token",
+      "schema": {
+        "$ref": "#/definitions/Error"
+      }
+    }
+  },
+  "securityDefinitions": {
+    "adminToken": {
+      "description": "Admin API token, as configured by the server operator. Example: Bearer \u003ctoken\u003e",
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    }
+  },
+  "x-google-allow": "all",
+  "x-google-backend": {
+    "address": "https://scorecard-api-prod-x3pmld2cbq-uc.a.run.app",
+    "protocol": "h2"
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "API to interact with a project's published Scorecard result",
+    "title": "OpenSSF Scorecard API",
+    "version": "1.0.0"
+  },
+  "host": "api.securityscorecards.dev",
+  "paths": {
+    "/admin/metrics": {
+      "get": {
+        "security": [
+          {
+            "adminToken": []
+          }
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "Get the instance's CDN purge queue metrics",
+        "operationId": "getMetrics",
+        "responses": {
+          "200": {
+            "description": "The instance's metrics",
+            "schema": {
+              "$ref": "#/definitions/Metrics"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "Always no-store, the metrics are of the instance serving the request"
+              }
+            }
+          },
+          "401": {
+            "description": "The request has no valid admin token",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "default": {
+            "description": "There was an internal error in the server while processing the request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/projects/{platform}/{org}/{repo}": {
+      "get": {
+        "security": [
+          {
+            "adminToken": []
+          }
+        ],
+        "description": "Lists the latest result and the per-commit results stored for the repository, from the Scorecard action and the weekly cron scan, with the overrides set on the repository and its commits.\n",
+        "tags": [
+          "admin"
+        ],
+        "summary": "List a repository's stored results and their overrides",
+        "operationId": "listAdminResults",
+        "responses": {
+          "200": {
+            "description": "The repository's stored results and overrides",
+            "schema": {
+              "$ref": "#/definitions/AdminResults"
+            }
+          },
+          "400": {
+            "description": "The request provided to the server was invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              }
+            }
+          },
+          "401": {
+            "description": "The request has no valid admin token",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "default": {
+            "description": "There was an internal error in the server while processing the request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "VCS platform. eg. github.com",
+          "name": "platform",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "description": "Name of the owner/organization of the repository",
+          "name": "org",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "description": "Name of the repository",
+          "name": "repo",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/admin/projects/{platform}/{org}/{repo}/annotate": {
+      "post": {
+        "security": [
+          {
+            "adminToken": []
+          }
+        ],
+        "description": "The note is returned in the X-Scorecard-Annotation header of the results. An empty annotation removes it. Cached responses are purged.\n",
+        "tags": [
+          "admin"
+        ],
+        "summary": "Attach a note to a repository's results, or the result of one of its commits",
+        "operationId": "annotateResult",
+        "parameters": [
+          {
+            "name": "override",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/OverrideRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The updated override",
+            "schema": {
+              "$ref": "#/definitions/ResultOverride"
+            }
+          },
+          "400": {
+            "description": "The request provided to the server was invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              }
+            }
+          },
+          "401": {
+            "description": "The request has no valid admin token",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "default": {
+            "description": "There was an internal error in the server while processing the request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "VCS platform. eg. github.com",
+          "name": "platform",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "description": "Name of the owner/organization of the repository",
+          "name": "org",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "description": "Name of the repository",
+          "name": "repo",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/admin/projects/{platform}/{org}/{repo}/audit": {
+      "get": {
+        "security": [
+          {
+            "adminToken": []
+          }
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "List the changes made to a repository's overrides, oldest first",
+        "operationId": "getAuditLog",
+        "responses": {
+          "200": {
+            "description": "The repository's audit log",
+            "schema": {
+              "$ref": "#/definitions/AuditLog"
+            }
+          },
+          "400": {
+            "description": "The request provided to the server was invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              }
+            }
+          },
+          "401": {
+            "description": "The request has no valid admin token",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "default": {
+            "description": "There was an internal error in the server while processing the request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "VCS platform. eg. github.com",
+          "name": "platform",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "description": "Name of the owner/organization of the repository",
+          "name": "org",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "description": "Name of the repository",
+          "name": "repo",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/admin/projects/{platform}/{org}/{repo}/restore": {
+      "post": {
+        "security": [
+          {
+            "adminToken": []
+          }
+        ],
+        "description": "Serves the repository's results, or the result of one of its commits, again. Any annotation is kept. Cached responses are purged.\n",
+        "tags": [
+          "admin"
+        ],
+        "summary": "Restore tombstoned results",
+        "operationId": "restoreResult",
+        "parameters": [
+          {
+            "name": "override",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/OverrideRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The updated override",
+            "schema": {
+              "$ref": "#/definitions/ResultOverride"
+            }
+          },
+          "400": {
+            "description": "The request provided to the server was invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              }
+            }
+          },
+          "401": {
+            "description": "The request has no valid admin token",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "There is no override to restore"
+          },
+          "default": {
+            "description": "There was an internal error in the server while processing the request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "VCS platform. eg. github.com",
+          "name": "platform",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "description": "Name of the owner/organization of the repository",
+          "name": "org",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "description": "Name of the repository",
+          "name": "repo",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/admin/projects/{platform}/{org}/{repo}/tombstone": {
+      "post": {
+        "security": [
+          {
+            "adminToken": []
+          }
+        ],
+        "description": "The result requests and the badge of a tombstoned repository, or the result requests of a tombstoned commit, return 410 Gone with the reason. Cached responses are purged.\n",
+        "tags": [
+          "admin"
+        ],
+        "summary": "Take down a repository's results, or the result of one of its commits",
+        "operationId": "tombstoneResult",
+        "parameters": [
+          {
+            "name": "override",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/OverrideRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The updated override",
+            "schema": {
+              "$ref": "#/definitions/ResultOverride"
+            }
+          },
+          "400": {
+            "description": "The request provided to the server was invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              }
+            }
+          },
+          "401": {
+            "description": "The request has no valid admin token",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "default": {
+            "description": "There was an internal error in the server while processing the request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "VCS platform. eg. github.com",
+          "name": "platform",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "description": "Name of the owner/organization of the repository",
+          "name": "org",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "description": "Name of the repository",
+          "name": "repo",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/projects/{platform}/{org}": {
+      "get": {
+        "description": "Lists the repositories of the organization in the search index, with their latest aggregate and per-check scores, and summary statistics over those repositories. Inconclusive check scores (-1) are excluded from means and medians, but included in the distributions. Repositories are paginated by name with limit and offset; truncated is set when there are more. The statistics are over every repository of the organization, not only the page's, so they are the same on every page. Repositories without a latest result, or whose latest result was taken down, are left out, so a page can have fewer than limit repositories.\n",
+        "tags": [
+          "results"
+        ],
+        "summary": "Get the latest ScorecardResults of every repository in an organization",
+        "operationId": "getOrgResults",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "VCS platform. eg. github.com",
+            "name": "platform",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the owner/organization",
+            "name": "org",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "Maximum number of repositories to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "default": 0,
+            "description": "Number of repositories to skip, for pagination",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The organization's repositories and summary statistics",
+            "schema": {
+              "$ref": "#/definitions/OrgResults"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              },
+              "Surrogate-Key": {
+                "type": "string",
+                "description": "Surrogate keys for Fastly CDN purging, e.g. org:github.com/org"
+              }
+            }
+          },
+          "400": {
+            "description": "The request provided to the server was invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              }
+            }
+          },
+          "404": {
+            "description": "The content requested could not be found",
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              },
+              "Surrogate-Key": {
+                "type": "string",
+                "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
+              }
+            }
+          },
+          "default": {
+            "description": "There was an internal error in the server while processing the request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/projects/{platform}/{org}/{repo}": {
+      "get": {
+        "description": "The json format is also available as YAML, as a CSV table of the checks, or as a Markdown summary, depending on the Accept header. It's the only operation with these media types: the others only produce JSON.\n",
+        "produces": [
+          "application/json",
+          "application/yaml",
+          "text/csv",
+          "text/markdown"
+        ],
+        "tags": [
+          "results"
+        ],
+        "summary": "Get a repository's ScorecardResult",
+        "operationId": "getResult",
+        "parameters": [
+          {
+            "pattern": "^[0-9a-fA-F]{40}$",
+            "type": "string",
+            "description": "SHA1 commit hash expressed in hexadecimal format",
+            "name": "commit",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "json",
+              "sarif",
+              "probe"
+            ],
+            "type": "string",
+            "default": "json",
+            "description": "Output format of the result. ` + "`" + `json` + "`" + ` returns the checks of the result, without probe findings. ` + "`" + `sarif` + "`" + ` converts the stored checks into a SARIF 2.1.0 log (application/sarif+json) with one rule per check. ` + "`" + `probe` + "`" + ` returns the structured probe findings of Scorecard v5 results as a ScorecardProbeResult, or 404 if the result has none.\n",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "action",
+              "cron"
+            ],
+            "type": "string",
+            "description": "Only serve the result published by the Scorecard action, or computed by the weekly cron scan. By default, the most recently written of the two is served.\n",
+            "name": "source",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "Maximum age in seconds of the stored result, from when it was last written. Returns 422 if the best available result, from the requested source if any, is older.\n",
+            "name": "maxAge",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Wrap the result in a ResultEnvelope with its source and age. Only supported with the json format.\n",
+            "name": "envelope",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "ETag from a previous response. Returns 304 if the result hasn't changed.",
+            "name": "If-None-Match",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "HTTP date from a previous Last-Modified header. Returns 304 if the result hasn't changed since. Ignored when If-None-Match is present.\n",
+            "name": "If-Modified-Since",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A JSON object of the repository's ScorecardResult",
+            "schema": {
+              "$ref": "#/definitions/ScorecardResult"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "ETag": {
+                "type": "string",
+                "description": "Strong validator for the stored result, for use with If-None-Match."
+              },
+              "Last-Modified": {
+                "type": "string",
+                "description": "Time the stored result was last written, for use with If-Modified-Since."
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              },
+              "Surrogate-Key": {
+                "type": "string",
+                "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
+              },
+              "X-Scorecard-Age": {
+                "type": "integer",
+                "description": "Age in seconds of the stored result when the response was generated"
+              },
+              "X-Scorecard-Annotation": {
+                "type": "string",
+                "description": "Note attached to the result by the server operator, if any"
+              },
+              "X-Scorecard-Source": {
+                "enum": [
+                  "action",
+                  "cron"
+                ],
+                "type": "string",
+                "description": "Where the stored result comes from: published by the Scorecard action, or computed by the weekly cron scan.\n"
+              }
+            }
+          },
+          "304": {
+            "description": "The stored result hasn't changed since the conditional request's validators",
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "ETag": {
+                "type": "string",
+                "description": "Strong validator for the stored result, for use with If-None-Match."
+              },
+              "Last-Modified": {
+                "type": "string",
+                "description": "Time the stored result was last written, for use with If-Modified-Since."
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              },
+              "Surrogate-Key": {
+                "type": "string",
+                "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
+              },
+              "X-Scorecard-Age": {
+                "type": "integer",
+                "description": "Age in seconds of the stored result when the response was generated"
+              },
+              "X-Scorecard-Annotation": {
+                "type": "string",
+                "description": "Note attached to the result by the server operator, if any"
+              },
+              "X-Scorecard-Source": {
+                "enum": [
+                  "action",
+                  "cron"
+                ],
+                "type": "string",
+                "description": "Where the stored result comes from: published by the Scorecard action, or computed by the weekly cron scan.\n"
+              }
+            }
+          },
+          "400": {
+            "description": "The request provided to the server was invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              }
+            }
+          },
+          "404": {
+            "description": "The content requested could not be found",
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              },
+              "Surrogate-Key": {
+                "type": "string",
+                "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
+              }
+            }
+          },
+          "406": {
+            "description": "The sarif and probe formats, and envelopes, are only available as JSON, which the Accept header doesn't accept. The response depends on the Accept header, so it isn't cached.\n",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              }
+            }
+          },
+          "410": {
+            "description": "The result was taken down. The message is the reason.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              },
+              "Surrogate-Key": {
+                "type": "string",
+                "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
+              }
+            }
+          },
+          "422": {
+            "description": "The best available result is older than maxAge",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              },
+              "Surrogate-Key": {
+                "type": "string",
+                "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
+              },
+              "X-Scorecard-Age": {
+                "type": "integer"
+              },
+              "X-Scorecard-Source": {
+                "enum": [
+                  "action",
+                  "cron"
+                ],
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "There was an internal error in the server while processing the request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "results"
+        ],
+        "summary": "Publish a repository's OIDC verified ScorecardResult",
+        "operationId": "postResult",
+        "parameters": [
+          {
+            "name": "publish",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VerifiedScorecardResult"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Successfully updated ScorecardResult",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "The request provided to the server was invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              }
+            }
+          },
+          "default": {
+            "description": "There was an internal error in the server while processing the request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "VCS platform. eg. github.com",
+          "name": "platform",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "description": "Name of the owner/organization of the repository",
+          "name": "org",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "description": "Name of the repository",
+          "name": "repo",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/projects/{platform}/{org}/{repo}/badge": {
+      "get": {
+        "produces": [
+          "image/svg+xml"
+        ],
+        "tags": [
+          "badge"
+        ],
+        "summary": "Get a repository's Scorecard badge",
+        "operationId": "getBadge",
+        "parameters": [
+          {
+            "enum": [
+              "plastic",
+              "flat",
+              "flat-square",
+              "for-the-badge",
+              "social"
+            ],
+            "type": "string",
+            "default": "flat",
+            "description": "Style to render the badge",
+            "name": "style",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "VCS platform. eg. github.com",
+            "name": "platform",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the owner/organization of the repository",
+            "name": "org",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the repository",
+            "name": "repo",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "302": {
+            "description": "Scorecard badge for the repository",
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              },
+              "Surrogate-Key": {
+                "type": "string",
+                "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
+              }
+            }
+          },
+          "410": {
+            "description": "The repository's results were taken down. The plain text body is the reason.",
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              },
+              "Surrogate-Key": {
+                "type": "string",
+                "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
+              }
+            }
+          },
+          "default": {
+            "description": "There was an internal error in the server while processing the request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/projects/{platform}/{org}/{repo}/evaluate": {
+      "post": {
+        "description": "Evaluates the stored result against the policy, and returns whether it passes with the reason for every failed rule. A failing result is still a successful evaluation, so it's returned with a 200.\n",
+        "tags": [
+          "results"
+        ],
+        "summary": "Evaluate a repository's ScorecardResult against a policy",
+        "operationId": "evaluatePolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "VCS platform. eg. github.com",
+            "name": "platform",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the owner/organization of the repository",
+            "name": "org",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the repository",
+            "name": "repo",
+            "in": "path",
+            "required": true
+          },
+          {
+            "pattern": "^[0-9a-fA-F]{40}$",
+            "type": "string",
+            "description": "SHA1 commit hash expressed in hexadecimal format",
+            "name": "commit",
+            "in": "query"
+          },
+          {
+            "name": "policy",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Policy"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The outcome of the evaluation",
+            "schema": {
+              "$ref": "#/definitions/PolicyEvaluation"
+            }
+          },
+          "400": {
+            "description": "The request provided to the server was invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              }
+            }
+          },
+          "404": {
+            "description": "The content requested could not be found",
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              },
+              "Surrogate-Key": {
+                "type": "string",
+                "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
+              }
+            }
+          },
+          "410": {
+            "description": "The result was taken down. The message is the reason.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              },
+              "Surrogate-Key": {
+                "type": "string",
+                "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
+              }
+            }
+          },
+          "default": {
+            "description": "There was an internal error in the server while processing the request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/projects/{platform}/{org}/{repo}/score": {
+      "get": {
+        "description": "Recomputes the aggregate score from the stored check scores, rather than returning the score the result was published with, so repositories can be ranked consistently. The ` + "`" + `default` + "`" + ` profile uses Scorecard's risk weights (Critical 10, High 7.5, Medium 5, Low 2.5); other profiles are configured by the server operator. Inconclusive checks (-1) and checks with no weight don't count, and the score is -1 if no check does.\n",
+        "tags": [
+          "results"
+        ],
+        "summary": "Recompute a repository's aggregate score with a weight profile",
+        "operationId": "getScore",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "VCS platform. eg. github.com",
+            "name": "platform",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the owner/organization of the repository",
+            "name": "org",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the repository",
+            "name": "repo",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "default": "default",
+            "description": "Name of the weight profile",
+            "name": "weights",
+            "in": "query"
+          },
+          {
+            "pattern": "^[0-9a-fA-F]{40}$",
+            "type": "string",
+            "description": "SHA1 commit hash expressed in hexadecimal format",
+            "name": "commit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The recomputed score",
+            "schema": {
+              "$ref": "#/definitions/WeightedScore"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              },
+              "Surrogate-Key": {
+                "type": "string",
+                "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
+              }
+            }
+          },
+          "400": {
+            "description": "The request provided to the server was invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              }
+            }
+          },
+          "404": {
+            "description": "The content requested could not be found",
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              },
+              "Surrogate-Key": {
+                "type": "string",
+                "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
+              }
+            }
+          },
+          "410": {
+            "description": "The result was taken down. The message is the reason.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              },
+              "Surrogate-Key": {
+                "type": "string",
+                "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
+              }
+            }
+          },
+          "default": {
+            "description": "There was an internal error in the server while processing the request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/search": {
+      "get": {
+        "description": "Searches the index of the latest result of each repository. The index is updated whenever a result is published, and backfilled from the weekly scan. Results are paginated with limit and offset; truncated is set when there are more. Score filters apply to the given check, or to the aggregate score without one. Inconclusive check scores (-1) never match score filters. Taken down results are left out, so a page can have fewer than limit results even when truncated is set. Unless the server has a database index, searches of many repositories must filter by platform and org.\n",
+        "tags": [
+          "results"
+        ],
+        "summary": "Search the latest ScorecardResults of repositories",
+        "operationId": "searchResults",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "VCS platform. eg. github.com",
+            "name": "platform",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Name of the owner/organization of the repositories",
+            "name": "org",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Name of the check the score filters apply to. eg. Branch-Protection",
+            "name": "check",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "description": "Minimum score, inclusive",
+            "name": "minScore",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "description": "Maximum score, inclusive",
+            "name": "maxScore",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Scorecard version, with * and ? wildcards. eg. v5.*",
+            "name": "scorecardVersion",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only results computed on or after this date (YYYY-MM-DD or RFC 3339)",
+            "name": "updatedSince",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "Maximum number of results to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "default": 0,
+            "description": "Number of matching results to skip, for pagination",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "name",
+              "-name",
+              "score",
+              "-score",
+              "date",
+              "-date"
+            ],
+            "type": "string",
+            "default": "name",
+            "description": "Order of the results. score is the score of the given check, or the aggregate score without one. A leading - sorts in descending order. Ties are sorted by name.\n",
+            "name": "sort",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The matching repositories",
+            "schema": {
+              "$ref": "#/definitions/SearchResults"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              }
+            }
+          },
+          "400": {
+            "description": "The request provided to the server was invalid",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "TTL for browser caching. Example: max-age=3600"
+              },
+              "Surrogate-Control": {
+                "type": "string",
+                "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+              }
+            }
+          },
+          "default": {
+            "description": "There was an internal error in the server while processing the request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "AdminResults": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "x-order": 0
+        },
+        "overrides": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ResultOverride"
+          },
+          "x-omitempty": true,
+          "x-order": 2
+        },
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/StoredResult"
+          },
+          "x-omitempty": true,
+          "x-order": 1
+        }
+      }
+    },
+    "AuditEvent": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "tombstone",
+            "restore",
+            "annotate"
+          ],
+          "x-order": 2
+        },
+        "actor": {
+          "description": "Name of the admin token used",
+          "type": "string",
+          "x-order": 1
+        },
+        "annotation": {
+          "type": "string",
+          "x-order": 6
+        },
+        "commit": {
+          "type": "string",
+          "x-order": 4
+        },
+        "name": {
+          "type": "string",
+          "x-order": 3
+        },
+        "reason": {
+          "type": "string",
+          "x-order": 5
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time",
+          "x-order": 0
+        }
+      }
+    },
+    "AuditLog": {
+      "type": "object",
+      "properties": {
+        "events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AuditEvent"
+          },
+          "x-omitempty": true,
+          "x-order": 0
+        }
+      }
+    },
+    "CheckScore": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "x-order": 0
+        },
+        "score": {
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 1
+        }
+      }
+    },
+    "CheckStats": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "x-order": 0
+        },
+        "stats": {
+          "x-order": 1,
+          "$ref": "#/definitions/ScoreStats"
+        }
+      }
+    },
+    "Error": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer"
+        },
+        "fields": {
+          "description": "The invalid fields of the request, when known",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FieldError"
+          }
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "FieldError": {
+      "type": "object",
+      "properties": {
+        "field": {
+          "description": "Path of the invalid field in the request body, e.g. result.checks.0.score",
+          "type": "string",
+          "x-order": 0
+        },
+        "message": {
+          "type": "string",
+          "x-order": 1
+        }
+      }
+    },
+    "FindingLocation": {
+      "type": "object",
+      "properties": {
+        "lineEnd": {
+          "type": "integer",
+          "x-order": 3
+        },
+        "lineStart": {
+          "type": "integer",
+          "x-order": 2
+        },
+        "path": {
+          "type": "string",
+          "x-order": 1
+        },
+        "snippet": {
+          "type": "string",
+          "x-order": 4
+        },
+        "type": {
+          "description": "Scorecard file type, e.g. 1 for source files and 4 for URLs",
+          "type": "integer",
+          "x-order": 0
+        }
+      }
+    },
+    "FindingRemediation": {
+      "type": "object",
+      "properties": {
+        "effort": {
+          "description": "Scorecard remediation effort, 1 (low) to 3 (high)",
+          "type": "integer",
+          "x-order": 2
+        },
+        "markdown": {
+          "type": "string",
+          "x-order": 1
+        },
+        "patch": {
+          "type": "string",
+          "x-order": 3
+        },
+        "text": {
+          "type": "string",
+          "x-order": 0
+        }
+      }
+    },
+    "Metrics": {
+      "type": "object",
+      "properties": {
+        "cdnPurgeBacklog": {
+          "description": "Number of CDN purges pending, including the ones being retried",
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 0
+        },
+        "cdnPurgeDeadLetters": {
+          "description": "Number of CDN purges given up on since the instance started",
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 1
+        }
+      }
+    },
+    "OrgResults": {
+      "type": "object",
+      "properties": {
+        "org": {
+          "type": "string",
+          "x-order": 1
+        },
+        "platform": {
+          "type": "string",
+          "x-order": 0
+        },
+        "repos": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RepoSummary"
+          },
+          "x-order": 2
+        },
+        "summary": {
+          "x-order": 3,
+          "$ref": "#/definitions/OrgSummary"
+        },
+        "truncated": {
+          "description": "Whether the organization has more repositories past the offset and limit",
+          "type": "boolean",
+          "x-omitempty": false,
+          "x-order": 4
+        }
+      }
+    },
+    "OrgSummary": {
+      "type": "object",
+      "properties": {
+        "checks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CheckStats"
+          },
+          "x-order": 2
+        },
+        "repos": {
+          "description": "Number of repositories of the organization with results, across all pages",
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 0
+        },
+        "score": {
+          "x-order": 1,
+          "$ref": "#/definitions/ScoreStats"
+        }
+      }
+    },
+    "OverrideRequest": {
+      "type": "object",
+      "required": [
+        "reason"
+      ],
+      "properties": {
+        "annotation": {
+          "description": "Note to attach to the results, for annotateResult",
+          "type": "string",
+          "x-order": 2
+        },
+        "commit": {
+          "description": "SHA1 of the commit whose result to override, or empty for the whole repository",
+          "type": "string",
+          "pattern": "^[0-9a-fA-F]{40}$",
+          "x-order": 0
+        },
+        "reason": {
+          "description": "Why the change is made, recorded in the audit log and returned with 410 responses",
+          "type": "string",
+          "minLength": 1,
+          "x-order": 1
+        }
+      }
+    },
+    "Policy": {
+      "description": "Rules a result must satisfy. Rules which aren't set aren't checked.",
+      "type": "object",
+      "properties": {
+        "maxAgeDays": {
+          "description": "Maximum age of the result in days, from its date",
+          "type": "integer",
+          "x-order": 3
+        },
+        "minCheckScores": {
+          "description": "Minimum score, 0 to 10, of each listed check. Listed checks which are missing or inconclusive fail.\n",
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer"
+          },
+          "x-order": 1
+        },
+        "minScore": {
+          "description": "Minimum aggregate score, 0 to 10",
+          "type": "number",
+          "x-order": 0
+        },
+        "minScorecardVersion": {
+          "description": "Minimum semantic version of Scorecard the result was computed with, e.g. v5.0.0",
+          "type": "string",
+          "x-order": 4
+        },
+        "requiredChecks": {
+          "description": "Checks which must have a conclusive score, whatever it is",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-order": 2
+        }
+      }
+    },
+    "PolicyEvaluation": {
+      "type": "object",
+      "properties": {
+        "commit": {
+          "type": "string",
+          "x-order": 3
+        },
+        "date": {
+          "type": "string",
+          "x-order": 2
+        },
+        "name": {
+          "description": "Full name of the repository, e.g. github.com/ossf/scorecard",
+          "type": "string",
+          "x-order": 1
+        },
+        "pass": {
+          "type": "boolean",
+          "x-omitempty": false,
+          "x-order": 0
+        },
+        "scorecardVersion": {
+          "type": "string",
+          "x-order": 4
+        },
+        "violations": {
+          "description": "Every rule the result failed",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/PolicyViolation"
+          },
+          "x-order": 5
+        }
+      }
+    },
+    "PolicyViolation": {
+      "type": "object",
+      "properties": {
+        "check": {
+          "description": "Check the violation is about, for per-check rules",
+          "type": "string",
+          "x-order": 1
+        },
+        "message": {
+          "type": "string",
+          "x-order": 2
+        },
+        "rule": {
+          "type": "string",
+          "enum": [
+            "minScore",
+            "minCheckScores",
+            "requiredChecks",
+            "maxAgeDays",
+            "minScorecardVersion"
+          ],
+          "x-order": 0
+        }
+      }
+    },
+    "ProbeFinding": {
       "type": "object",
       "properties": {
-        "code": {
-          "type": "integer"
+        "location": {
+          "x-order": 3,
+          "$ref": "#/definitions/FindingLocation"
         },
         "message": {
-          "type": "string"
+          "type": "string",
+          "x-order": 2
+        },
+        "outcome": {
+          "type": "string",
+          "enum": [
+            "True",
+            "False",
+            "NotAvailable",
+            "Error",
+            "NotSupported",
+            "NotApplicable"
+          ],
+          "x-order": 1
+        },
+        "probe": {
+          "description": "Name of the probe, e.g. hasDangerousWorkflowScriptInjection",
+          "type": "string",
+          "x-order": 0
+        },
+        "remediation": {
+          "x-order": 4,
+          "$ref": "#/definitions/FindingRemediation"
+        },
+        "values": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "x-order": 5
         }
       }
     },
@@ -660,6 +3735,134 @@ func init() {
         }
       }
     },
+    "RepoSummary": {
+      "type": "object",
+      "properties": {
+        "checks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CheckScore"
+          },
+          "x-order": 4
+        },
+        "date": {
+          "type": "string",
+          "x-order": 1
+        },
+        "name": {
+          "description": "repository that was analyzed, e.g. github.com/org/repo",
+          "type": "string",
+          "x-order": 0
+        },
+        "score": {
+          "description": "Aggregate score of the repository",
+          "type": "number",
+          "x-omitempty": false,
+          "x-order": 2
+        },
+        "scorecardVersion": {
+          "description": "Scorecard version used for the analysis",
+          "type": "string",
+          "x-order": 3
+        }
+      }
+    },
+    "ResultEnvelope": {
+      "description": "A ScorecardResult with where it comes from and how old it is",
+      "type": "object",
+      "properties": {
+        "age": {
+          "description": "Age in seconds of the stored result when the response was generated",
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 2
+        },
+        "lastModified": {
+          "description": "Time the stored result was last written",
+          "type": "string",
+          "format": "date-time",
+          "x-order": 1
+        },
+        "result": {
+          "x-order": 3,
+          "$ref": "#/definitions/ScorecardResult"
+        },
+        "source": {
+          "type": "string",
+          "enum": [
+            "action",
+            "cron"
+          ],
+          "x-order": 0
+        }
+      }
+    },
+    "ResultOverride": {
+      "type": "object",
+      "properties": {
+        "annotation": {
+          "type": "string",
+          "x-order": 5
+        },
+        "commit": {
+          "description": "SHA1 of the overridden commit, or empty for the whole repository",
+          "type": "string",
+          "x-order": 1
+        },
+        "name": {
+          "description": "Full name of the repository, e.g. github.com/ossf/scorecard",
+          "type": "string",
+          "x-order": 0
+        },
+        "reason": {
+          "description": "Reason of the latest change",
+          "type": "string",
+          "x-order": 3
+        },
+        "tombstoneReason": {
+          "description": "Why the results were taken down, while they are",
+          "type": "string",
+          "x-order": 4
+        },
+        "tombstoned": {
+          "type": "boolean",
+          "x-omitempty": false,
+          "x-order": 2
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-order": 7
+        },
+        "updatedBy": {
+          "type": "string",
+          "x-order": 6
+        }
+      }
+    },
+    "ScoreStats": {
+      "type": "object",
+      "properties": {
+        "distribution": {
+          "description": "Number of repositories per score. Aggregate scores are rounded down.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer"
+          },
+          "x-order": 2
+        },
+        "mean": {
+          "type": "number",
+          "x-omitempty": false,
+          "x-order": 0
+        },
+        "median": {
+          "type": "number",
+          "x-omitempty": false,
+          "x-order": 1
+        }
+      }
+    },
     "ScorecardCheck": {
       "type": "object",
       "properties": {
@@ -691,7 +3894,11 @@ func init() {
           "x-order": 2
         },
         "score": {
+          "description": "Score of the check, or -1 if inconclusive",
           "type": "integer",
+          "maximum": 10,
+          "minimum": -1,
+          "x-nullable": false,
           "x-omitempty": false,
           "x-order": 1
         }
@@ -709,6 +3916,35 @@ func init() {
       },
       "x-order": 3
     },
+    "ScorecardProbeResult": {
+      "description": "The probe findings of a ScorecardResult, as output by scorecard --format=probe",
+      "type": "object",
+      "properties": {
+        "date": {
+          "type": "string",
+          "x-order": 0
+        },
+        "findings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ProbeFinding"
+          },
+          "x-order": 3
+        },
+        "metadata": {
+          "type": "string",
+          "x-order": 4
+        },
+        "repo": {
+          "x-order": 1,
+          "$ref": "#/definitions/Repo"
+        },
+        "scorecard": {
+          "x-order": 2,
+          "$ref": "#/definitions/ScorecardVersion"
+        }
+      }
+    },
     "ScorecardResult": {
       "type": "object",
       "properties": {
@@ -723,17 +3959,29 @@ func init() {
           "type": "string",
           "x-order": 0
         },
+        "findings": {
+          "description": "Probe findings, published by Scorecard v5 and later",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ProbeFinding"
+          },
+          "x-omitempty": true,
+          "x-order": 5
+        },
         "metadata": {
           "type": "string",
-          "x-order": 5
+          "x-order": 6
         },
         "repo": {
           "x-order": 1,
           "$ref": "#/definitions/Repo"
         },
         "score": {
-          "description": "Aggregate score of the repository",
+          "description": "Aggregate score of the repository, or -1 if inconclusive",
           "type": "number",
+          "maximum": 10,
+          "minimum": -1,
+          "x-nullable": false,
           "x-omitempty": false,
           "x-order": 3
         },
@@ -760,6 +4008,47 @@ func init() {
         }
       }
     },
+    "SearchResults": {
+      "type": "object",
+      "properties": {
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RepoSummary"
+          },
+          "x-order": 0
+        },
+        "truncated": {
+          "description": "Whether more repositories matched past the offset and limit",
+          "type": "boolean",
+          "x-omitempty": false,
+          "x-order": 1
+        }
+      }
+    },
+    "StoredResult": {
+      "type": "object",
+      "properties": {
+        "commit": {
+          "description": "SHA1 of the commit, or empty for the latest result",
+          "type": "string",
+          "x-order": 0
+        },
+        "lastModified": {
+          "type": "string",
+          "format": "date-time",
+          "x-order": 2
+        },
+        "source": {
+          "type": "string",
+          "enum": [
+            "action",
+            "cron"
+          ],
+          "x-order": 1
+        }
+      }
+    },
     "VerifiedScorecardResult": {
       "type": "object",
       "properties": {
@@ -776,6 +4065,90 @@ func init() {
           "type": "integer"
         }
       }
+    },
+    "WeightedCheck": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "x-order": 0
+        },
+        "score": {
+          "type": "integer",
+          "x-omitempty": false,
+          "x-order": 1
+        },
+        "weight": {
+          "type": "number",
+          "x-omitempty": false,
+          "x-order": 2
+        }
+      }
+    },
+    "WeightedScore": {
+      "type": "object",
+      "properties": {
+        "checks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/WeightedCheck"
+          },
+          "x-order": 6
+        },
+        "commit": {
+          "type": "string",
+          "x-order": 2
+        },
+        "date": {
+          "type": "string",
+          "x-order": 1
+        },
+        "name": {
+          "description": "Full name of the repository, e.g. github.com/ossf/scorecard",
+          "type": "string",
+          "x-order": 0
+        },
+        "profile": {
+          "description": "Name of the weight profile used",
+          "type": "string",
+          "x-order": 3
+        },
+        "publishedScore": {
+          "description": "Aggregate score the result was published with",
+          "type": "number",
+          "x-omitempty": false,
+          "x-order": 5
+        },
+        "score": {
+          "description": "Weighted aggregate score, or -1 if no check counted",
+          "type": "number",
+          "x-omitempty": false,
+          "x-order": 4
+        }
+      }
+    }
+  },
+  "parameters": {
+    "org": {
+      "type": "string",
+      "description": "Name of the owner/organization of the repository",
+      "name": "org",
+      "in": "path",
+      "required": true
+    },
+    "platform": {
+      "type": "string",
+      "description": "VCS platform. eg. github.com",
+      "name": "platform",
+      "in": "path",
+      "required": true
+    },
+    "repo": {
+      "type": "string",
+      "description": "Name of the repository",
+      "name": "repo",
+      "in": "path",
+      "required": true
     }
   },
   "responses": {
@@ -795,6 +4168,26 @@ func init() {
         }
       }
     },
+    "Gone": {
+      "description": "The result was taken down. The message is the reason.",
+      "schema": {
+        "$ref": "#/definitions/Error"
+      },
+      "headers": {
+        "Cache-Control": {
+          "type": "string",
+          "description": "TTL for browser caching. Example: max-age=3600"
+        },
+        "Surrogate-Control": {
+          "type": "string",
+          "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+        },
+        "Surrogate-Key": {
+          "type": "string",
+          "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
+        }
+      }
+    },
     "InternalServerError": {
       "description": "There was an internal error in the server while processing the request",
       "schema": {
@@ -811,8 +4204,26 @@ func init() {
         "Surrogate-Control": {
           "type": "string",
           "description": "TTL for Fastly CDN caching. Example: max-age=3600"
+        },
+        "Surrogate-Key": {
+          "type": "string",
+          "description": "Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo"
         }
       }
+    },
+    "Unauthorized": {
+      "description": "The request has no valid admin token",
+      "schema": {
+        "$ref": "#/definitions/Error"
+      }
+    }
+  },
+  "securityDefinitions": {
+    "adminToken": {
+      "description": "Admin API token, as configured by the server operator. Example: Bearer \u003ctoken\u003e",
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
     }
   },
   "x-google-allow": "all",
@@ -0,0 +1,86 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// AnnotateResultHandlerFunc turns a function with the right signature into a annotate result handler
+type AnnotateResultHandlerFunc func(AnnotateResultParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn AnnotateResultHandlerFunc) Handle(params AnnotateResultParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// AnnotateResultHandler interface for that can handle valid annotate result params
+type AnnotateResultHandler interface {
+	Handle(AnnotateResultParams, interface{}) middleware.Responder
+}
+
+// NewAnnotateResult creates a new http.Handler for the annotate result operation
+func NewAnnotateResult(ctx *middleware.Context, handler AnnotateResultHandler) *AnnotateResult {
+	return &AnnotateResult{Context: ctx, Handler: handler}
+}
+
+/*
+	AnnotateResult swagger:route POST /admin/projects/{platform}/{org}/{repo}/annotate admin annotateResult
+
+# Attach a note to a repository's results, or the result of one of its commits
+
+The note is returned in the X-Scorecard-Annotation header of the results. An empty annotation removes it. Cached responses are purged.
+*/
+type AnnotateResult struct {
+	Context *middleware.Context
+	Handler AnnotateResultHandler
+}
+
+func (o *AnnotateResult) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewAnnotateResultParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,267 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// AnnotateResultOKCode is the HTTP code returned for type AnnotateResultOK
+const AnnotateResultOKCode int = 200
+
+/*
+AnnotateResultOK The updated override
+
+swagger:response annotateResultOK
+*/
+type AnnotateResultOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ResultOverride `json:"body,omitempty"`
+}
+
+// NewAnnotateResultOK creates AnnotateResultOK with default headers values
+func NewAnnotateResultOK() *AnnotateResultOK {
+
+	return &AnnotateResultOK{}
+}
+
+// WithPayload adds the payload to the annotate result o k response
+func (o *AnnotateResultOK) WithPayload(payload *models.ResultOverride) *AnnotateResultOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the annotate result o k response
+func (o *AnnotateResultOK) SetPayload(payload *models.ResultOverride) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AnnotateResultOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AnnotateResultBadRequestCode is the HTTP code returned for type AnnotateResultBadRequest
+const AnnotateResultBadRequestCode int = 400
+
+/*
+AnnotateResultBadRequest The request provided to the server was invalid
+
+swagger:response annotateResultBadRequest
+*/
+type AnnotateResultBadRequest struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewAnnotateResultBadRequest creates AnnotateResultBadRequest with default headers values
+func NewAnnotateResultBadRequest() *AnnotateResultBadRequest {
+
+	return &AnnotateResultBadRequest{}
+}
+
+// WithCacheControl adds the cacheControl to the annotate result bad request response
+func (o *AnnotateResultBadRequest) WithCacheControl(cacheControl string) *AnnotateResultBadRequest {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the annotate result bad request response
+func (o *AnnotateResultBadRequest) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithSurrogateControl adds the surrogateControl to the annotate result bad request response
+func (o *AnnotateResultBadRequest) WithSurrogateControl(surrogateControl string) *AnnotateResultBadRequest {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the annotate result bad request response
+func (o *AnnotateResultBadRequest) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithPayload adds the payload to the annotate result bad request response
+func (o *AnnotateResultBadRequest) WithPayload(payload *models.Error) *AnnotateResultBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the annotate result bad request response
+func (o *AnnotateResultBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AnnotateResultBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AnnotateResultUnauthorizedCode is the HTTP code returned for type AnnotateResultUnauthorized
+const AnnotateResultUnauthorizedCode int = 401
+
+/*
+AnnotateResultUnauthorized The request has no valid admin token
+
+swagger:response annotateResultUnauthorized
+*/
+type AnnotateResultUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewAnnotateResultUnauthorized creates AnnotateResultUnauthorized with default headers values
+func NewAnnotateResultUnauthorized() *AnnotateResultUnauthorized {
+
+	return &AnnotateResultUnauthorized{}
+}
+
+// WithPayload adds the payload to the annotate result unauthorized response
+func (o *AnnotateResultUnauthorized) WithPayload(payload *models.Error) *AnnotateResultUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the annotate result unauthorized response
+func (o *AnnotateResultUnauthorized) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AnnotateResultUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+AnnotateResultDefault There was an internal error in the server while processing the request
+
+swagger:response annotateResultDefault
+*/
+type AnnotateResultDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewAnnotateResultDefault creates AnnotateResultDefault with default headers values
+func NewAnnotateResultDefault(code int) *AnnotateResultDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &AnnotateResultDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the annotate result default response
+func (o *AnnotateResultDefault) WithStatusCode(code int) *AnnotateResultDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the annotate result default response
+func (o *AnnotateResultDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the annotate result default response
+func (o *AnnotateResultDefault) WithPayload(payload *models.Error) *AnnotateResultDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the annotate result default response
+func (o *AnnotateResultDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AnnotateResultDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,267 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// GetAuditLogOKCode is the HTTP code returned for type GetAuditLogOK
+const GetAuditLogOKCode int = 200
+
+/*
+GetAuditLogOK The repository's audit log
+
+swagger:response getAuditLogOK
+*/
+type GetAuditLogOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.AuditLog `json:"body,omitempty"`
+}
+
+// NewGetAuditLogOK creates GetAuditLogOK with default headers values
+func NewGetAuditLogOK() *GetAuditLogOK {
+
+	return &GetAuditLogOK{}
+}
+
+// WithPayload adds the payload to the get audit log o k response
+func (o *GetAuditLogOK) WithPayload(payload *models.AuditLog) *GetAuditLogOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get audit log o k response
+func (o *GetAuditLogOK) SetPayload(payload *models.AuditLog) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAuditLogOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetAuditLogBadRequestCode is the HTTP code returned for type GetAuditLogBadRequest
+const GetAuditLogBadRequestCode int = 400
+
+/*
+GetAuditLogBadRequest The request provided to the server was invalid
+
+swagger:response getAuditLogBadRequest
+*/
+type GetAuditLogBadRequest struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetAuditLogBadRequest creates GetAuditLogBadRequest with default headers values
+func NewGetAuditLogBadRequest() *GetAuditLogBadRequest {
+
+	return &GetAuditLogBadRequest{}
+}
+
+// WithCacheControl adds the cacheControl to the get audit log bad request response
+func (o *GetAuditLogBadRequest) WithCacheControl(cacheControl string) *GetAuditLogBadRequest {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the get audit log bad request response
+func (o *GetAuditLogBadRequest) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithSurrogateControl adds the surrogateControl to the get audit log bad request response
+func (o *GetAuditLogBadRequest) WithSurrogateControl(surrogateControl string) *GetAuditLogBadRequest {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the get audit log bad request response
+func (o *GetAuditLogBadRequest) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithPayload adds the payload to the get audit log bad request response
+func (o *GetAuditLogBadRequest) WithPayload(payload *models.Error) *GetAuditLogBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get audit log bad request response
+func (o *GetAuditLogBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAuditLogBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetAuditLogUnauthorizedCode is the HTTP code returned for type GetAuditLogUnauthorized
+const GetAuditLogUnauthorizedCode int = 401
+
+/*
+GetAuditLogUnauthorized The request has no valid admin token
+
+swagger:response getAuditLogUnauthorized
+*/
+type GetAuditLogUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetAuditLogUnauthorized creates GetAuditLogUnauthorized with default headers values
+func NewGetAuditLogUnauthorized() *GetAuditLogUnauthorized {
+
+	return &GetAuditLogUnauthorized{}
+}
+
+// WithPayload adds the payload to the get audit log unauthorized response
+func (o *GetAuditLogUnauthorized) WithPayload(payload *models.Error) *GetAuditLogUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get audit log unauthorized response
+func (o *GetAuditLogUnauthorized) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAuditLogUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetAuditLogDefault There was an internal error in the server while processing the request
+
+swagger:response getAuditLogDefault
+*/
+type GetAuditLogDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetAuditLogDefault creates GetAuditLogDefault with default headers values
+func NewGetAuditLogDefault(code int) *GetAuditLogDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetAuditLogDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get audit log default response
+func (o *GetAuditLogDefault) WithStatusCode(code int) *GetAuditLogDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get audit log default response
+func (o *GetAuditLogDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get audit log default response
+func (o *GetAuditLogDefault) WithPayload(payload *models.Error) *GetAuditLogDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get audit log default response
+func (o *GetAuditLogDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAuditLogDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+	"strings"
+)
+
+// GetAuditLogURL generates an URL for the get audit log operation
+type GetAuditLogURL struct {
+	Org      string
+	Platform string
+	Repo     string
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetAuditLogURL) WithBasePath(bp string) *GetAuditLogURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetAuditLogURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *GetAuditLogURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/admin/projects/{platform}/{org}/{repo}/audit"
+
+	org := o.Org
+	if org != "" {
+		_path = strings.Replace(_path, "{org}", org, -1)
+	} else {
+		return nil, errors.New("org is required on GetAuditLogURL")
+	}
+
+	platform := o.Platform
+	if platform != "" {
+		_path = strings.Replace(_path, "{platform}", platform, -1)
+	} else {
+		return nil, errors.New("platform is required on GetAuditLogURL")
+	}
+
+	repo := o.Repo
+	if repo != "" {
+		_path = strings.Replace(_path, "{repo}", repo, -1)
+	} else {
+		return nil, errors.New("repo is required on GetAuditLogURL")
+	}
+
+	_basePath := o._basePath
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *GetAuditLogURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *GetAuditLogURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *GetAuditLogURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on GetAuditLogURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on GetAuditLogURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *GetAuditLogURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
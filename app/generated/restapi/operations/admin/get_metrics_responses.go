@@ -0,0 +1,200 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// GetMetricsOKCode is the HTTP code returned for type GetMetricsOK
+const GetMetricsOKCode int = 200
+
+/*
+GetMetricsOK The instance's metrics
+
+swagger:response getMetricsOK
+*/
+type GetMetricsOK struct {
+	/*Always no-store, the metrics are of the instance serving the request
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Metrics `json:"body,omitempty"`
+}
+
+// NewGetMetricsOK creates GetMetricsOK with default headers values
+func NewGetMetricsOK() *GetMetricsOK {
+
+	return &GetMetricsOK{}
+}
+
+// WithCacheControl adds the cacheControl to the get metrics o k response
+func (o *GetMetricsOK) WithCacheControl(cacheControl string) *GetMetricsOK {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the get metrics o k response
+func (o *GetMetricsOK) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithPayload adds the payload to the get metrics o k response
+func (o *GetMetricsOK) WithPayload(payload *models.Metrics) *GetMetricsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get metrics o k response
+func (o *GetMetricsOK) SetPayload(payload *models.Metrics) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetMetricsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetMetricsUnauthorizedCode is the HTTP code returned for type GetMetricsUnauthorized
+const GetMetricsUnauthorizedCode int = 401
+
+/*
+GetMetricsUnauthorized The request has no valid admin token
+
+swagger:response getMetricsUnauthorized
+*/
+type GetMetricsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetMetricsUnauthorized creates GetMetricsUnauthorized with default headers values
+func NewGetMetricsUnauthorized() *GetMetricsUnauthorized {
+
+	return &GetMetricsUnauthorized{}
+}
+
+// WithPayload adds the payload to the get metrics unauthorized response
+func (o *GetMetricsUnauthorized) WithPayload(payload *models.Error) *GetMetricsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get metrics unauthorized response
+func (o *GetMetricsUnauthorized) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetMetricsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetMetricsDefault There was an internal error in the server while processing the request
+
+swagger:response getMetricsDefault
+*/
+type GetMetricsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetMetricsDefault creates GetMetricsDefault with default headers values
+func NewGetMetricsDefault(code int) *GetMetricsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetMetricsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get metrics default response
+func (o *GetMetricsDefault) WithStatusCode(code int) *GetMetricsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get metrics default response
+func (o *GetMetricsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get metrics default response
+func (o *GetMetricsDefault) WithPayload(payload *models.Error) *GetMetricsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get metrics default response
+func (o *GetMetricsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetMetricsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
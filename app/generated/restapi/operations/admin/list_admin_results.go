@@ -0,0 +1,86 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ListAdminResultsHandlerFunc turns a function with the right signature into a list admin results handler
+type ListAdminResultsHandlerFunc func(ListAdminResultsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListAdminResultsHandlerFunc) Handle(params ListAdminResultsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListAdminResultsHandler interface for that can handle valid list admin results params
+type ListAdminResultsHandler interface {
+	Handle(ListAdminResultsParams, interface{}) middleware.Responder
+}
+
+// NewListAdminResults creates a new http.Handler for the list admin results operation
+func NewListAdminResults(ctx *middleware.Context, handler ListAdminResultsHandler) *ListAdminResults {
+	return &ListAdminResults{Context: ctx, Handler: handler}
+}
+
+/*
+	ListAdminResults swagger:route GET /admin/projects/{platform}/{org}/{repo} admin listAdminResults
+
+# List a repository's stored results and their overrides
+
+Lists the latest result and the per-commit results stored for the repository, from the Scorecard action and the weekly cron scan, with the overrides set on the repository and its commits.
+*/
+type ListAdminResults struct {
+	Context *middleware.Context
+	Handler ListAdminResultsHandler
+}
+
+func (o *ListAdminResults) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListAdminResultsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,267 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// ListAdminResultsOKCode is the HTTP code returned for type ListAdminResultsOK
+const ListAdminResultsOKCode int = 200
+
+/*
+ListAdminResultsOK The repository's stored results and overrides
+
+swagger:response listAdminResultsOK
+*/
+type ListAdminResultsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.AdminResults `json:"body,omitempty"`
+}
+
+// NewListAdminResultsOK creates ListAdminResultsOK with default headers values
+func NewListAdminResultsOK() *ListAdminResultsOK {
+
+	return &ListAdminResultsOK{}
+}
+
+// WithPayload adds the payload to the list admin results o k response
+func (o *ListAdminResultsOK) WithPayload(payload *models.AdminResults) *ListAdminResultsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list admin results o k response
+func (o *ListAdminResultsOK) SetPayload(payload *models.AdminResults) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListAdminResultsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListAdminResultsBadRequestCode is the HTTP code returned for type ListAdminResultsBadRequest
+const ListAdminResultsBadRequestCode int = 400
+
+/*
+ListAdminResultsBadRequest The request provided to the server was invalid
+
+swagger:response listAdminResultsBadRequest
+*/
+type ListAdminResultsBadRequest struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListAdminResultsBadRequest creates ListAdminResultsBadRequest with default headers values
+func NewListAdminResultsBadRequest() *ListAdminResultsBadRequest {
+
+	return &ListAdminResultsBadRequest{}
+}
+
+// WithCacheControl adds the cacheControl to the list admin results bad request response
+func (o *ListAdminResultsBadRequest) WithCacheControl(cacheControl string) *ListAdminResultsBadRequest {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the list admin results bad request response
+func (o *ListAdminResultsBadRequest) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithSurrogateControl adds the surrogateControl to the list admin results bad request response
+func (o *ListAdminResultsBadRequest) WithSurrogateControl(surrogateControl string) *ListAdminResultsBadRequest {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the list admin results bad request response
+func (o *ListAdminResultsBadRequest) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithPayload adds the payload to the list admin results bad request response
+func (o *ListAdminResultsBadRequest) WithPayload(payload *models.Error) *ListAdminResultsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list admin results bad request response
+func (o *ListAdminResultsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListAdminResultsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListAdminResultsUnauthorizedCode is the HTTP code returned for type ListAdminResultsUnauthorized
+const ListAdminResultsUnauthorizedCode int = 401
+
+/*
+ListAdminResultsUnauthorized The request has no valid admin token
+
+swagger:response listAdminResultsUnauthorized
+*/
+type ListAdminResultsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListAdminResultsUnauthorized creates ListAdminResultsUnauthorized with default headers values
+func NewListAdminResultsUnauthorized() *ListAdminResultsUnauthorized {
+
+	return &ListAdminResultsUnauthorized{}
+}
+
+// WithPayload adds the payload to the list admin results unauthorized response
+func (o *ListAdminResultsUnauthorized) WithPayload(payload *models.Error) *ListAdminResultsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list admin results unauthorized response
+func (o *ListAdminResultsUnauthorized) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListAdminResultsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+ListAdminResultsDefault There was an internal error in the server while processing the request
+
+swagger:response listAdminResultsDefault
+*/
+type ListAdminResultsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListAdminResultsDefault creates ListAdminResultsDefault with default headers values
+func NewListAdminResultsDefault(code int) *ListAdminResultsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListAdminResultsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list admin results default response
+func (o *ListAdminResultsDefault) WithStatusCode(code int) *ListAdminResultsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list admin results default response
+func (o *ListAdminResultsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list admin results default response
+func (o *ListAdminResultsDefault) WithPayload(payload *models.Error) *ListAdminResultsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list admin results default response
+func (o *ListAdminResultsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListAdminResultsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,172 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// NewRestoreResultParams creates a new RestoreResultParams object
+//
+// There are no default values defined in the spec.
+func NewRestoreResultParams() RestoreResultParams {
+
+	return RestoreResultParams{}
+}
+
+// RestoreResultParams contains all the bound params for the restore result operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters restoreResult
+type RestoreResultParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Name of the owner/organization of the repository
+	  Required: true
+	  In: path
+	*/
+	Org string
+	/*
+	  Required: true
+	  In: body
+	*/
+	Override *models.OverrideRequest
+	/*VCS platform. eg. github.com
+	  Required: true
+	  In: path
+	*/
+	Platform string
+	/*Name of the repository
+	  Required: true
+	  In: path
+	*/
+	Repo string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewRestoreResultParams() beforehand.
+func (o *RestoreResultParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rOrg, rhkOrg, _ := route.Params.GetOK("org")
+	if err := o.bindOrg(rOrg, rhkOrg, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.OverrideRequest
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("override", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("override", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			ctx := validate.WithOperationRequest(r.Context())
+			if err := body.ContextValidate(ctx, route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.Override = &body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("override", "body", ""))
+	}
+
+	rPlatform, rhkPlatform, _ := route.Params.GetOK("platform")
+	if err := o.bindPlatform(rPlatform, rhkPlatform, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rRepo, rhkRepo, _ := route.Params.GetOK("repo")
+	if err := o.bindRepo(rRepo, rhkRepo, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindOrg binds and validates parameter Org from path.
+func (o *RestoreResultParams) bindOrg(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.Org = raw
+
+	return nil
+}
+
+// bindPlatform binds and validates parameter Platform from path.
+func (o *RestoreResultParams) bindPlatform(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.Platform = raw
+
+	return nil
+}
+
+// bindRepo binds and validates parameter Repo from path.
+func (o *RestoreResultParams) bindRepo(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.Repo = raw
+
+	return nil
+}
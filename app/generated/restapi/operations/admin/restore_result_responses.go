@@ -0,0 +1,292 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// RestoreResultOKCode is the HTTP code returned for type RestoreResultOK
+const RestoreResultOKCode int = 200
+
+/*
+RestoreResultOK The updated override
+
+swagger:response restoreResultOK
+*/
+type RestoreResultOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ResultOverride `json:"body,omitempty"`
+}
+
+// NewRestoreResultOK creates RestoreResultOK with default headers values
+func NewRestoreResultOK() *RestoreResultOK {
+
+	return &RestoreResultOK{}
+}
+
+// WithPayload adds the payload to the restore result o k response
+func (o *RestoreResultOK) WithPayload(payload *models.ResultOverride) *RestoreResultOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the restore result o k response
+func (o *RestoreResultOK) SetPayload(payload *models.ResultOverride) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RestoreResultOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RestoreResultBadRequestCode is the HTTP code returned for type RestoreResultBadRequest
+const RestoreResultBadRequestCode int = 400
+
+/*
+RestoreResultBadRequest The request provided to the server was invalid
+
+swagger:response restoreResultBadRequest
+*/
+type RestoreResultBadRequest struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRestoreResultBadRequest creates RestoreResultBadRequest with default headers values
+func NewRestoreResultBadRequest() *RestoreResultBadRequest {
+
+	return &RestoreResultBadRequest{}
+}
+
+// WithCacheControl adds the cacheControl to the restore result bad request response
+func (o *RestoreResultBadRequest) WithCacheControl(cacheControl string) *RestoreResultBadRequest {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the restore result bad request response
+func (o *RestoreResultBadRequest) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithSurrogateControl adds the surrogateControl to the restore result bad request response
+func (o *RestoreResultBadRequest) WithSurrogateControl(surrogateControl string) *RestoreResultBadRequest {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the restore result bad request response
+func (o *RestoreResultBadRequest) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithPayload adds the payload to the restore result bad request response
+func (o *RestoreResultBadRequest) WithPayload(payload *models.Error) *RestoreResultBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the restore result bad request response
+func (o *RestoreResultBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RestoreResultBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RestoreResultUnauthorizedCode is the HTTP code returned for type RestoreResultUnauthorized
+const RestoreResultUnauthorizedCode int = 401
+
+/*
+RestoreResultUnauthorized The request has no valid admin token
+
+swagger:response restoreResultUnauthorized
+*/
+type RestoreResultUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRestoreResultUnauthorized creates RestoreResultUnauthorized with default headers values
+func NewRestoreResultUnauthorized() *RestoreResultUnauthorized {
+
+	return &RestoreResultUnauthorized{}
+}
+
+// WithPayload adds the payload to the restore result unauthorized response
+func (o *RestoreResultUnauthorized) WithPayload(payload *models.Error) *RestoreResultUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the restore result unauthorized response
+func (o *RestoreResultUnauthorized) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RestoreResultUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RestoreResultNotFoundCode is the HTTP code returned for type RestoreResultNotFound
+const RestoreResultNotFoundCode int = 404
+
+/*
+RestoreResultNotFound There is no override to restore
+
+swagger:response restoreResultNotFound
+*/
+type RestoreResultNotFound struct {
+}
+
+// NewRestoreResultNotFound creates RestoreResultNotFound with default headers values
+func NewRestoreResultNotFound() *RestoreResultNotFound {
+
+	return &RestoreResultNotFound{}
+}
+
+// WriteResponse to the client
+func (o *RestoreResultNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+/*
+RestoreResultDefault There was an internal error in the server while processing the request
+
+swagger:response restoreResultDefault
+*/
+type RestoreResultDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRestoreResultDefault creates RestoreResultDefault with default headers values
+func NewRestoreResultDefault(code int) *RestoreResultDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &RestoreResultDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the restore result default response
+func (o *RestoreResultDefault) WithStatusCode(code int) *RestoreResultDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the restore result default response
+func (o *RestoreResultDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the restore result default response
+func (o *RestoreResultDefault) WithPayload(payload *models.Error) *RestoreResultDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the restore result default response
+func (o *RestoreResultDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RestoreResultDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
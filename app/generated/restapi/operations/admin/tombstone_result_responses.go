@@ -0,0 +1,267 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// TombstoneResultOKCode is the HTTP code returned for type TombstoneResultOK
+const TombstoneResultOKCode int = 200
+
+/*
+TombstoneResultOK The updated override
+
+swagger:response tombstoneResultOK
+*/
+type TombstoneResultOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ResultOverride `json:"body,omitempty"`
+}
+
+// NewTombstoneResultOK creates TombstoneResultOK with default headers values
+func NewTombstoneResultOK() *TombstoneResultOK {
+
+	return &TombstoneResultOK{}
+}
+
+// WithPayload adds the payload to the tombstone result o k response
+func (o *TombstoneResultOK) WithPayload(payload *models.ResultOverride) *TombstoneResultOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the tombstone result o k response
+func (o *TombstoneResultOK) SetPayload(payload *models.ResultOverride) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TombstoneResultOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// TombstoneResultBadRequestCode is the HTTP code returned for type TombstoneResultBadRequest
+const TombstoneResultBadRequestCode int = 400
+
+/*
+TombstoneResultBadRequest The request provided to the server was invalid
+
+swagger:response tombstoneResultBadRequest
+*/
+type TombstoneResultBadRequest struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewTombstoneResultBadRequest creates TombstoneResultBadRequest with default headers values
+func NewTombstoneResultBadRequest() *TombstoneResultBadRequest {
+
+	return &TombstoneResultBadRequest{}
+}
+
+// WithCacheControl adds the cacheControl to the tombstone result bad request response
+func (o *TombstoneResultBadRequest) WithCacheControl(cacheControl string) *TombstoneResultBadRequest {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the tombstone result bad request response
+func (o *TombstoneResultBadRequest) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithSurrogateControl adds the surrogateControl to the tombstone result bad request response
+func (o *TombstoneResultBadRequest) WithSurrogateControl(surrogateControl string) *TombstoneResultBadRequest {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the tombstone result bad request response
+func (o *TombstoneResultBadRequest) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithPayload adds the payload to the tombstone result bad request response
+func (o *TombstoneResultBadRequest) WithPayload(payload *models.Error) *TombstoneResultBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the tombstone result bad request response
+func (o *TombstoneResultBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TombstoneResultBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// TombstoneResultUnauthorizedCode is the HTTP code returned for type TombstoneResultUnauthorized
+const TombstoneResultUnauthorizedCode int = 401
+
+/*
+TombstoneResultUnauthorized The request has no valid admin token
+
+swagger:response tombstoneResultUnauthorized
+*/
+type TombstoneResultUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewTombstoneResultUnauthorized creates TombstoneResultUnauthorized with default headers values
+func NewTombstoneResultUnauthorized() *TombstoneResultUnauthorized {
+
+	return &TombstoneResultUnauthorized{}
+}
+
+// WithPayload adds the payload to the tombstone result unauthorized response
+func (o *TombstoneResultUnauthorized) WithPayload(payload *models.Error) *TombstoneResultUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the tombstone result unauthorized response
+func (o *TombstoneResultUnauthorized) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TombstoneResultUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+TombstoneResultDefault There was an internal error in the server while processing the request
+
+swagger:response tombstoneResultDefault
+*/
+type TombstoneResultDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewTombstoneResultDefault creates TombstoneResultDefault with default headers values
+func NewTombstoneResultDefault(code int) *TombstoneResultDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &TombstoneResultDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the tombstone result default response
+func (o *TombstoneResultDefault) WithStatusCode(code int) *TombstoneResultDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the tombstone result default response
+func (o *TombstoneResultDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the tombstone result default response
+func (o *TombstoneResultDefault) WithPayload(payload *models.Error) *TombstoneResultDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the tombstone result default response
+func (o *TombstoneResultDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TombstoneResultDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -45,7 +45,7 @@ type GetBadgeFound struct {
 
 	 */
 	SurrogateControl string `json:"Surrogate-Control"`
-	/*Surrogate key for Fastly CDN purging.
+	/*Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
 
 	 */
 	SurrogateKey string `json:"Surrogate-Key"`
@@ -119,6 +119,97 @@ func (o *GetBadgeFound) WriteResponse(rw http.ResponseWriter, producer runtime.P
 	rw.WriteHeader(302)
 }
 
+// GetBadgeGoneCode is the HTTP code returned for type GetBadgeGone
+const GetBadgeGoneCode int = 410
+
+/*
+GetBadgeGone The repository's results were taken down. The plain text body is the reason.
+
+swagger:response getBadgeGone
+*/
+type GetBadgeGone struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+	/*Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+
+	 */
+	SurrogateKey string `json:"Surrogate-Key"`
+}
+
+// NewGetBadgeGone creates GetBadgeGone with default headers values
+func NewGetBadgeGone() *GetBadgeGone {
+
+	return &GetBadgeGone{}
+}
+
+// WithCacheControl adds the cacheControl to the get badge gone response
+func (o *GetBadgeGone) WithCacheControl(cacheControl string) *GetBadgeGone {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the get badge gone response
+func (o *GetBadgeGone) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithSurrogateControl adds the surrogateControl to the get badge gone response
+func (o *GetBadgeGone) WithSurrogateControl(surrogateControl string) *GetBadgeGone {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the get badge gone response
+func (o *GetBadgeGone) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithSurrogateKey adds the surrogateKey to the get badge gone response
+func (o *GetBadgeGone) WithSurrogateKey(surrogateKey string) *GetBadgeGone {
+	o.SurrogateKey = surrogateKey
+	return o
+}
+
+// SetSurrogateKey sets the surrogateKey to the get badge gone response
+func (o *GetBadgeGone) SetSurrogateKey(surrogateKey string) {
+	o.SurrogateKey = surrogateKey
+}
+
+// WriteResponse to the client
+func (o *GetBadgeGone) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	// response header Surrogate-Key
+
+	surrogateKey := o.SurrogateKey
+	if surrogateKey != "" {
+		rw.Header().Set("Surrogate-Key", surrogateKey)
+	}
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(410)
+}
+
 /*
 GetBadgeDefault There was an internal error in the server while processing the request
 
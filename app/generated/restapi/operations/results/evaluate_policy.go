@@ -0,0 +1,73 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package results
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// EvaluatePolicyHandlerFunc turns a function with the right signature into a evaluate policy handler
+type EvaluatePolicyHandlerFunc func(EvaluatePolicyParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn EvaluatePolicyHandlerFunc) Handle(params EvaluatePolicyParams) middleware.Responder {
+	return fn(params)
+}
+
+// EvaluatePolicyHandler interface for that can handle valid evaluate policy params
+type EvaluatePolicyHandler interface {
+	Handle(EvaluatePolicyParams) middleware.Responder
+}
+
+// NewEvaluatePolicy creates a new http.Handler for the evaluate policy operation
+func NewEvaluatePolicy(ctx *middleware.Context, handler EvaluatePolicyHandler) *EvaluatePolicy {
+	return &EvaluatePolicy{Context: ctx, Handler: handler}
+}
+
+/*
+	EvaluatePolicy swagger:route POST /projects/{platform}/{org}/{repo}/evaluate results evaluatePolicy
+
+# Evaluate a repository's ScorecardResult against a policy
+
+Evaluates the stored result against the policy, and returns whether it passes with the reason for every failed rule. A failing result is still a successful evaluation, so it's returned with a 200.
+*/
+type EvaluatePolicy struct {
+	Context *middleware.Context
+	Handler EvaluatePolicyHandler
+}
+
+func (o *EvaluatePolicy) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewEvaluatePolicyParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
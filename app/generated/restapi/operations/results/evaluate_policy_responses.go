@@ -0,0 +1,424 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package results
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// EvaluatePolicyOKCode is the HTTP code returned for type EvaluatePolicyOK
+const EvaluatePolicyOKCode int = 200
+
+/*
+EvaluatePolicyOK The outcome of the evaluation
+
+swagger:response evaluatePolicyOK
+*/
+type EvaluatePolicyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.PolicyEvaluation `json:"body,omitempty"`
+}
+
+// NewEvaluatePolicyOK creates EvaluatePolicyOK with default headers values
+func NewEvaluatePolicyOK() *EvaluatePolicyOK {
+
+	return &EvaluatePolicyOK{}
+}
+
+// WithPayload adds the payload to the evaluate policy o k response
+func (o *EvaluatePolicyOK) WithPayload(payload *models.PolicyEvaluation) *EvaluatePolicyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the evaluate policy o k response
+func (o *EvaluatePolicyOK) SetPayload(payload *models.PolicyEvaluation) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *EvaluatePolicyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// EvaluatePolicyBadRequestCode is the HTTP code returned for type EvaluatePolicyBadRequest
+const EvaluatePolicyBadRequestCode int = 400
+
+/*
+EvaluatePolicyBadRequest The request provided to the server was invalid
+
+swagger:response evaluatePolicyBadRequest
+*/
+type EvaluatePolicyBadRequest struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewEvaluatePolicyBadRequest creates EvaluatePolicyBadRequest with default headers values
+func NewEvaluatePolicyBadRequest() *EvaluatePolicyBadRequest {
+
+	return &EvaluatePolicyBadRequest{}
+}
+
+// WithCacheControl adds the cacheControl to the evaluate policy bad request response
+func (o *EvaluatePolicyBadRequest) WithCacheControl(cacheControl string) *EvaluatePolicyBadRequest {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the evaluate policy bad request response
+func (o *EvaluatePolicyBadRequest) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithSurrogateControl adds the surrogateControl to the evaluate policy bad request response
+func (o *EvaluatePolicyBadRequest) WithSurrogateControl(surrogateControl string) *EvaluatePolicyBadRequest {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the evaluate policy bad request response
+func (o *EvaluatePolicyBadRequest) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithPayload adds the payload to the evaluate policy bad request response
+func (o *EvaluatePolicyBadRequest) WithPayload(payload *models.Error) *EvaluatePolicyBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the evaluate policy bad request response
+func (o *EvaluatePolicyBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *EvaluatePolicyBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// EvaluatePolicyNotFoundCode is the HTTP code returned for type EvaluatePolicyNotFound
+const EvaluatePolicyNotFoundCode int = 404
+
+/*
+EvaluatePolicyNotFound The content requested could not be found
+
+swagger:response evaluatePolicyNotFound
+*/
+type EvaluatePolicyNotFound struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+	/*Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+
+	 */
+	SurrogateKey string `json:"Surrogate-Key"`
+}
+
+// NewEvaluatePolicyNotFound creates EvaluatePolicyNotFound with default headers values
+func NewEvaluatePolicyNotFound() *EvaluatePolicyNotFound {
+
+	return &EvaluatePolicyNotFound{}
+}
+
+// WithCacheControl adds the cacheControl to the evaluate policy not found response
+func (o *EvaluatePolicyNotFound) WithCacheControl(cacheControl string) *EvaluatePolicyNotFound {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the evaluate policy not found response
+func (o *EvaluatePolicyNotFound) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithSurrogateControl adds the surrogateControl to the evaluate policy not found response
+func (o *EvaluatePolicyNotFound) WithSurrogateControl(surrogateControl string) *EvaluatePolicyNotFound {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the evaluate policy not found response
+func (o *EvaluatePolicyNotFound) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithSurrogateKey adds the surrogateKey to the evaluate policy not found response
+func (o *EvaluatePolicyNotFound) WithSurrogateKey(surrogateKey string) *EvaluatePolicyNotFound {
+	o.SurrogateKey = surrogateKey
+	return o
+}
+
+// SetSurrogateKey sets the surrogateKey to the evaluate policy not found response
+func (o *EvaluatePolicyNotFound) SetSurrogateKey(surrogateKey string) {
+	o.SurrogateKey = surrogateKey
+}
+
+// WriteResponse to the client
+func (o *EvaluatePolicyNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	// response header Surrogate-Key
+
+	surrogateKey := o.SurrogateKey
+	if surrogateKey != "" {
+		rw.Header().Set("Surrogate-Key", surrogateKey)
+	}
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// EvaluatePolicyGoneCode is the HTTP code returned for type EvaluatePolicyGone
+const EvaluatePolicyGoneCode int = 410
+
+/*
+EvaluatePolicyGone The result was taken down. The message is the reason.
+
+swagger:response evaluatePolicyGone
+*/
+type EvaluatePolicyGone struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+	/*Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+
+	 */
+	SurrogateKey string `json:"Surrogate-Key"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewEvaluatePolicyGone creates EvaluatePolicyGone with default headers values
+func NewEvaluatePolicyGone() *EvaluatePolicyGone {
+
+	return &EvaluatePolicyGone{}
+}
+
+// WithCacheControl adds the cacheControl to the evaluate policy gone response
+func (o *EvaluatePolicyGone) WithCacheControl(cacheControl string) *EvaluatePolicyGone {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the evaluate policy gone response
+func (o *EvaluatePolicyGone) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithSurrogateControl adds the surrogateControl to the evaluate policy gone response
+func (o *EvaluatePolicyGone) WithSurrogateControl(surrogateControl string) *EvaluatePolicyGone {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the evaluate policy gone response
+func (o *EvaluatePolicyGone) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithSurrogateKey adds the surrogateKey to the evaluate policy gone response
+func (o *EvaluatePolicyGone) WithSurrogateKey(surrogateKey string) *EvaluatePolicyGone {
+	o.SurrogateKey = surrogateKey
+	return o
+}
+
+// SetSurrogateKey sets the surrogateKey to the evaluate policy gone response
+func (o *EvaluatePolicyGone) SetSurrogateKey(surrogateKey string) {
+	o.SurrogateKey = surrogateKey
+}
+
+// WithPayload adds the payload to the evaluate policy gone response
+func (o *EvaluatePolicyGone) WithPayload(payload *models.Error) *EvaluatePolicyGone {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the evaluate policy gone response
+func (o *EvaluatePolicyGone) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *EvaluatePolicyGone) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	// response header Surrogate-Key
+
+	surrogateKey := o.SurrogateKey
+	if surrogateKey != "" {
+		rw.Header().Set("Surrogate-Key", surrogateKey)
+	}
+
+	rw.WriteHeader(410)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+EvaluatePolicyDefault There was an internal error in the server while processing the request
+
+swagger:response evaluatePolicyDefault
+*/
+type EvaluatePolicyDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewEvaluatePolicyDefault creates EvaluatePolicyDefault with default headers values
+func NewEvaluatePolicyDefault(code int) *EvaluatePolicyDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &EvaluatePolicyDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the evaluate policy default response
+func (o *EvaluatePolicyDefault) WithStatusCode(code int) *EvaluatePolicyDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the evaluate policy default response
+func (o *EvaluatePolicyDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the evaluate policy default response
+func (o *EvaluatePolicyDefault) WithPayload(payload *models.Error) *EvaluatePolicyDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the evaluate policy default response
+func (o *EvaluatePolicyDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *EvaluatePolicyDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
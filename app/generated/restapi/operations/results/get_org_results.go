@@ -0,0 +1,73 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package results
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetOrgResultsHandlerFunc turns a function with the right signature into a get org results handler
+type GetOrgResultsHandlerFunc func(GetOrgResultsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetOrgResultsHandlerFunc) Handle(params GetOrgResultsParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetOrgResultsHandler interface for that can handle valid get org results params
+type GetOrgResultsHandler interface {
+	Handle(GetOrgResultsParams) middleware.Responder
+}
+
+// NewGetOrgResults creates a new http.Handler for the get org results operation
+func NewGetOrgResults(ctx *middleware.Context, handler GetOrgResultsHandler) *GetOrgResults {
+	return &GetOrgResults{Context: ctx, Handler: handler}
+}
+
+/*
+	GetOrgResults swagger:route GET /projects/{platform}/{org} results getOrgResults
+
+# Get the latest ScorecardResults of every repository in an organization
+
+Lists the repositories of the organization in the search index, with their latest aggregate and per-check scores, and summary statistics over those repositories. Inconclusive check scores (-1) are excluded from means and medians, but included in the distributions. Repositories are paginated by name with limit and offset; truncated is set when there are more. The statistics are over every repository of the organization, not only the page's, so they are the same on every page. Repositories without a latest result, or whose latest result was taken down, are left out, so a page can have fewer than limit repositories.
+*/
+type GetOrgResults struct {
+	Context *middleware.Context
+	Handler GetOrgResultsHandler
+}
+
+func (o *GetOrgResults) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetOrgResultsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
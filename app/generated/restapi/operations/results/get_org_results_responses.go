@@ -0,0 +1,379 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package results
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// GetOrgResultsOKCode is the HTTP code returned for type GetOrgResultsOK
+const GetOrgResultsOKCode int = 200
+
+/*
+GetOrgResultsOK The organization's repositories and summary statistics
+
+swagger:response getOrgResultsOK
+*/
+type GetOrgResultsOK struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+	/*Surrogate keys for Fastly CDN purging, e.g. org:github.com/org
+
+	 */
+	SurrogateKey string `json:"Surrogate-Key"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.OrgResults `json:"body,omitempty"`
+}
+
+// NewGetOrgResultsOK creates GetOrgResultsOK with default headers values
+func NewGetOrgResultsOK() *GetOrgResultsOK {
+
+	return &GetOrgResultsOK{}
+}
+
+// WithCacheControl adds the cacheControl to the get org results o k response
+func (o *GetOrgResultsOK) WithCacheControl(cacheControl string) *GetOrgResultsOK {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the get org results o k response
+func (o *GetOrgResultsOK) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithSurrogateControl adds the surrogateControl to the get org results o k response
+func (o *GetOrgResultsOK) WithSurrogateControl(surrogateControl string) *GetOrgResultsOK {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the get org results o k response
+func (o *GetOrgResultsOK) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithSurrogateKey adds the surrogateKey to the get org results o k response
+func (o *GetOrgResultsOK) WithSurrogateKey(surrogateKey string) *GetOrgResultsOK {
+	o.SurrogateKey = surrogateKey
+	return o
+}
+
+// SetSurrogateKey sets the surrogateKey to the get org results o k response
+func (o *GetOrgResultsOK) SetSurrogateKey(surrogateKey string) {
+	o.SurrogateKey = surrogateKey
+}
+
+// WithPayload adds the payload to the get org results o k response
+func (o *GetOrgResultsOK) WithPayload(payload *models.OrgResults) *GetOrgResultsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get org results o k response
+func (o *GetOrgResultsOK) SetPayload(payload *models.OrgResults) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetOrgResultsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	// response header Surrogate-Key
+
+	surrogateKey := o.SurrogateKey
+	if surrogateKey != "" {
+		rw.Header().Set("Surrogate-Key", surrogateKey)
+	}
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetOrgResultsBadRequestCode is the HTTP code returned for type GetOrgResultsBadRequest
+const GetOrgResultsBadRequestCode int = 400
+
+/*
+GetOrgResultsBadRequest The request provided to the server was invalid
+
+swagger:response getOrgResultsBadRequest
+*/
+type GetOrgResultsBadRequest struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetOrgResultsBadRequest creates GetOrgResultsBadRequest with default headers values
+func NewGetOrgResultsBadRequest() *GetOrgResultsBadRequest {
+
+	return &GetOrgResultsBadRequest{}
+}
+
+// WithCacheControl adds the cacheControl to the get org results bad request response
+func (o *GetOrgResultsBadRequest) WithCacheControl(cacheControl string) *GetOrgResultsBadRequest {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the get org results bad request response
+func (o *GetOrgResultsBadRequest) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithSurrogateControl adds the surrogateControl to the get org results bad request response
+func (o *GetOrgResultsBadRequest) WithSurrogateControl(surrogateControl string) *GetOrgResultsBadRequest {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the get org results bad request response
+func (o *GetOrgResultsBadRequest) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithPayload adds the payload to the get org results bad request response
+func (o *GetOrgResultsBadRequest) WithPayload(payload *models.Error) *GetOrgResultsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get org results bad request response
+func (o *GetOrgResultsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetOrgResultsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetOrgResultsNotFoundCode is the HTTP code returned for type GetOrgResultsNotFound
+const GetOrgResultsNotFoundCode int = 404
+
+/*
+GetOrgResultsNotFound The content requested could not be found
+
+swagger:response getOrgResultsNotFound
+*/
+type GetOrgResultsNotFound struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+	/*Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+
+	 */
+	SurrogateKey string `json:"Surrogate-Key"`
+}
+
+// NewGetOrgResultsNotFound creates GetOrgResultsNotFound with default headers values
+func NewGetOrgResultsNotFound() *GetOrgResultsNotFound {
+
+	return &GetOrgResultsNotFound{}
+}
+
+// WithCacheControl adds the cacheControl to the get org results not found response
+func (o *GetOrgResultsNotFound) WithCacheControl(cacheControl string) *GetOrgResultsNotFound {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the get org results not found response
+func (o *GetOrgResultsNotFound) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithSurrogateControl adds the surrogateControl to the get org results not found response
+func (o *GetOrgResultsNotFound) WithSurrogateControl(surrogateControl string) *GetOrgResultsNotFound {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the get org results not found response
+func (o *GetOrgResultsNotFound) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithSurrogateKey adds the surrogateKey to the get org results not found response
+func (o *GetOrgResultsNotFound) WithSurrogateKey(surrogateKey string) *GetOrgResultsNotFound {
+	o.SurrogateKey = surrogateKey
+	return o
+}
+
+// SetSurrogateKey sets the surrogateKey to the get org results not found response
+func (o *GetOrgResultsNotFound) SetSurrogateKey(surrogateKey string) {
+	o.SurrogateKey = surrogateKey
+}
+
+// WriteResponse to the client
+func (o *GetOrgResultsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	// response header Surrogate-Key
+
+	surrogateKey := o.SurrogateKey
+	if surrogateKey != "" {
+		rw.Header().Set("Surrogate-Key", surrogateKey)
+	}
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+/*
+GetOrgResultsDefault There was an internal error in the server while processing the request
+
+swagger:response getOrgResultsDefault
+*/
+type GetOrgResultsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetOrgResultsDefault creates GetOrgResultsDefault with default headers values
+func NewGetOrgResultsDefault(code int) *GetOrgResultsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetOrgResultsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get org results default response
+func (o *GetOrgResultsDefault) WithStatusCode(code int) *GetOrgResultsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get org results default response
+func (o *GetOrgResultsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get org results default response
+func (o *GetOrgResultsDefault) WithPayload(payload *models.Error) *GetOrgResultsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get org results default response
+func (o *GetOrgResultsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetOrgResultsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
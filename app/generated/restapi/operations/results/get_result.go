@@ -47,7 +47,9 @@ func NewGetResult(ctx *middleware.Context, handler GetResultHandler) *GetResult
 /*
 	GetResult swagger:route GET /projects/{platform}/{org}/{repo} results getResult
 
-Get a repository's ScorecardResult
+# Get a repository's ScorecardResult
+
+The json format is also available as YAML, as a CSV table of the checks, or as a Markdown summary, depending on the Accept header. It's the only operation with these media types: the others only produce JSON.
 */
 type GetResult struct {
 	Context *middleware.Context
@@ -27,15 +27,26 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 )
 
 // NewGetResultParams creates a new GetResultParams object
-//
-// There are no default values defined in the spec.
+// with the default values initialized.
 func NewGetResultParams() GetResultParams {
 
-	return GetResultParams{}
+	var (
+		// initialize parameters with default values
+
+		envelopeDefault = bool(false)
+		formatDefault   = string("json")
+	)
+
+	return GetResultParams{
+		Envelope: &envelopeDefault,
+
+		Format: &formatDefault,
+	}
 }
 
 // GetResultParams contains all the bound params for the get result operation
@@ -47,11 +58,38 @@ type GetResultParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*HTTP date from a previous Last-Modified header. Returns 304 if the result hasn't changed since. Ignored when If-None-Match is present.
+
+	  In: header
+	*/
+	IfModifiedSince *string
+	/*ETag from a previous response. Returns 304 if the result hasn't changed.
+	  In: header
+	*/
+	IfNoneMatch *string
 	/*SHA1 commit hash expressed in hexadecimal format
 	  Pattern: ^[0-9a-fA-F]{40}$
 	  In: query
 	*/
 	Commit *string
+	/*Wrap the result in a ResultEnvelope with its source and age. Only supported with the json format.
+
+	  In: query
+	  Default: false
+	*/
+	Envelope *bool
+	/*Output format of the result. `json` returns the checks of the result, without probe findings. `sarif` converts the stored checks into a SARIF 2.1.0 log (application/sarif+json) with one rule per check. `probe` returns the structured probe findings of Scorecard v5 results as a ScorecardProbeResult, or 404 if the result has none.
+
+	  In: query
+	  Default: "json"
+	*/
+	Format *string
+	/*Maximum age in seconds of the stored result, from when it was last written. Returns 422 if the best available result, from the requested source if any, is older.
+
+	  Minimum: 0
+	  In: query
+	*/
+	MaxAge *int64
 	/*Name of the owner/organization of the repository
 	  Required: true
 	  In: path
@@ -67,6 +105,11 @@ type GetResultParams struct {
 	  In: path
 	*/
 	Repo string
+	/*Only serve the result published by the Scorecard action, or computed by the weekly cron scan. By default, the most recently written of the two is served.
+
+	  In: query
+	*/
+	Source *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -80,11 +123,34 @@ func (o *GetResultParams) BindRequest(r *http.Request, route *middleware.Matched
 
 	qs := runtime.Values(r.URL.Query())
 
+	if err := o.bindIfModifiedSince(r.Header[http.CanonicalHeaderKey("If-Modified-Since")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.bindIfNoneMatch(r.Header[http.CanonicalHeaderKey("If-None-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qCommit, qhkCommit, _ := qs.GetOK("commit")
 	if err := o.bindCommit(qCommit, qhkCommit, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qEnvelope, qhkEnvelope, _ := qs.GetOK("envelope")
+	if err := o.bindEnvelope(qEnvelope, qhkEnvelope, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qFormat, qhkFormat, _ := qs.GetOK("format")
+	if err := o.bindFormat(qFormat, qhkFormat, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMaxAge, qhkMaxAge, _ := qs.GetOK("maxAge")
+	if err := o.bindMaxAge(qMaxAge, qhkMaxAge, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rOrg, rhkOrg, _ := route.Params.GetOK("org")
 	if err := o.bindOrg(rOrg, rhkOrg, route.Formats); err != nil {
 		res = append(res, err)
@@ -99,12 +165,51 @@ func (o *GetResultParams) BindRequest(r *http.Request, route *middleware.Matched
 	if err := o.bindRepo(rRepo, rhkRepo, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qSource, qhkSource, _ := qs.GetOK("source")
+	if err := o.bindSource(qSource, qhkSource, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindIfModifiedSince binds and validates parameter IfModifiedSince from header.
+func (o *GetResultParams) bindIfModifiedSince(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfModifiedSince = &raw
+
+	return nil
+}
+
+// bindIfNoneMatch binds and validates parameter IfNoneMatch from header.
+func (o *GetResultParams) bindIfNoneMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfNoneMatch = &raw
+
+	return nil
+}
+
 // bindCommit binds and validates parameter Commit from query.
 func (o *GetResultParams) bindCommit(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -137,6 +242,100 @@ func (o *GetResultParams) validateCommit(formats strfmt.Registry) error {
 	return nil
 }
 
+// bindEnvelope binds and validates parameter Envelope from query.
+func (o *GetResultParams) bindEnvelope(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetResultParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("envelope", "query", "bool", raw)
+	}
+	o.Envelope = &value
+
+	return nil
+}
+
+// bindFormat binds and validates parameter Format from query.
+func (o *GetResultParams) bindFormat(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetResultParams()
+		return nil
+	}
+	o.Format = &raw
+
+	if err := o.validateFormat(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateFormat carries on validations for parameter Format
+func (o *GetResultParams) validateFormat(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("format", "query", *o.Format, []interface{}{"json", "sarif", "probe"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindMaxAge binds and validates parameter MaxAge from query.
+func (o *GetResultParams) bindMaxAge(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("maxAge", "query", "int64", raw)
+	}
+	o.MaxAge = &value
+
+	if err := o.validateMaxAge(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateMaxAge carries on validations for parameter MaxAge
+func (o *GetResultParams) validateMaxAge(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("maxAge", "query", *o.MaxAge, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // bindOrg binds and validates parameter Org from path.
 func (o *GetResultParams) bindOrg(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -178,3 +377,35 @@ func (o *GetResultParams) bindRepo(rawData []string, hasKey bool, formats strfmt
 
 	return nil
 }
+
+// bindSource binds and validates parameter Source from query.
+func (o *GetResultParams) bindSource(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Source = &raw
+
+	if err := o.validateSource(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSource carries on validations for parameter Source
+func (o *GetResultParams) validateSource(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("source", "query", *o.Source, []interface{}{"action", "cron"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -24,6 +24,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/ossf/scorecard-webapp/app/generated/models"
 )
@@ -41,10 +42,35 @@ type GetResultOK struct {
 
 	 */
 	CacheControl string `json:"Cache-Control"`
+	/*Strong validator for the stored result, for use with If-None-Match.
+
+	 */
+	ETag string `json:"ETag"`
+	/*Time the stored result was last written, for use with If-Modified-Since.
+
+	 */
+	LastModified string `json:"Last-Modified"`
 	/*TTL for Fastly CDN caching. Example: max-age=3600
 
 	 */
 	SurrogateControl string `json:"Surrogate-Control"`
+	/*Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+
+	 */
+	SurrogateKey string `json:"Surrogate-Key"`
+	/*Age in seconds of the stored result when the response was generated
+
+	 */
+	XScorecardAge int64 `json:"X-Scorecard-Age"`
+	/*Note attached to the result by the server operator, if any
+
+	 */
+	XScorecardAnnotation string `json:"X-Scorecard-Annotation"`
+	/*Where the stored result comes from: published by the Scorecard action, or computed by the weekly cron scan.
+
+
+	 */
+	XScorecardSource string `json:"X-Scorecard-Source"`
 
 	/*
 	  In: Body
@@ -69,6 +95,28 @@ func (o *GetResultOK) SetCacheControl(cacheControl string) {
 	o.CacheControl = cacheControl
 }
 
+// WithETag adds the eTag to the get result o k response
+func (o *GetResultOK) WithETag(eTag string) *GetResultOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get result o k response
+func (o *GetResultOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WithLastModified adds the lastModified to the get result o k response
+func (o *GetResultOK) WithLastModified(lastModified string) *GetResultOK {
+	o.LastModified = lastModified
+	return o
+}
+
+// SetLastModified sets the lastModified to the get result o k response
+func (o *GetResultOK) SetLastModified(lastModified string) {
+	o.LastModified = lastModified
+}
+
 // WithSurrogateControl adds the surrogateControl to the get result o k response
 func (o *GetResultOK) WithSurrogateControl(surrogateControl string) *GetResultOK {
 	o.SurrogateControl = surrogateControl
@@ -80,6 +128,50 @@ func (o *GetResultOK) SetSurrogateControl(surrogateControl string) {
 	o.SurrogateControl = surrogateControl
 }
 
+// WithSurrogateKey adds the surrogateKey to the get result o k response
+func (o *GetResultOK) WithSurrogateKey(surrogateKey string) *GetResultOK {
+	o.SurrogateKey = surrogateKey
+	return o
+}
+
+// SetSurrogateKey sets the surrogateKey to the get result o k response
+func (o *GetResultOK) SetSurrogateKey(surrogateKey string) {
+	o.SurrogateKey = surrogateKey
+}
+
+// WithXScorecardAge adds the xScorecardAge to the get result o k response
+func (o *GetResultOK) WithXScorecardAge(xScorecardAge int64) *GetResultOK {
+	o.XScorecardAge = xScorecardAge
+	return o
+}
+
+// SetXScorecardAge sets the xScorecardAge to the get result o k response
+func (o *GetResultOK) SetXScorecardAge(xScorecardAge int64) {
+	o.XScorecardAge = xScorecardAge
+}
+
+// WithXScorecardAnnotation adds the xScorecardAnnotation to the get result o k response
+func (o *GetResultOK) WithXScorecardAnnotation(xScorecardAnnotation string) *GetResultOK {
+	o.XScorecardAnnotation = xScorecardAnnotation
+	return o
+}
+
+// SetXScorecardAnnotation sets the xScorecardAnnotation to the get result o k response
+func (o *GetResultOK) SetXScorecardAnnotation(xScorecardAnnotation string) {
+	o.XScorecardAnnotation = xScorecardAnnotation
+}
+
+// WithXScorecardSource adds the xScorecardSource to the get result o k response
+func (o *GetResultOK) WithXScorecardSource(xScorecardSource string) *GetResultOK {
+	o.XScorecardSource = xScorecardSource
+	return o
+}
+
+// SetXScorecardSource sets the xScorecardSource to the get result o k response
+func (o *GetResultOK) SetXScorecardSource(xScorecardSource string) {
+	o.XScorecardSource = xScorecardSource
+}
+
 // WithPayload adds the payload to the get result o k response
 func (o *GetResultOK) WithPayload(payload *models.ScorecardResult) *GetResultOK {
 	o.Payload = payload
@@ -101,6 +193,20 @@ func (o *GetResultOK) WriteResponse(rw http.ResponseWriter, producer runtime.Pro
 		rw.Header().Set("Cache-Control", cacheControl)
 	}
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	// response header Last-Modified
+
+	lastModified := o.LastModified
+	if lastModified != "" {
+		rw.Header().Set("Last-Modified", lastModified)
+	}
+
 	// response header Surrogate-Control
 
 	surrogateControl := o.SurrogateControl
@@ -108,6 +214,34 @@ func (o *GetResultOK) WriteResponse(rw http.ResponseWriter, producer runtime.Pro
 		rw.Header().Set("Surrogate-Control", surrogateControl)
 	}
 
+	// response header Surrogate-Key
+
+	surrogateKey := o.SurrogateKey
+	if surrogateKey != "" {
+		rw.Header().Set("Surrogate-Key", surrogateKey)
+	}
+
+	// response header X-Scorecard-Age
+
+	xScorecardAge := swag.FormatInt64(o.XScorecardAge)
+	if xScorecardAge != "" {
+		rw.Header().Set("X-Scorecard-Age", xScorecardAge)
+	}
+
+	// response header X-Scorecard-Annotation
+
+	xScorecardAnnotation := o.XScorecardAnnotation
+	if xScorecardAnnotation != "" {
+		rw.Header().Set("X-Scorecard-Annotation", xScorecardAnnotation)
+	}
+
+	// response header X-Scorecard-Source
+
+	xScorecardSource := o.XScorecardSource
+	if xScorecardSource != "" {
+		rw.Header().Set("X-Scorecard-Source", xScorecardSource)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -117,6 +251,208 @@ func (o *GetResultOK) WriteResponse(rw http.ResponseWriter, producer runtime.Pro
 	}
 }
 
+// GetResultNotModifiedCode is the HTTP code returned for type GetResultNotModified
+const GetResultNotModifiedCode int = 304
+
+/*
+GetResultNotModified The stored result hasn't changed since the conditional request's validators
+
+swagger:response getResultNotModified
+*/
+type GetResultNotModified struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*Strong validator for the stored result, for use with If-None-Match.
+
+	 */
+	ETag string `json:"ETag"`
+	/*Time the stored result was last written, for use with If-Modified-Since.
+
+	 */
+	LastModified string `json:"Last-Modified"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+	/*Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+
+	 */
+	SurrogateKey string `json:"Surrogate-Key"`
+	/*Age in seconds of the stored result when the response was generated
+
+	 */
+	XScorecardAge int64 `json:"X-Scorecard-Age"`
+	/*Note attached to the result by the server operator, if any
+
+	 */
+	XScorecardAnnotation string `json:"X-Scorecard-Annotation"`
+	/*Where the stored result comes from: published by the Scorecard action, or computed by the weekly cron scan.
+
+
+	 */
+	XScorecardSource string `json:"X-Scorecard-Source"`
+}
+
+// NewGetResultNotModified creates GetResultNotModified with default headers values
+func NewGetResultNotModified() *GetResultNotModified {
+
+	return &GetResultNotModified{}
+}
+
+// WithCacheControl adds the cacheControl to the get result not modified response
+func (o *GetResultNotModified) WithCacheControl(cacheControl string) *GetResultNotModified {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the get result not modified response
+func (o *GetResultNotModified) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithETag adds the eTag to the get result not modified response
+func (o *GetResultNotModified) WithETag(eTag string) *GetResultNotModified {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get result not modified response
+func (o *GetResultNotModified) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WithLastModified adds the lastModified to the get result not modified response
+func (o *GetResultNotModified) WithLastModified(lastModified string) *GetResultNotModified {
+	o.LastModified = lastModified
+	return o
+}
+
+// SetLastModified sets the lastModified to the get result not modified response
+func (o *GetResultNotModified) SetLastModified(lastModified string) {
+	o.LastModified = lastModified
+}
+
+// WithSurrogateControl adds the surrogateControl to the get result not modified response
+func (o *GetResultNotModified) WithSurrogateControl(surrogateControl string) *GetResultNotModified {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the get result not modified response
+func (o *GetResultNotModified) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithSurrogateKey adds the surrogateKey to the get result not modified response
+func (o *GetResultNotModified) WithSurrogateKey(surrogateKey string) *GetResultNotModified {
+	o.SurrogateKey = surrogateKey
+	return o
+}
+
+// SetSurrogateKey sets the surrogateKey to the get result not modified response
+func (o *GetResultNotModified) SetSurrogateKey(surrogateKey string) {
+	o.SurrogateKey = surrogateKey
+}
+
+// WithXScorecardAge adds the xScorecardAge to the get result not modified response
+func (o *GetResultNotModified) WithXScorecardAge(xScorecardAge int64) *GetResultNotModified {
+	o.XScorecardAge = xScorecardAge
+	return o
+}
+
+// SetXScorecardAge sets the xScorecardAge to the get result not modified response
+func (o *GetResultNotModified) SetXScorecardAge(xScorecardAge int64) {
+	o.XScorecardAge = xScorecardAge
+}
+
+// WithXScorecardAnnotation adds the xScorecardAnnotation to the get result not modified response
+func (o *GetResultNotModified) WithXScorecardAnnotation(xScorecardAnnotation string) *GetResultNotModified {
+	o.XScorecardAnnotation = xScorecardAnnotation
+	return o
+}
+
+// SetXScorecardAnnotation sets the xScorecardAnnotation to the get result not modified response
+func (o *GetResultNotModified) SetXScorecardAnnotation(xScorecardAnnotation string) {
+	o.XScorecardAnnotation = xScorecardAnnotation
+}
+
+// WithXScorecardSource adds the xScorecardSource to the get result not modified response
+func (o *GetResultNotModified) WithXScorecardSource(xScorecardSource string) *GetResultNotModified {
+	o.XScorecardSource = xScorecardSource
+	return o
+}
+
+// SetXScorecardSource sets the xScorecardSource to the get result not modified response
+func (o *GetResultNotModified) SetXScorecardSource(xScorecardSource string) {
+	o.XScorecardSource = xScorecardSource
+}
+
+// WriteResponse to the client
+func (o *GetResultNotModified) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	// response header Last-Modified
+
+	lastModified := o.LastModified
+	if lastModified != "" {
+		rw.Header().Set("Last-Modified", lastModified)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	// response header Surrogate-Key
+
+	surrogateKey := o.SurrogateKey
+	if surrogateKey != "" {
+		rw.Header().Set("Surrogate-Key", surrogateKey)
+	}
+
+	// response header X-Scorecard-Age
+
+	xScorecardAge := swag.FormatInt64(o.XScorecardAge)
+	if xScorecardAge != "" {
+		rw.Header().Set("X-Scorecard-Age", xScorecardAge)
+	}
+
+	// response header X-Scorecard-Annotation
+
+	xScorecardAnnotation := o.XScorecardAnnotation
+	if xScorecardAnnotation != "" {
+		rw.Header().Set("X-Scorecard-Annotation", xScorecardAnnotation)
+	}
+
+	// response header X-Scorecard-Source
+
+	xScorecardSource := o.XScorecardSource
+	if xScorecardSource != "" {
+		rw.Header().Set("X-Scorecard-Source", xScorecardSource)
+	}
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(304)
+}
+
 // GetResultBadRequestCode is the HTTP code returned for type GetResultBadRequest
 const GetResultBadRequestCode int = 400
 
@@ -223,6 +559,10 @@ type GetResultNotFound struct {
 
 	 */
 	SurrogateControl string `json:"Surrogate-Control"`
+	/*Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+
+	 */
+	SurrogateKey string `json:"Surrogate-Key"`
 }
 
 // NewGetResultNotFound creates GetResultNotFound with default headers values
@@ -253,6 +593,17 @@ func (o *GetResultNotFound) SetSurrogateControl(surrogateControl string) {
 	o.SurrogateControl = surrogateControl
 }
 
+// WithSurrogateKey adds the surrogateKey to the get result not found response
+func (o *GetResultNotFound) WithSurrogateKey(surrogateKey string) *GetResultNotFound {
+	o.SurrogateKey = surrogateKey
+	return o
+}
+
+// SetSurrogateKey sets the surrogateKey to the get result not found response
+func (o *GetResultNotFound) SetSurrogateKey(surrogateKey string) {
+	o.SurrogateKey = surrogateKey
+}
+
 // WriteResponse to the client
 func (o *GetResultNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
@@ -270,11 +621,373 @@ func (o *GetResultNotFound) WriteResponse(rw http.ResponseWriter, producer runti
 		rw.Header().Set("Surrogate-Control", surrogateControl)
 	}
 
+	// response header Surrogate-Key
+
+	surrogateKey := o.SurrogateKey
+	if surrogateKey != "" {
+		rw.Header().Set("Surrogate-Key", surrogateKey)
+	}
+
 	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
 
 	rw.WriteHeader(404)
 }
 
+// GetResultNotAcceptableCode is the HTTP code returned for type GetResultNotAcceptable
+const GetResultNotAcceptableCode int = 406
+
+/*
+GetResultNotAcceptable The sarif and probe formats, and envelopes, are only available as JSON, which the Accept header doesn't accept. The response depends on the Accept header, so it isn't cached.
+
+swagger:response getResultNotAcceptable
+*/
+type GetResultNotAcceptable struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetResultNotAcceptable creates GetResultNotAcceptable with default headers values
+func NewGetResultNotAcceptable() *GetResultNotAcceptable {
+
+	return &GetResultNotAcceptable{}
+}
+
+// WithCacheControl adds the cacheControl to the get result not acceptable response
+func (o *GetResultNotAcceptable) WithCacheControl(cacheControl string) *GetResultNotAcceptable {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the get result not acceptable response
+func (o *GetResultNotAcceptable) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithSurrogateControl adds the surrogateControl to the get result not acceptable response
+func (o *GetResultNotAcceptable) WithSurrogateControl(surrogateControl string) *GetResultNotAcceptable {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the get result not acceptable response
+func (o *GetResultNotAcceptable) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithPayload adds the payload to the get result not acceptable response
+func (o *GetResultNotAcceptable) WithPayload(payload *models.Error) *GetResultNotAcceptable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get result not acceptable response
+func (o *GetResultNotAcceptable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetResultNotAcceptable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	rw.WriteHeader(406)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetResultGoneCode is the HTTP code returned for type GetResultGone
+const GetResultGoneCode int = 410
+
+/*
+GetResultGone The result was taken down. The message is the reason.
+
+swagger:response getResultGone
+*/
+type GetResultGone struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+	/*Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+
+	 */
+	SurrogateKey string `json:"Surrogate-Key"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetResultGone creates GetResultGone with default headers values
+func NewGetResultGone() *GetResultGone {
+
+	return &GetResultGone{}
+}
+
+// WithCacheControl adds the cacheControl to the get result gone response
+func (o *GetResultGone) WithCacheControl(cacheControl string) *GetResultGone {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the get result gone response
+func (o *GetResultGone) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithSurrogateControl adds the surrogateControl to the get result gone response
+func (o *GetResultGone) WithSurrogateControl(surrogateControl string) *GetResultGone {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the get result gone response
+func (o *GetResultGone) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithSurrogateKey adds the surrogateKey to the get result gone response
+func (o *GetResultGone) WithSurrogateKey(surrogateKey string) *GetResultGone {
+	o.SurrogateKey = surrogateKey
+	return o
+}
+
+// SetSurrogateKey sets the surrogateKey to the get result gone response
+func (o *GetResultGone) SetSurrogateKey(surrogateKey string) {
+	o.SurrogateKey = surrogateKey
+}
+
+// WithPayload adds the payload to the get result gone response
+func (o *GetResultGone) WithPayload(payload *models.Error) *GetResultGone {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get result gone response
+func (o *GetResultGone) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetResultGone) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	// response header Surrogate-Key
+
+	surrogateKey := o.SurrogateKey
+	if surrogateKey != "" {
+		rw.Header().Set("Surrogate-Key", surrogateKey)
+	}
+
+	rw.WriteHeader(410)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetResultUnprocessableEntityCode is the HTTP code returned for type GetResultUnprocessableEntity
+const GetResultUnprocessableEntityCode int = 422
+
+/*
+GetResultUnprocessableEntity The best available result is older than maxAge
+
+swagger:response getResultUnprocessableEntity
+*/
+type GetResultUnprocessableEntity struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+	/*Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+
+	 */
+	SurrogateKey string `json:"Surrogate-Key"`
+	/*
+
+	 */
+	XScorecardAge int64 `json:"X-Scorecard-Age"`
+	/*
+
+	 */
+	XScorecardSource string `json:"X-Scorecard-Source"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetResultUnprocessableEntity creates GetResultUnprocessableEntity with default headers values
+func NewGetResultUnprocessableEntity() *GetResultUnprocessableEntity {
+
+	return &GetResultUnprocessableEntity{}
+}
+
+// WithCacheControl adds the cacheControl to the get result unprocessable entity response
+func (o *GetResultUnprocessableEntity) WithCacheControl(cacheControl string) *GetResultUnprocessableEntity {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the get result unprocessable entity response
+func (o *GetResultUnprocessableEntity) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithSurrogateControl adds the surrogateControl to the get result unprocessable entity response
+func (o *GetResultUnprocessableEntity) WithSurrogateControl(surrogateControl string) *GetResultUnprocessableEntity {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the get result unprocessable entity response
+func (o *GetResultUnprocessableEntity) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithSurrogateKey adds the surrogateKey to the get result unprocessable entity response
+func (o *GetResultUnprocessableEntity) WithSurrogateKey(surrogateKey string) *GetResultUnprocessableEntity {
+	o.SurrogateKey = surrogateKey
+	return o
+}
+
+// SetSurrogateKey sets the surrogateKey to the get result unprocessable entity response
+func (o *GetResultUnprocessableEntity) SetSurrogateKey(surrogateKey string) {
+	o.SurrogateKey = surrogateKey
+}
+
+// WithXScorecardAge adds the xScorecardAge to the get result unprocessable entity response
+func (o *GetResultUnprocessableEntity) WithXScorecardAge(xScorecardAge int64) *GetResultUnprocessableEntity {
+	o.XScorecardAge = xScorecardAge
+	return o
+}
+
+// SetXScorecardAge sets the xScorecardAge to the get result unprocessable entity response
+func (o *GetResultUnprocessableEntity) SetXScorecardAge(xScorecardAge int64) {
+	o.XScorecardAge = xScorecardAge
+}
+
+// WithXScorecardSource adds the xScorecardSource to the get result unprocessable entity response
+func (o *GetResultUnprocessableEntity) WithXScorecardSource(xScorecardSource string) *GetResultUnprocessableEntity {
+	o.XScorecardSource = xScorecardSource
+	return o
+}
+
+// SetXScorecardSource sets the xScorecardSource to the get result unprocessable entity response
+func (o *GetResultUnprocessableEntity) SetXScorecardSource(xScorecardSource string) {
+	o.XScorecardSource = xScorecardSource
+}
+
+// WithPayload adds the payload to the get result unprocessable entity response
+func (o *GetResultUnprocessableEntity) WithPayload(payload *models.Error) *GetResultUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get result unprocessable entity response
+func (o *GetResultUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetResultUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	// response header Surrogate-Key
+
+	surrogateKey := o.SurrogateKey
+	if surrogateKey != "" {
+		rw.Header().Set("Surrogate-Key", surrogateKey)
+	}
+
+	// response header X-Scorecard-Age
+
+	xScorecardAge := swag.FormatInt64(o.XScorecardAge)
+	if xScorecardAge != "" {
+		rw.Header().Set("X-Scorecard-Age", xScorecardAge)
+	}
+
+	// response header X-Scorecard-Source
+
+	xScorecardSource := o.XScorecardSource
+	if xScorecardSource != "" {
+		rw.Header().Set("X-Scorecard-Source", xScorecardSource)
+	}
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*
 GetResultDefault There was an internal error in the server while processing the request
 
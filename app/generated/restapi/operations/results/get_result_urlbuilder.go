@@ -25,6 +25,8 @@ import (
 	"net/url"
 	golangswaggerpaths "path"
 	"strings"
+
+	"github.com/go-openapi/swag"
 )
 
 // GetResultURL generates an URL for the get result operation
@@ -33,7 +35,11 @@ type GetResultURL struct {
 	Platform string
 	Repo     string
 
-	Commit *string
+	Commit   *string
+	Envelope *bool
+	Format   *string
+	MaxAge   *int64
+	Source   *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -95,6 +101,38 @@ func (o *GetResultURL) Build() (*url.URL, error) {
 		qs.Set("commit", commitQ)
 	}
 
+	var envelopeQ string
+	if o.Envelope != nil {
+		envelopeQ = swag.FormatBool(*o.Envelope)
+	}
+	if envelopeQ != "" {
+		qs.Set("envelope", envelopeQ)
+	}
+
+	var formatQ string
+	if o.Format != nil {
+		formatQ = *o.Format
+	}
+	if formatQ != "" {
+		qs.Set("format", formatQ)
+	}
+
+	var maxAgeQ string
+	if o.MaxAge != nil {
+		maxAgeQ = swag.FormatInt64(*o.MaxAge)
+	}
+	if maxAgeQ != "" {
+		qs.Set("maxAge", maxAgeQ)
+	}
+
+	var sourceQ string
+	if o.Source != nil {
+		sourceQ = *o.Source
+	}
+	if sourceQ != "" {
+		qs.Set("source", sourceQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
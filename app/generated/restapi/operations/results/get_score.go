@@ -0,0 +1,73 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package results
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetScoreHandlerFunc turns a function with the right signature into a get score handler
+type GetScoreHandlerFunc func(GetScoreParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetScoreHandlerFunc) Handle(params GetScoreParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetScoreHandler interface for that can handle valid get score params
+type GetScoreHandler interface {
+	Handle(GetScoreParams) middleware.Responder
+}
+
+// NewGetScore creates a new http.Handler for the get score operation
+func NewGetScore(ctx *middleware.Context, handler GetScoreHandler) *GetScore {
+	return &GetScore{Context: ctx, Handler: handler}
+}
+
+/*
+	GetScore swagger:route GET /projects/{platform}/{org}/{repo}/score results getScore
+
+# Recompute a repository's aggregate score with a weight profile
+
+Recomputes the aggregate score from the stored check scores, rather than returning the score the result was published with, so repositories can be ranked consistently. The `default` profile uses Scorecard's risk weights (Critical 10, High 7.5, Medium 5, Low 2.5); other profiles are configured by the server operator. Inconclusive checks (-1) and checks with no weight don't count, and the score is -1 if no check does.
+*/
+type GetScore struct {
+	Context *middleware.Context
+	Handler GetScoreHandler
+}
+
+func (o *GetScore) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetScoreParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
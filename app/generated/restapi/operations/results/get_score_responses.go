@@ -0,0 +1,490 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package results
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// GetScoreOKCode is the HTTP code returned for type GetScoreOK
+const GetScoreOKCode int = 200
+
+/*
+GetScoreOK The recomputed score
+
+swagger:response getScoreOK
+*/
+type GetScoreOK struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+	/*Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+
+	 */
+	SurrogateKey string `json:"Surrogate-Key"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.WeightedScore `json:"body,omitempty"`
+}
+
+// NewGetScoreOK creates GetScoreOK with default headers values
+func NewGetScoreOK() *GetScoreOK {
+
+	return &GetScoreOK{}
+}
+
+// WithCacheControl adds the cacheControl to the get score o k response
+func (o *GetScoreOK) WithCacheControl(cacheControl string) *GetScoreOK {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the get score o k response
+func (o *GetScoreOK) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithSurrogateControl adds the surrogateControl to the get score o k response
+func (o *GetScoreOK) WithSurrogateControl(surrogateControl string) *GetScoreOK {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the get score o k response
+func (o *GetScoreOK) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithSurrogateKey adds the surrogateKey to the get score o k response
+func (o *GetScoreOK) WithSurrogateKey(surrogateKey string) *GetScoreOK {
+	o.SurrogateKey = surrogateKey
+	return o
+}
+
+// SetSurrogateKey sets the surrogateKey to the get score o k response
+func (o *GetScoreOK) SetSurrogateKey(surrogateKey string) {
+	o.SurrogateKey = surrogateKey
+}
+
+// WithPayload adds the payload to the get score o k response
+func (o *GetScoreOK) WithPayload(payload *models.WeightedScore) *GetScoreOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get score o k response
+func (o *GetScoreOK) SetPayload(payload *models.WeightedScore) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetScoreOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	// response header Surrogate-Key
+
+	surrogateKey := o.SurrogateKey
+	if surrogateKey != "" {
+		rw.Header().Set("Surrogate-Key", surrogateKey)
+	}
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetScoreBadRequestCode is the HTTP code returned for type GetScoreBadRequest
+const GetScoreBadRequestCode int = 400
+
+/*
+GetScoreBadRequest The request provided to the server was invalid
+
+swagger:response getScoreBadRequest
+*/
+type GetScoreBadRequest struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetScoreBadRequest creates GetScoreBadRequest with default headers values
+func NewGetScoreBadRequest() *GetScoreBadRequest {
+
+	return &GetScoreBadRequest{}
+}
+
+// WithCacheControl adds the cacheControl to the get score bad request response
+func (o *GetScoreBadRequest) WithCacheControl(cacheControl string) *GetScoreBadRequest {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the get score bad request response
+func (o *GetScoreBadRequest) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithSurrogateControl adds the surrogateControl to the get score bad request response
+func (o *GetScoreBadRequest) WithSurrogateControl(surrogateControl string) *GetScoreBadRequest {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the get score bad request response
+func (o *GetScoreBadRequest) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithPayload adds the payload to the get score bad request response
+func (o *GetScoreBadRequest) WithPayload(payload *models.Error) *GetScoreBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get score bad request response
+func (o *GetScoreBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetScoreBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetScoreNotFoundCode is the HTTP code returned for type GetScoreNotFound
+const GetScoreNotFoundCode int = 404
+
+/*
+GetScoreNotFound The content requested could not be found
+
+swagger:response getScoreNotFound
+*/
+type GetScoreNotFound struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+	/*Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+
+	 */
+	SurrogateKey string `json:"Surrogate-Key"`
+}
+
+// NewGetScoreNotFound creates GetScoreNotFound with default headers values
+func NewGetScoreNotFound() *GetScoreNotFound {
+
+	return &GetScoreNotFound{}
+}
+
+// WithCacheControl adds the cacheControl to the get score not found response
+func (o *GetScoreNotFound) WithCacheControl(cacheControl string) *GetScoreNotFound {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the get score not found response
+func (o *GetScoreNotFound) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithSurrogateControl adds the surrogateControl to the get score not found response
+func (o *GetScoreNotFound) WithSurrogateControl(surrogateControl string) *GetScoreNotFound {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the get score not found response
+func (o *GetScoreNotFound) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithSurrogateKey adds the surrogateKey to the get score not found response
+func (o *GetScoreNotFound) WithSurrogateKey(surrogateKey string) *GetScoreNotFound {
+	o.SurrogateKey = surrogateKey
+	return o
+}
+
+// SetSurrogateKey sets the surrogateKey to the get score not found response
+func (o *GetScoreNotFound) SetSurrogateKey(surrogateKey string) {
+	o.SurrogateKey = surrogateKey
+}
+
+// WriteResponse to the client
+func (o *GetScoreNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	// response header Surrogate-Key
+
+	surrogateKey := o.SurrogateKey
+	if surrogateKey != "" {
+		rw.Header().Set("Surrogate-Key", surrogateKey)
+	}
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// GetScoreGoneCode is the HTTP code returned for type GetScoreGone
+const GetScoreGoneCode int = 410
+
+/*
+GetScoreGone The result was taken down. The message is the reason.
+
+swagger:response getScoreGone
+*/
+type GetScoreGone struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+	/*Surrogate keys for Fastly CDN purging, e.g. repo:github.com/org/repo
+
+	 */
+	SurrogateKey string `json:"Surrogate-Key"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetScoreGone creates GetScoreGone with default headers values
+func NewGetScoreGone() *GetScoreGone {
+
+	return &GetScoreGone{}
+}
+
+// WithCacheControl adds the cacheControl to the get score gone response
+func (o *GetScoreGone) WithCacheControl(cacheControl string) *GetScoreGone {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the get score gone response
+func (o *GetScoreGone) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithSurrogateControl adds the surrogateControl to the get score gone response
+func (o *GetScoreGone) WithSurrogateControl(surrogateControl string) *GetScoreGone {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the get score gone response
+func (o *GetScoreGone) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithSurrogateKey adds the surrogateKey to the get score gone response
+func (o *GetScoreGone) WithSurrogateKey(surrogateKey string) *GetScoreGone {
+	o.SurrogateKey = surrogateKey
+	return o
+}
+
+// SetSurrogateKey sets the surrogateKey to the get score gone response
+func (o *GetScoreGone) SetSurrogateKey(surrogateKey string) {
+	o.SurrogateKey = surrogateKey
+}
+
+// WithPayload adds the payload to the get score gone response
+func (o *GetScoreGone) WithPayload(payload *models.Error) *GetScoreGone {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get score gone response
+func (o *GetScoreGone) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetScoreGone) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	// response header Surrogate-Key
+
+	surrogateKey := o.SurrogateKey
+	if surrogateKey != "" {
+		rw.Header().Set("Surrogate-Key", surrogateKey)
+	}
+
+	rw.WriteHeader(410)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetScoreDefault There was an internal error in the server while processing the request
+
+swagger:response getScoreDefault
+*/
+type GetScoreDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetScoreDefault creates GetScoreDefault with default headers values
+func NewGetScoreDefault(code int) *GetScoreDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetScoreDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get score default response
+func (o *GetScoreDefault) WithStatusCode(code int) *GetScoreDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get score default response
+func (o *GetScoreDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get score default response
+func (o *GetScoreDefault) WithPayload(payload *models.Error) *GetScoreDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get score default response
+func (o *GetScoreDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetScoreDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package results
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// SearchResultsHandlerFunc turns a function with the right signature into a search results handler
+type SearchResultsHandlerFunc func(SearchResultsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SearchResultsHandlerFunc) Handle(params SearchResultsParams) middleware.Responder {
+	return fn(params)
+}
+
+// SearchResultsHandler interface for that can handle valid search results params
+type SearchResultsHandler interface {
+	Handle(SearchResultsParams) middleware.Responder
+}
+
+// NewSearchResults creates a new http.Handler for the search results operation
+func NewSearchResults(ctx *middleware.Context, handler SearchResultsHandler) *SearchResults {
+	return &SearchResults{Context: ctx, Handler: handler}
+}
+
+/*
+	SearchResults swagger:route GET /search results searchResults
+
+# Search the latest ScorecardResults of repositories
+
+Searches the index of the latest result of each repository. The index is updated whenever a result is published, and backfilled from the weekly scan. Results are paginated with limit and offset; truncated is set when there are more. Score filters apply to the given check, or to the aggregate score without one. Inconclusive check scores (-1) never match score filters. Taken down results are left out, so a page can have fewer than limit results even when truncated is set. Unless the server has a database index, searches of many repositories must filter by platform and org.
+*/
+type SearchResults struct {
+	Context *middleware.Context
+	Handler SearchResultsHandler
+}
+
+func (o *SearchResults) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSearchResultsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
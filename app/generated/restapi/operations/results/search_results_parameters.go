@@ -0,0 +1,428 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package results
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewSearchResultsParams creates a new SearchResultsParams object
+// with the default values initialized.
+func NewSearchResultsParams() SearchResultsParams {
+
+	var (
+		// initialize parameters with default values
+
+		limitDefault = int64(100)
+
+		offsetDefault = int64(0)
+
+		sortDefault = string("name")
+	)
+
+	return SearchResultsParams{
+		Limit: &limitDefault,
+
+		Offset: &offsetDefault,
+
+		Sort: &sortDefault,
+	}
+}
+
+// SearchResultsParams contains all the bound params for the search results operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters searchResults
+type SearchResultsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Name of the check the score filters apply to. eg. Branch-Protection
+	  In: query
+	*/
+	Check *string
+	/*Maximum number of results to return
+	  Maximum: 1000
+	  Minimum: 1
+	  In: query
+	  Default: 100
+	*/
+	Limit *int64
+	/*Maximum score, inclusive
+	  In: query
+	*/
+	MaxScore *float64
+	/*Minimum score, inclusive
+	  In: query
+	*/
+	MinScore *float64
+	/*Number of matching results to skip, for pagination
+	  Minimum: 0
+	  In: query
+	  Default: 0
+	*/
+	Offset *int64
+	/*Name of the owner/organization of the repositories
+	  In: query
+	*/
+	Org *string
+	/*VCS platform. eg. github.com
+	  In: query
+	*/
+	Platform *string
+	/*Scorecard version, with * and ? wildcards. eg. v5.*
+	  In: query
+	*/
+	ScorecardVersion *string
+	/*Order of the results. score is the score of the given check, or the aggregate score without one. A leading - sorts in descending order. Ties are sorted by name.
+
+	  In: query
+	  Default: "name"
+	*/
+	Sort *string
+	/*Only results computed on or after this date (YYYY-MM-DD or RFC 3339)
+	  In: query
+	*/
+	UpdatedSince *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewSearchResultsParams() beforehand.
+func (o *SearchResultsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qCheck, qhkCheck, _ := qs.GetOK("check")
+	if err := o.bindCheck(qCheck, qhkCheck, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMaxScore, qhkMaxScore, _ := qs.GetOK("maxScore")
+	if err := o.bindMaxScore(qMaxScore, qhkMaxScore, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMinScore, qhkMinScore, _ := qs.GetOK("minScore")
+	if err := o.bindMinScore(qMinScore, qhkMinScore, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOffset, qhkOffset, _ := qs.GetOK("offset")
+	if err := o.bindOffset(qOffset, qhkOffset, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOrg, qhkOrg, _ := qs.GetOK("org")
+	if err := o.bindOrg(qOrg, qhkOrg, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qPlatform, qhkPlatform, _ := qs.GetOK("platform")
+	if err := o.bindPlatform(qPlatform, qhkPlatform, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qScorecardVersion, qhkScorecardVersion, _ := qs.GetOK("scorecardVersion")
+	if err := o.bindScorecardVersion(qScorecardVersion, qhkScorecardVersion, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSort, qhkSort, _ := qs.GetOK("sort")
+	if err := o.bindSort(qSort, qhkSort, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qUpdatedSince, qhkUpdatedSince, _ := qs.GetOK("updatedSince")
+	if err := o.bindUpdatedSince(qUpdatedSince, qhkUpdatedSince, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindCheck binds and validates parameter Check from query.
+func (o *SearchResultsParams) bindCheck(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Check = &raw
+
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *SearchResultsParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewSearchResultsParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *SearchResultsParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", *o.Limit, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("limit", "query", *o.Limit, 1000, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindMaxScore binds and validates parameter MaxScore from query.
+func (o *SearchResultsParams) bindMaxScore(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertFloat64(raw)
+	if err != nil {
+		return errors.InvalidType("maxScore", "query", "float64", raw)
+	}
+	o.MaxScore = &value
+
+	return nil
+}
+
+// bindMinScore binds and validates parameter MinScore from query.
+func (o *SearchResultsParams) bindMinScore(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertFloat64(raw)
+	if err != nil {
+		return errors.InvalidType("minScore", "query", "float64", raw)
+	}
+	o.MinScore = &value
+
+	return nil
+}
+
+// bindOffset binds and validates parameter Offset from query.
+func (o *SearchResultsParams) bindOffset(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewSearchResultsParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("offset", "query", "int64", raw)
+	}
+	o.Offset = &value
+
+	if err := o.validateOffset(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateOffset carries on validations for parameter Offset
+func (o *SearchResultsParams) validateOffset(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("offset", "query", *o.Offset, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindOrg binds and validates parameter Org from query.
+func (o *SearchResultsParams) bindOrg(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Org = &raw
+
+	return nil
+}
+
+// bindPlatform binds and validates parameter Platform from query.
+func (o *SearchResultsParams) bindPlatform(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Platform = &raw
+
+	return nil
+}
+
+// bindScorecardVersion binds and validates parameter ScorecardVersion from query.
+func (o *SearchResultsParams) bindScorecardVersion(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.ScorecardVersion = &raw
+
+	return nil
+}
+
+// bindSort binds and validates parameter Sort from query.
+func (o *SearchResultsParams) bindSort(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewSearchResultsParams()
+		return nil
+	}
+	o.Sort = &raw
+
+	if err := o.validateSort(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSort carries on validations for parameter Sort
+func (o *SearchResultsParams) validateSort(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("sort", "query", *o.Sort, []interface{}{"name", "-name", "score", "-score", "date", "-date"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindUpdatedSince binds and validates parameter UpdatedSince from query.
+func (o *SearchResultsParams) bindUpdatedSince(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.UpdatedSince = &raw
+
+	return nil
+}
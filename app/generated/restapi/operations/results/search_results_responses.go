@@ -0,0 +1,266 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package results
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// SearchResultsOKCode is the HTTP code returned for type SearchResultsOK
+const SearchResultsOKCode int = 200
+
+/*
+SearchResultsOK The matching repositories
+
+swagger:response searchResultsOK
+*/
+type SearchResultsOK struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.SearchResults `json:"body,omitempty"`
+}
+
+// NewSearchResultsOK creates SearchResultsOK with default headers values
+func NewSearchResultsOK() *SearchResultsOK {
+
+	return &SearchResultsOK{}
+}
+
+// WithCacheControl adds the cacheControl to the search results o k response
+func (o *SearchResultsOK) WithCacheControl(cacheControl string) *SearchResultsOK {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the search results o k response
+func (o *SearchResultsOK) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithSurrogateControl adds the surrogateControl to the search results o k response
+func (o *SearchResultsOK) WithSurrogateControl(surrogateControl string) *SearchResultsOK {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the search results o k response
+func (o *SearchResultsOK) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithPayload adds the payload to the search results o k response
+func (o *SearchResultsOK) WithPayload(payload *models.SearchResults) *SearchResultsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the search results o k response
+func (o *SearchResultsOK) SetPayload(payload *models.SearchResults) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SearchResultsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SearchResultsBadRequestCode is the HTTP code returned for type SearchResultsBadRequest
+const SearchResultsBadRequestCode int = 400
+
+/*
+SearchResultsBadRequest The request provided to the server was invalid
+
+swagger:response searchResultsBadRequest
+*/
+type SearchResultsBadRequest struct {
+	/*TTL for browser caching. Example: max-age=3600
+
+	 */
+	CacheControl string `json:"Cache-Control"`
+	/*TTL for Fastly CDN caching. Example: max-age=3600
+
+	 */
+	SurrogateControl string `json:"Surrogate-Control"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSearchResultsBadRequest creates SearchResultsBadRequest with default headers values
+func NewSearchResultsBadRequest() *SearchResultsBadRequest {
+
+	return &SearchResultsBadRequest{}
+}
+
+// WithCacheControl adds the cacheControl to the search results bad request response
+func (o *SearchResultsBadRequest) WithCacheControl(cacheControl string) *SearchResultsBadRequest {
+	o.CacheControl = cacheControl
+	return o
+}
+
+// SetCacheControl sets the cacheControl to the search results bad request response
+func (o *SearchResultsBadRequest) SetCacheControl(cacheControl string) {
+	o.CacheControl = cacheControl
+}
+
+// WithSurrogateControl adds the surrogateControl to the search results bad request response
+func (o *SearchResultsBadRequest) WithSurrogateControl(surrogateControl string) *SearchResultsBadRequest {
+	o.SurrogateControl = surrogateControl
+	return o
+}
+
+// SetSurrogateControl sets the surrogateControl to the search results bad request response
+func (o *SearchResultsBadRequest) SetSurrogateControl(surrogateControl string) {
+	o.SurrogateControl = surrogateControl
+}
+
+// WithPayload adds the payload to the search results bad request response
+func (o *SearchResultsBadRequest) WithPayload(payload *models.Error) *SearchResultsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the search results bad request response
+func (o *SearchResultsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SearchResultsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Cache-Control
+
+	cacheControl := o.CacheControl
+	if cacheControl != "" {
+		rw.Header().Set("Cache-Control", cacheControl)
+	}
+
+	// response header Surrogate-Control
+
+	surrogateControl := o.SurrogateControl
+	if surrogateControl != "" {
+		rw.Header().Set("Surrogate-Control", surrogateControl)
+	}
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+SearchResultsDefault There was an internal error in the server while processing the request
+
+swagger:response searchResultsDefault
+*/
+type SearchResultsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSearchResultsDefault creates SearchResultsDefault with default headers values
+func NewSearchResultsDefault(code int) *SearchResultsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SearchResultsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the search results default response
+func (o *SearchResultsDefault) WithStatusCode(code int) *SearchResultsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the search results default response
+func (o *SearchResultsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the search results default response
+func (o *SearchResultsDefault) WithPayload(payload *models.Error) *SearchResultsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the search results default response
+func (o *SearchResultsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SearchResultsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,198 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// Copyright 2021 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package results
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
+)
+
+// SearchResultsURL generates an URL for the search results operation
+type SearchResultsURL struct {
+	Check            *string
+	Limit            *int64
+	MaxScore         *float64
+	MinScore         *float64
+	Offset           *int64
+	Org              *string
+	Platform         *string
+	ScorecardVersion *string
+	Sort             *string
+	UpdatedSince     *string
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *SearchResultsURL) WithBasePath(bp string) *SearchResultsURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *SearchResultsURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *SearchResultsURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/search"
+
+	_basePath := o._basePath
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var checkQ string
+	if o.Check != nil {
+		checkQ = *o.Check
+	}
+	if checkQ != "" {
+		qs.Set("check", checkQ)
+	}
+
+	var limitQ string
+	if o.Limit != nil {
+		limitQ = swag.FormatInt64(*o.Limit)
+	}
+	if limitQ != "" {
+		qs.Set("limit", limitQ)
+	}
+
+	var maxScoreQ string
+	if o.MaxScore != nil {
+		maxScoreQ = swag.FormatFloat64(*o.MaxScore)
+	}
+	if maxScoreQ != "" {
+		qs.Set("maxScore", maxScoreQ)
+	}
+
+	var minScoreQ string
+	if o.MinScore != nil {
+		minScoreQ = swag.FormatFloat64(*o.MinScore)
+	}
+	if minScoreQ != "" {
+		qs.Set("minScore", minScoreQ)
+	}
+
+	var offsetQ string
+	if o.Offset != nil {
+		offsetQ = swag.FormatInt64(*o.Offset)
+	}
+	if offsetQ != "" {
+		qs.Set("offset", offsetQ)
+	}
+
+	var orgQ string
+	if o.Org != nil {
+		orgQ = *o.Org
+	}
+	if orgQ != "" {
+		qs.Set("org", orgQ)
+	}
+
+	var platformQ string
+	if o.Platform != nil {
+		platformQ = *o.Platform
+	}
+	if platformQ != "" {
+		qs.Set("platform", platformQ)
+	}
+
+	var scorecardVersionQ string
+	if o.ScorecardVersion != nil {
+		scorecardVersionQ = *o.ScorecardVersion
+	}
+	if scorecardVersionQ != "" {
+		qs.Set("scorecardVersion", scorecardVersionQ)
+	}
+
+	var sortQ string
+	if o.Sort != nil {
+		sortQ = *o.Sort
+	}
+	if sortQ != "" {
+		qs.Set("sort", sortQ)
+	}
+
+	var updatedSinceQ string
+	if o.UpdatedSince != nil {
+		updatedSinceQ = *o.UpdatedSince
+	}
+	if updatedSinceQ != "" {
+		qs.Set("updatedSince", updatedSinceQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *SearchResultsURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *SearchResultsURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *SearchResultsURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on SearchResultsURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on SearchResultsURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *SearchResultsURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
@@ -22,6 +22,7 @@ package operations
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -30,10 +31,12 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/runtime/security"
+	"github.com/go-openapi/runtime/yamlpc"
 	"github.com/go-openapi/spec"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/ossf/scorecard-webapp/app/generated/restapi/operations/admin"
 	"github.com/ossf/scorecard-webapp/app/generated/restapi/operations/badge"
 	"github.com/ossf/scorecard-webapp/app/generated/restapi/operations/results"
 )
@@ -58,18 +61,62 @@ func NewScorecardAPI(spec *loads.Document) *ScorecardAPI {
 
 		JSONConsumer: runtime.JSONConsumer(),
 
-		BinProducer:  runtime.ByteStreamProducer(),
+		BinProducer: runtime.ByteStreamProducer(),
+		CsvProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("csv producer has not yet been implemented")
+		}),
 		JSONProducer: runtime.JSONProducer(),
+		MarkdownProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("markdown producer has not yet been implemented")
+		}),
+		YamlProducer: yamlpc.YAMLProducer(),
 
+		AdminAnnotateResultHandler: admin.AnnotateResultHandlerFunc(func(params admin.AnnotateResultParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.AnnotateResult has not yet been implemented")
+		}),
+		ResultsEvaluatePolicyHandler: results.EvaluatePolicyHandlerFunc(func(params results.EvaluatePolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation results.EvaluatePolicy has not yet been implemented")
+		}),
+		AdminGetAuditLogHandler: admin.GetAuditLogHandlerFunc(func(params admin.GetAuditLogParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.GetAuditLog has not yet been implemented")
+		}),
 		BadgeGetBadgeHandler: badge.GetBadgeHandlerFunc(func(params badge.GetBadgeParams) middleware.Responder {
 			return middleware.NotImplemented("operation badge.GetBadge has not yet been implemented")
 		}),
+		AdminGetMetricsHandler: admin.GetMetricsHandlerFunc(func(params admin.GetMetricsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.GetMetrics has not yet been implemented")
+		}),
+		ResultsGetOrgResultsHandler: results.GetOrgResultsHandlerFunc(func(params results.GetOrgResultsParams) middleware.Responder {
+			return middleware.NotImplemented("operation results.GetOrgResults has not yet been implemented")
+		}),
 		ResultsGetResultHandler: results.GetResultHandlerFunc(func(params results.GetResultParams) middleware.Responder {
 			return middleware.NotImplemented("operation results.GetResult has not yet been implemented")
 		}),
+		ResultsGetScoreHandler: results.GetScoreHandlerFunc(func(params results.GetScoreParams) middleware.Responder {
+			return middleware.NotImplemented("operation results.GetScore has not yet been implemented")
+		}),
+		AdminListAdminResultsHandler: admin.ListAdminResultsHandlerFunc(func(params admin.ListAdminResultsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.ListAdminResults has not yet been implemented")
+		}),
 		ResultsPostResultHandler: results.PostResultHandlerFunc(func(params results.PostResultParams) middleware.Responder {
 			return middleware.NotImplemented("operation results.PostResult has not yet been implemented")
 		}),
+		AdminRestoreResultHandler: admin.RestoreResultHandlerFunc(func(params admin.RestoreResultParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.RestoreResult has not yet been implemented")
+		}),
+		ResultsSearchResultsHandler: results.SearchResultsHandlerFunc(func(params results.SearchResultsParams) middleware.Responder {
+			return middleware.NotImplemented("operation results.SearchResults has not yet been implemented")
+		}),
+		AdminTombstoneResultHandler: admin.TombstoneResultHandlerFunc(func(params admin.TombstoneResultParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.TombstoneResult has not yet been implemented")
+		}),
+
+		// Applies when the "Authorization" header is set
+		AdminTokenAuth: func(token string) (interface{}, error) {
+			return nil, errors.NotImplemented("api key auth (adminToken) Authorization from header param [Authorization] has not yet been implemented")
+		},
+		// default authorizer is authorized meaning no requests are blocked
+		APIAuthorizer: security.Authorized(),
 	}
 }
 
@@ -105,16 +152,52 @@ type ScorecardAPI struct {
 	// BinProducer registers a producer for the following mime types:
 	//   - image/svg+xml
 	BinProducer runtime.Producer
+	// CsvProducer registers a producer for the following mime types:
+	//   - text/csv
+	CsvProducer runtime.Producer
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
-
+	// MarkdownProducer registers a producer for the following mime types:
+	//   - text/markdown
+	MarkdownProducer runtime.Producer
+	// YamlProducer registers a producer for the following mime types:
+	//   - application/yaml
+	YamlProducer runtime.Producer
+
+	// AdminTokenAuth registers a function that takes a token and returns a principal
+	// it performs authentication based on an api key Authorization provided in the header
+	AdminTokenAuth func(string) (interface{}, error)
+
+	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
+	APIAuthorizer runtime.Authorizer
+
+	// AdminAnnotateResultHandler sets the operation handler for the annotate result operation
+	AdminAnnotateResultHandler admin.AnnotateResultHandler
+	// ResultsEvaluatePolicyHandler sets the operation handler for the evaluate policy operation
+	ResultsEvaluatePolicyHandler results.EvaluatePolicyHandler
+	// AdminGetAuditLogHandler sets the operation handler for the get audit log operation
+	AdminGetAuditLogHandler admin.GetAuditLogHandler
 	// BadgeGetBadgeHandler sets the operation handler for the get badge operation
 	BadgeGetBadgeHandler badge.GetBadgeHandler
+	// AdminGetMetricsHandler sets the operation handler for the get metrics operation
+	AdminGetMetricsHandler admin.GetMetricsHandler
+	// ResultsGetOrgResultsHandler sets the operation handler for the get org results operation
+	ResultsGetOrgResultsHandler results.GetOrgResultsHandler
 	// ResultsGetResultHandler sets the operation handler for the get result operation
 	ResultsGetResultHandler results.GetResultHandler
+	// ResultsGetScoreHandler sets the operation handler for the get score operation
+	ResultsGetScoreHandler results.GetScoreHandler
+	// AdminListAdminResultsHandler sets the operation handler for the list admin results operation
+	AdminListAdminResultsHandler admin.ListAdminResultsHandler
 	// ResultsPostResultHandler sets the operation handler for the post result operation
 	ResultsPostResultHandler results.PostResultHandler
+	// AdminRestoreResultHandler sets the operation handler for the restore result operation
+	AdminRestoreResultHandler admin.RestoreResultHandler
+	// ResultsSearchResultsHandler sets the operation handler for the search results operation
+	ResultsSearchResultsHandler results.SearchResultsHandler
+	// AdminTombstoneResultHandler sets the operation handler for the tombstone result operation
+	AdminTombstoneResultHandler admin.TombstoneResultHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -191,19 +274,62 @@ func (o *ScorecardAPI) Validate() error {
 	if o.BinProducer == nil {
 		unregistered = append(unregistered, "BinProducer")
 	}
+	if o.CsvProducer == nil {
+		unregistered = append(unregistered, "CsvProducer")
+	}
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
+	if o.MarkdownProducer == nil {
+		unregistered = append(unregistered, "MarkdownProducer")
+	}
+	if o.YamlProducer == nil {
+		unregistered = append(unregistered, "YamlProducer")
+	}
 
+	if o.AdminTokenAuth == nil {
+		unregistered = append(unregistered, "AuthorizationAuth")
+	}
+
+	if o.AdminAnnotateResultHandler == nil {
+		unregistered = append(unregistered, "admin.AnnotateResultHandler")
+	}
+	if o.ResultsEvaluatePolicyHandler == nil {
+		unregistered = append(unregistered, "results.EvaluatePolicyHandler")
+	}
+	if o.AdminGetAuditLogHandler == nil {
+		unregistered = append(unregistered, "admin.GetAuditLogHandler")
+	}
 	if o.BadgeGetBadgeHandler == nil {
 		unregistered = append(unregistered, "badge.GetBadgeHandler")
 	}
+	if o.AdminGetMetricsHandler == nil {
+		unregistered = append(unregistered, "admin.GetMetricsHandler")
+	}
+	if o.ResultsGetOrgResultsHandler == nil {
+		unregistered = append(unregistered, "results.GetOrgResultsHandler")
+	}
 	if o.ResultsGetResultHandler == nil {
 		unregistered = append(unregistered, "results.GetResultHandler")
 	}
+	if o.ResultsGetScoreHandler == nil {
+		unregistered = append(unregistered, "results.GetScoreHandler")
+	}
+	if o.AdminListAdminResultsHandler == nil {
+		unregistered = append(unregistered, "admin.ListAdminResultsHandler")
+	}
 	if o.ResultsPostResultHandler == nil {
 		unregistered = append(unregistered, "results.PostResultHandler")
 	}
+	if o.AdminRestoreResultHandler == nil {
+		unregistered = append(unregistered, "admin.RestoreResultHandler")
+	}
+	if o.ResultsSearchResultsHandler == nil {
+		unregistered = append(unregistered, "results.SearchResultsHandler")
+	}
+	if o.AdminTombstoneResultHandler == nil {
+		unregistered = append(unregistered, "admin.TombstoneResultHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -219,12 +345,21 @@ func (o *ScorecardAPI) ServeErrorFor(operationID string) func(http.ResponseWrite
 
 // AuthenticatorsFor gets the authenticators for the specified security schemes
 func (o *ScorecardAPI) AuthenticatorsFor(schemes map[string]spec.SecurityScheme) map[string]runtime.Authenticator {
-	return nil
+	result := make(map[string]runtime.Authenticator)
+	for name := range schemes {
+		switch name {
+		case "adminToken":
+			scheme := schemes[name]
+			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, o.AdminTokenAuth)
+
+		}
+	}
+	return result
 }
 
 // Authorizer returns the registered authorizer
 func (o *ScorecardAPI) Authorizer() runtime.Authorizer {
-	return nil
+	return o.APIAuthorizer
 }
 
 // ConsumersFor gets the consumers for the specified media types.
@@ -252,8 +387,14 @@ func (o *ScorecardAPI) ProducersFor(mediaTypes []string) map[string]runtime.Prod
 		switch mt {
 		case "image/svg+xml":
 			result["image/svg+xml"] = o.BinProducer
+		case "text/csv":
+			result["text/csv"] = o.CsvProducer
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "text/markdown":
+			result["text/markdown"] = o.MarkdownProducer
+		case "application/yaml":
+			result["application/yaml"] = o.YamlProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -294,6 +435,18 @@ func (o *ScorecardAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/projects/{platform}/{org}/{repo}/annotate"] = admin.NewAnnotateResult(o.context, o.AdminAnnotateResultHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/projects/{platform}/{org}/{repo}/evaluate"] = results.NewEvaluatePolicy(o.context, o.ResultsEvaluatePolicyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/projects/{platform}/{org}/{repo}/audit"] = admin.NewGetAuditLog(o.context, o.AdminGetAuditLogHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -301,11 +454,39 @@ func (o *ScorecardAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/metrics"] = admin.NewGetMetrics(o.context, o.AdminGetMetricsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/projects/{platform}/{org}"] = results.NewGetOrgResults(o.context, o.ResultsGetOrgResultsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/projects/{platform}/{org}/{repo}"] = results.NewGetResult(o.context, o.ResultsGetResultHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/projects/{platform}/{org}/{repo}/score"] = results.NewGetScore(o.context, o.ResultsGetScoreHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/projects/{platform}/{org}/{repo}"] = admin.NewListAdminResults(o.context, o.AdminListAdminResultsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/projects/{platform}/{org}/{repo}"] = results.NewPostResult(o.context, o.ResultsPostResultHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/projects/{platform}/{org}/{repo}/restore"] = admin.NewRestoreResult(o.context, o.AdminRestoreResultHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/search"] = results.NewSearchResults(o.context, o.ResultsSearchResultsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/projects/{platform}/{org}/{repo}/tombstone"] = admin.NewTombstoneResult(o.context, o.AdminTombstoneResultHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
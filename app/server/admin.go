@@ -0,0 +1,417 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+
+	oaerrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"gocloud.dev/blob"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+	"github.com/ossf/scorecard-webapp/app/generated/restapi/operations/admin"
+	"github.com/ossf/scorecard-webapp/app/server/internal/cdn"
+	"github.com/ossf/scorecard-webapp/app/server/internal/override"
+)
+
+var errMalformedAdminToken = errors.New("malformed admin token, want name:token")
+
+// commitResultKey matches the key of a commit's results file, relative to the repository.
+var commitResultKey = regexp.MustCompile(`^([0-9a-fA-F]{40})/` + resultsFile + `$`)
+
+// adminTokens maps the SHA-256 hash of each admin token to its name.
+type adminTokens map[[sha256.Size]byte]string
+
+var (
+	adminTokensOnce sync.Once
+	adminTokenNames adminTokens
+)
+
+// AdminTokenAuth authenticates requests to the admin API by their Authorization header, a bearer
+// token listed in ADMIN_TOKENS, and returns the token's name as the principal.
+func AdminTokenAuth(header string) (interface{}, error) {
+	adminTokensOnce.Do(func() {
+		adminTokenNames = newAdminTokens(os.Getenv)
+	})
+	return adminTokenNames.authenticate(header)
+}
+
+// newAdminTokens reads the admin tokens from ADMIN_TOKENS, a comma separated list of name:token pairs.
+// The name identifies the admin in the audit log. The admin API is disabled if there are none, or if
+// RESULT_OVERRIDES_BUCKET_URL isn't set, as overrides would only last until the instance restarts.
+func newAdminTokens(getenv func(string) string) adminTokens {
+	if getenv("RESULT_OVERRIDES_BUCKET_URL") == "" {
+		log.Println("admin API disabled, RESULT_OVERRIDES_BUCKET_URL not set")
+		return nil
+	}
+	tokens, err := parseAdminTokens(getenv("ADMIN_TOKENS"))
+	if err != nil {
+		log.Println("error reading ADMIN_TOKENS, admin API disabled: " + err.Error())
+		return nil
+	}
+	if len(tokens) == 0 {
+		log.Println("admin API disabled, ADMIN_TOKENS not set")
+	}
+	return tokens
+}
+
+func parseAdminTokens(s string) (adminTokens, error) {
+	tokens := adminTokens{}
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, token, ok := strings.Cut(pair, ":")
+		if !ok || name == "" || token == "" {
+			return nil, errMalformedAdminToken
+		}
+		tokens[sha256.Sum256([]byte(token))] = name
+	}
+	return tokens, nil
+}
+
+// authenticate returns the name of the token in the Authorization header. Hashes are compared
+// in constant time, so the response time doesn't leak how much of a token is right.
+func (t adminTokens) authenticate(header string) (string, error) {
+	token := strings.TrimSpace(header)
+	if len(token) > len("Bearer ") && strings.EqualFold(token[:len("Bearer ")], "Bearer ") {
+		token = strings.TrimSpace(token[len("Bearer "):])
+	}
+	hash := sha256.Sum256([]byte(token))
+	var name string
+	for h, n := range t {
+		if subtle.ConstantTimeCompare(h[:], hash[:]) == 1 {
+			name = n
+		}
+	}
+	if token == "" || name == "" {
+		return "", oaerrors.Unauthenticated("adminToken")
+	}
+	return name, nil
+}
+
+var (
+	overridesOnce sync.Once
+	overrides     *override.Store
+	errOverrides  error
+)
+
+// OpenOverrides opens the process wide store of results overrides, in RESULT_OVERRIDES_BUCKET_URL.
+// It's called at startup, so results which were taken down are never served because their
+// overrides couldn't be read. Without RESULT_OVERRIDES_BUCKET_URL, there are no overrides
+// and the admin API is disabled.
+func OpenOverrides(ctx context.Context) error {
+	overridesOnce.Do(func() {
+		overrides, errOverrides = newOverrides(ctx, os.Getenv)
+	})
+	return errOverrides
+}
+
+func newOverrides(ctx context.Context, getenv func(string) string) (*override.Store, error) {
+	bucketURL := getenv("RESULT_OVERRIDES_BUCKET_URL")
+	if bucketURL == "" {
+		return nil, nil
+	}
+	bucket, err := blob.OpenBucket(ctx, bucketURL)
+	if err != nil {
+		return nil, fmt.Errorf("opening RESULT_OVERRIDES_BUCKET_URL: %w", err)
+	}
+	return override.NewStore(bucket), nil
+}
+
+// getOverrides returns the store opened by OpenOverrides, nil without RESULT_OVERRIDES_BUCKET_URL.
+func getOverrides() *override.Store {
+	if err := OpenOverrides(context.Background()); err != nil {
+		// The server doesn't start when this fails, so it can't be serving.
+		log.Fatal(err)
+	}
+	return overrides
+}
+
+// resolveOverride returns the override applying to the result served for the request: the
+// commit's result if commit is set, otherwise res, the repository's latest result, so taking
+// down the commit it's for takes it down too. res is nil if there is no result, or to only
+// resolve the repository's override. Without a store, no override applies and res isn't read.
+func resolveOverride(ctx context.Context, store *override.Store, res *storedResult,
+	host, org, repo, commit string,
+) (override.Override, error) {
+	if store == nil {
+		return override.Override{}, nil
+	}
+	if commit == "" && res != nil {
+		var err error
+		if commit, err = res.commit(ctx); err != nil {
+			return override.Override{}, err
+		}
+	}
+	o, err := store.Resolve(ctx, host, org, repo, commit)
+	if err != nil {
+		return override.Override{}, fmt.Errorf("resolving override: %w", err)
+	}
+	return o, nil
+}
+
+func ListAdminResultsHandler(params admin.ListAdminResultsParams, principal interface{}) middleware.Responder {
+	ctx := requestContext(params.HTTPRequest)
+	res, err := listAdminResults(ctx, getOverrides(), resultSources, params.Platform, params.Org, params.Repo)
+	if errors.Is(err, errInvalidInputs) {
+		return admin.NewListAdminResultsBadRequest().WithPayload(&models.Error{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	if err != nil {
+		return admin.NewListAdminResultsDefault(http.StatusInternalServerError).WithPayload(&models.Error{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+	}
+	return admin.NewListAdminResultsOK().WithPayload(res)
+}
+
+func TombstoneResultHandler(params admin.TombstoneResultParams, principal interface{}) middleware.Responder {
+	res, err := applyOverride(requestContext(params.HTTPRequest), getOverrides(), getPurgeQueue(),
+		principal, override.ActionTombstone, params.Platform, params.Org, params.Repo, params.Override)
+	if errors.Is(err, errInvalidInputs) {
+		return admin.NewTombstoneResultBadRequest().WithPayload(&models.Error{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	if err != nil {
+		return admin.NewTombstoneResultDefault(http.StatusInternalServerError).WithPayload(&models.Error{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+	}
+	return admin.NewTombstoneResultOK().WithPayload(res)
+}
+
+func RestoreResultHandler(params admin.RestoreResultParams, principal interface{}) middleware.Responder {
+	res, err := applyOverride(requestContext(params.HTTPRequest), getOverrides(), getPurgeQueue(),
+		principal, override.ActionRestore, params.Platform, params.Org, params.Repo, params.Override)
+	if errors.Is(err, errInvalidInputs) {
+		return admin.NewRestoreResultBadRequest().WithPayload(&models.Error{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	if errors.Is(err, override.ErrNoOverride) {
+		return admin.NewRestoreResultNotFound()
+	}
+	if err != nil {
+		return admin.NewRestoreResultDefault(http.StatusInternalServerError).WithPayload(&models.Error{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+	}
+	return admin.NewRestoreResultOK().WithPayload(res)
+}
+
+func AnnotateResultHandler(params admin.AnnotateResultParams, principal interface{}) middleware.Responder {
+	res, err := applyOverride(requestContext(params.HTTPRequest), getOverrides(), getPurgeQueue(),
+		principal, override.ActionAnnotate, params.Platform, params.Org, params.Repo, params.Override)
+	if errors.Is(err, errInvalidInputs) {
+		return admin.NewAnnotateResultBadRequest().WithPayload(&models.Error{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	if err != nil {
+		return admin.NewAnnotateResultDefault(http.StatusInternalServerError).WithPayload(&models.Error{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+	}
+	return admin.NewAnnotateResultOK().WithPayload(res)
+}
+
+func GetAuditLogHandler(params admin.GetAuditLogParams, principal interface{}) middleware.Responder {
+	res, err := auditLog(requestContext(params.HTTPRequest), getOverrides(), params.Platform, params.Org, params.Repo)
+	if errors.Is(err, errInvalidInputs) {
+		return admin.NewGetAuditLogBadRequest().WithPayload(&models.Error{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	if err != nil {
+		return admin.NewGetAuditLogDefault(http.StatusInternalServerError).WithPayload(&models.Error{
+			Code:    http.StatusInternalServerError,
+			Message: err.Error(),
+		})
+	}
+	return admin.NewGetAuditLogOK().WithPayload(res)
+}
+
+// GetMetricsHandler reports the metrics of the instance's CDN purge queue, so admins can tell
+// when purges are falling behind or being given up on.
+func GetMetricsHandler(params admin.GetMetricsParams, principal interface{}) middleware.Responder {
+	queue := getPurgeQueue()
+	return admin.NewGetMetricsOK().
+		WithCacheControl("no-store").
+		WithPayload(&models.Metrics{
+			CdnPurgeBacklog:     int64(queue.Backlog()),
+			CdnPurgeDeadLetters: int64(queue.DeadLetters()),
+		})
+}
+
+// applyOverride makes the admin's change to the repository's results, or the commit's result,
+// and purges the cached responses it affects.
+func applyOverride(ctx context.Context, store *override.Store, queue *cdn.Queue, principal interface{},
+	action, host, org, repo string, req *models.OverrideRequest,
+) (*models.ResultOverride, error) {
+	if _, err := sanitizeInputs(host, org, repo, nil); err != nil {
+		return nil, err
+	}
+	actor, _ := principal.(string)
+	change := override.Change{
+		Actor:      actor,
+		Action:     action,
+		Host:       host,
+		Org:        org,
+		Repo:       repo,
+		Commit:     strings.ToLower(req.Commit),
+		Reason:     *req.Reason,
+		Annotation: req.Annotation,
+	}
+	o, err := store.Apply(ctx, change)
+	if err != nil {
+		return nil, fmt.Errorf("applying %s: %w", action, err)
+	}
+	log.Printf("admin %s: %s %s: %s", actor, action, path.Join(host, org, repo, change.Commit), change.Reason)
+	purgeRepo(ctx, queue, host, org, repo, change.Commit)
+	return toResultOverride(o), nil
+}
+
+// listAdminResults lists the repository's results stored in the sources, and its overrides.
+func listAdminResults(ctx context.Context, store *override.Store, sources []resultSource,
+	host, org, repo string,
+) (*models.AdminResults, error) {
+	if _, err := sanitizeInputs(host, org, repo, nil); err != nil {
+		return nil, err
+	}
+	stored, err := listStoredResults(ctx, sources, host, org, repo)
+	if err != nil {
+		return nil, err
+	}
+	list, err := store.List(ctx, host, org, repo)
+	if err != nil {
+		return nil, fmt.Errorf("listing overrides: %w", err)
+	}
+	res := &models.AdminResults{Name: path.Join(host, org, repo), Results: stored}
+	for i := range list {
+		res.Overrides = append(res.Overrides, toResultOverride(&list[i]))
+	}
+	return res, nil
+}
+
+// listStoredResults lists the repository's latest results and commits' results in every source.
+// The latest results have no commit.
+func listStoredResults(ctx context.Context, sources []resultSource, host, org, repo string,
+) ([]*models.StoredResult, error) {
+	var stored []*models.StoredResult
+	for _, s := range sources {
+		results, err := listSourceResults(ctx, s, path.Join(host, org, repo)+"/")
+		if err != nil {
+			return nil, fmt.Errorf("listing %s results: %w", s.name, err)
+		}
+		stored = append(stored, results...)
+	}
+	return stored, nil
+}
+
+func listSourceResults(ctx context.Context, s resultSource, prefix string) ([]*models.StoredResult, error) {
+	bucket, err := blob.OpenBucket(ctx, s.bucketURL)
+	if err != nil {
+		return nil, fmt.Errorf("blob.OpenBucket: %w", err)
+	}
+	defer bucket.Close()
+	var stored []*models.StoredResult
+	iter := bucket.List(&blob.ListOptions{Prefix: prefix})
+	for {
+		obj, err := iter.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			return stored, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("bucket.List: %w", err)
+		}
+		var commit string
+		if key := strings.TrimPrefix(obj.Key, prefix); key != resultsFile {
+			m := commitResultKey.FindStringSubmatch(key)
+			if m == nil {
+				continue
+			}
+			commit = m[1]
+		}
+		stored = append(stored, &models.StoredResult{
+			Commit:       commit,
+			Source:       s.name,
+			LastModified: strfmt.DateTime(obj.ModTime.UTC()),
+		})
+	}
+}
+
+// auditLog returns the audit log of the repository's overrides.
+func auditLog(ctx context.Context, store *override.Store, host, org, repo string) (*models.AuditLog, error) {
+	if _, err := sanitizeInputs(host, org, repo, nil); err != nil {
+		return nil, err
+	}
+	events, err := store.Audit(ctx, host, org, repo)
+	if err != nil {
+		return nil, fmt.Errorf("reading audit log: %w", err)
+	}
+	res := &models.AuditLog{}
+	for _, e := range events {
+		res.Events = append(res.Events, &models.AuditEvent{
+			Time:       strfmt.DateTime(e.Time),
+			Actor:      e.Actor,
+			Action:     e.Action,
+			Name:       path.Join(e.Host, e.Org, e.Repo),
+			Commit:     e.Commit,
+			Reason:     e.Reason,
+			Annotation: e.Annotation,
+		})
+	}
+	return res, nil
+}
+
+func toResultOverride(o *override.Override) *models.ResultOverride {
+	return &models.ResultOverride{
+		Name:            path.Join(o.Host, o.Org, o.Repo),
+		Commit:          o.Commit,
+		Tombstoned:      o.Tombstoned,
+		Reason:          o.Reason,
+		TombstoneReason: o.TombstoneReason,
+		Annotation:      o.Annotation,
+		UpdatedBy:       o.UpdatedBy,
+		UpdatedAt:       strfmt.DateTime(o.UpdatedAt),
+	}
+}
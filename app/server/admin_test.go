@@ -0,0 +1,316 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+	"github.com/ossf/scorecard-webapp/app/generated/restapi/operations/admin"
+	"github.com/ossf/scorecard-webapp/app/server/internal/cdn"
+	"github.com/ossf/scorecard-webapp/app/server/internal/override"
+)
+
+func Test_adminTokens_authenticate(t *testing.T) {
+	t.Parallel()
+	tokens := newAdminTokens(func(string) string { return "alice:s3cret, bob:hunter2:with:colons" })
+	tests := []struct {
+		name    string
+		header  string
+		want    string
+		wantErr bool
+	}{
+		{name: "bearer token", header: "Bearer s3cret", want: "alice"},
+		{name: "lowercase scheme", header: "bearer hunter2:with:colons", want: "bob"},
+		{name: "bare token", header: "s3cret", want: "alice"},
+		{name: "wrong token", header: "Bearer s3cre", wantErr: true},
+		{name: "name as token", header: "Bearer alice", wantErr: true},
+		{name: "empty", header: "Bearer ", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tokens.authenticate(tt.header)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("authenticate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("authenticate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_newAdminTokens(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		env   map[string]string
+		name  string
+		count int
+	}{
+		{name: "unset", env: map[string]string{"RESULT_OVERRIDES_BUCKET_URL": "mem://"}},
+		{
+			name:  "one",
+			env:   map[string]string{"ADMIN_TOKENS": "alice:s3cret", "RESULT_OVERRIDES_BUCKET_URL": "mem://"},
+			count: 1,
+		},
+		{
+			name:  "trailing comma",
+			env:   map[string]string{"ADMIN_TOKENS": "alice:s3cret,", "RESULT_OVERRIDES_BUCKET_URL": "mem://"},
+			count: 1,
+		},
+		{
+			name: "missing name",
+			env:  map[string]string{"ADMIN_TOKENS": "alice:s3cret,:hunter2", "RESULT_OVERRIDES_BUCKET_URL": "mem://"},
+		},
+		{name: "missing token", env: map[string]string{"ADMIN_TOKENS": "alice", "RESULT_OVERRIDES_BUCKET_URL": "mem://"}},
+		{name: "overrides not persisted", env: map[string]string{"ADMIN_TOKENS": "alice:s3cret"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tokens := newAdminTokens(func(key string) string { return tt.env[key] })
+			if len(tokens) != tt.count {
+				t.Errorf("newAdminTokens() has %d tokens, want %d", len(tokens), tt.count)
+			}
+			if _, err := tokens.authenticate(""); err == nil {
+				t.Error("authenticate(\"\") succeeded")
+			}
+		})
+	}
+}
+
+func Test_newOverrides(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		env       string
+		wantErr   bool
+		wantStore bool
+	}{
+		{name: "unset"},
+		{name: "bucket", env: "mem://", wantStore: true},
+		{name: "invalid bucket", env: "nosuchscheme://bucket", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			store, err := newOverrides(context.Background(), func(string) string { return tt.env })
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newOverrides() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (store != nil) != tt.wantStore {
+				t.Errorf("newOverrides() = %v, want a store %t", store, tt.wantStore)
+			}
+		})
+	}
+}
+
+func Test_applyOverride(t *testing.T) {
+	t.Parallel()
+	const commit = "0123456789ABCDEF0123456789ABCDEF01234567"
+	ctx := context.Background()
+	store := override.NewStore(nil)
+	purger := &fakePurger{keyErr: cdn.ErrKeysUnsupported}
+	queue := cdn.NewQueue(purger, nil, cdn.DefaultQueueOptions)
+	if err := queue.Start(ctx); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	defer queue.Close()
+
+	_, err := applyOverride(ctx, store, queue, "alice", override.ActionTombstone, "github.com", "org", "..",
+		&models.OverrideRequest{Reason: swag.String("takedown")})
+	if !errors.Is(err, errInvalidInputs) {
+		t.Errorf("applyOverride(invalid repository) = %v, want errInvalidInputs", err)
+	}
+	_, err = applyOverride(ctx, store, queue, "alice", override.ActionRestore, "github.com", "org", "repo",
+		&models.OverrideRequest{Reason: swag.String("nothing to restore")})
+	if !errors.Is(err, override.ErrNoOverride) {
+		t.Errorf("applyOverride(restore) = %v, want ErrNoOverride", err)
+	}
+
+	got, err := applyOverride(ctx, store, queue, "alice", override.ActionTombstone, "github.com", "Org", "Repo",
+		&models.OverrideRequest{Commit: commit, Reason: swag.String("leaked secret")})
+	if err != nil {
+		t.Fatalf("applyOverride: %v", err)
+	}
+	want := &models.ResultOverride{
+		Name:            "github.com/Org/Repo",
+		Commit:          "0123456789abcdef0123456789abcdef01234567",
+		Tombstoned:      true,
+		Reason:          "leaked secret",
+		TombstoneReason: "leaked secret",
+		UpdatedBy:       "alice",
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(models.ResultOverride{}, "UpdatedAt")); diff != "" {
+		t.Errorf("applyOverride() mismatch (-want +got):\n%s", diff)
+	}
+	resolved, err := store.Resolve(ctx, "github.com", "org", "repo", commit)
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+	if !resolved.Tombstoned || resolved.TombstoneReason != "leaked secret" {
+		t.Errorf("Resolve() = %+v, want tombstoned", resolved)
+	}
+
+	assert.Eventually(t, func() bool { return queue.Backlog() == 0 }, 5*time.Second, 10*time.Millisecond)
+	purger.mu.Lock()
+	defer purger.mu.Unlock()
+	assert.Contains(t, purger.urls, "/projects/github.com/Org/Repo?commit=0123456789abcdef0123456789abcdef01234567")
+
+	audit, err := auditLog(ctx, store, "github.com", "org", "repo")
+	if err != nil {
+		t.Fatalf("auditLog: %v", err)
+	}
+	if len(audit.Events) != 1 || audit.Events[0].Actor != "alice" || audit.Events[0].Action != override.ActionTombstone {
+		t.Errorf("auditLog() = %+v, want the tombstone", audit.Events)
+	}
+}
+
+func Test_listStoredResults(t *testing.T) {
+	t.Parallel()
+	const commit = "0123456789abcdef0123456789abcdef01234567"
+	modTime := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	source := func(t *testing.T, name string, keys ...string) resultSource {
+		t.Helper()
+		dir := t.TempDir()
+		for _, key := range keys {
+			path := filepath.Join(dir, filepath.FromSlash(key))
+			if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+				t.Fatalf("os.MkdirAll: %v", err)
+			}
+			if err := os.WriteFile(path, []byte(`{"date":"2024-01-01"}`), 0o600); err != nil {
+				t.Fatalf("os.WriteFile: %v", err)
+			}
+			if err := os.Chtimes(path, modTime, modTime); err != nil {
+				t.Fatalf("os.Chtimes: %v", err)
+			}
+		}
+		return resultSource{bucketURL: "file://" + filepath.ToSlash(dir), name: name}
+	}
+	sources := []resultSource{
+		source(t, IndexSourceAction,
+			"github.com/org/repo/results.json",
+			"github.com/org/repo/"+commit+"/results.json",
+			"github.com/org/repo/unrelated.json",
+			"github.com/org/repository/results.json"),
+		source(t, IndexSourceCron, "github.com/org/repo/results.json"),
+	}
+
+	got, err := listStoredResults(context.Background(), sources, "github.com", "org", "repo")
+	if err != nil {
+		t.Fatalf("listStoredResults: %v", err)
+	}
+	lastModified := strfmt.DateTime(modTime)
+	want := []*models.StoredResult{
+		{Commit: commit, Source: IndexSourceAction, LastModified: lastModified},
+		{Source: IndexSourceAction, LastModified: lastModified},
+		{Source: IndexSourceCron, LastModified: lastModified},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("listStoredResults() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_resolveOverride(t *testing.T) {
+	t.Parallel()
+	const commit = "0123456789abcdef0123456789abcdef01234567"
+	ctx := context.Background()
+	latest := func(t *testing.T, commit string) *storedResult {
+		t.Helper()
+		dir := t.TempDir()
+		path := filepath.Join(dir, "github.com", "org", "repo", resultsFile)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("os.MkdirAll: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(`{"repo":{"commit":"`+commit+`"}}`), 0o600); err != nil {
+			t.Fatalf("os.WriteFile: %v", err)
+		}
+		sources := []resultSource{{bucketURL: "file://" + filepath.ToSlash(dir), name: IndexSourceAction}}
+		res, err := findResult(ctx, sources, "github.com/org/repo/"+resultsFile, "")
+		if err != nil {
+			t.Fatalf("findResult: %v", err)
+		}
+		t.Cleanup(func() { res.Close() })
+		return res
+	}
+	store := override.NewStore(nil)
+	_, err := store.Apply(ctx, override.Change{
+		Actor: "alice", Action: override.ActionTombstone, Host: "github.com", Org: "org", Repo: "repo",
+		Commit: commit, Reason: "leaked secret",
+	})
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+
+	tests := []struct {
+		res  *storedResult
+		name string
+		want bool
+	}{
+		{name: "latest result of the tombstoned commit", res: latest(t, commit), want: true},
+		{name: "latest result of another commit", res: latest(t, "fedcba9876543210fedcba9876543210fedcba98")},
+		{name: "no result"},
+	}
+	t.Run("no store", func(t *testing.T) {
+		t.Parallel()
+		res := latest(t, commit)
+		got, err := resolveOverride(ctx, nil, res, "github.com", "org", "repo", "")
+		if err != nil || got.Tombstoned {
+			t.Errorf("resolveOverride() = %+v, %v, want no override", got, err)
+		}
+		if res.data != nil {
+			t.Error("resolveOverride() read the result without a store")
+		}
+	})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := resolveOverride(ctx, store, tt.res, "github.com", "org", "repo", "")
+			if err != nil {
+				t.Fatalf("resolveOverride: %v", err)
+			}
+			if got.Tombstoned != tt.want {
+				t.Errorf("resolveOverride() = %+v, want tombstoned %v", got, tt.want)
+			}
+			if tt.want && got.TombstoneReason != "leaked secret" {
+				t.Errorf("TombstoneReason = %q, want the commit's", got.TombstoneReason)
+			}
+		})
+	}
+}
+
+func TestGetMetricsHandler(t *testing.T) {
+	// Don't purge a real CDN.
+	t.Setenv("STORAGE_EMULATOR_HOST", "localhost:0")
+	got, ok := GetMetricsHandler(admin.GetMetricsParams{}, "alice").(*admin.GetMetricsOK)
+	if !ok || got.Payload == nil || got.CacheControl != "no-store" {
+		t.Fatalf("GetMetricsHandler() = %#v, want uncached metrics", got)
+	}
+	if got.Payload.CdnPurgeBacklog != 0 || got.Payload.CdnPurgeDeadLetters != 0 {
+		t.Errorf("GetMetricsHandler() = %+v, want an empty queue", got.Payload)
+	}
+}
@@ -15,7 +15,10 @@
 package server
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"log"
 	"net/http"
 	"net/url"
 
@@ -25,12 +28,15 @@ import (
 
 	"github.com/ossf/scorecard-webapp/app/generated/models"
 	"github.com/ossf/scorecard-webapp/app/generated/restapi/operations/badge"
+	"github.com/ossf/scorecard-webapp/app/server/internal/override"
 )
 
 const (
 	shieldsURL   = "https://img.shields.io/ossf-scorecard"
 	badgeLabel   = "openssf+scorecard"
 	defaultStyle = "flat"
+	// Tags every badge redirect, so they can all be purged at once.
+	badgeSurrogateKey = "scorecard-badge-redirect"
 )
 
 func GetBadgeHandler(params badge.GetBadgeParams) middleware.Responder {
@@ -54,10 +60,41 @@ func GetBadgeHandler(params badge.GetBadgeParams) middleware.Responder {
 		})
 	}
 
+	override, err := badgeOverride(requestContext(params.HTTPRequest), getOverrides(), host, orgName, repoName)
+	if err != nil {
+		// The badge is only a redirect to the score, so it's served rather than failed.
+		log.Printf("error resolving the badge override of %s/%s/%s: %v", host, orgName, repoName, err)
+	}
+
 	return middleware.ResponderFunc(func(rw http.ResponseWriter, producer runtime.Producer) {
 		rw.Header().Set("Surrogate-Control", fastlyTTL)
 		rw.Header().Set("Cache-Control", browserCacheTTL)
-		rw.Header().Set("Surrogate-Key", "scorecard-badge-redirect")
+		rw.Header().Set("Surrogate-Key", badgeSurrogateKey+" "+repoSurrogateKey(host, orgName, repoName))
+		if override.Tombstoned {
+			// Restoring the repository's results purges this response.
+			rw.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			rw.WriteHeader(http.StatusGone)
+			if _, err := io.WriteString(rw, override.TombstoneReason); err != nil {
+				log.Printf("error writing badge response: %v", err)
+			}
+			return
+		}
 		http.Redirect(rw, params.HTTPRequest, parsedURL.String(), http.StatusFound)
 	})
 }
+
+// badgeOverride returns the override applying to the repository's latest result, the one
+// the badge shows the score of. Without a store, the result isn't looked up.
+func badgeOverride(ctx context.Context, store *override.Store, host, orgName, repoName string,
+) (override.Override, error) {
+	if store == nil {
+		return override.Override{}, nil
+	}
+	res, err := lookupResult(ctx, host, orgName, repoName, nil, "")
+	if err != nil {
+		// Without a result, only the repository's own override applies.
+		return resolveOverride(ctx, store, nil, host, orgName, repoName, "")
+	}
+	defer res.Close()
+	return resolveOverride(ctx, store, res, host, orgName, repoName, "")
+}
@@ -0,0 +1,205 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"golang.org/x/mod/semver"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+	"github.com/ossf/scorecard-webapp/app/generated/restapi/operations/results"
+	"github.com/ossf/scorecard-webapp/app/server/internal/index"
+)
+
+const (
+	maxPolicyScore = 10
+	day            = 24 * time.Hour
+)
+
+func EvaluatePolicyHandler(params results.EvaluatePolicyParams) middleware.Responder {
+	ctx := requestContext(params.HTTPRequest)
+	if fields := validatePolicy(params.Policy); len(fields) > 0 {
+		return results.NewEvaluatePolicyBadRequest().WithPayload(&models.Error{
+			Code:    http.StatusBadRequest,
+			Message: "invalid policy",
+			Fields:  fields,
+		})
+	}
+
+	res, err := lookupResult(ctx, params.Platform, params.Org, params.Repo, params.Commit, "")
+	if errors.Is(err, errInvalidInputs) {
+		return results.NewEvaluatePolicyBadRequest()
+	}
+	var served *storedResult
+	if err == nil {
+		defer res.Close()
+		served = res
+	}
+	override, overrideErr := resolveOverride(ctx, getOverrides(), served, params.Platform, params.Org, params.Repo,
+		swag.StringValue(params.Commit))
+	if overrideErr != nil {
+		err = overrideErr
+	} else if override.Tombstoned {
+		return results.NewEvaluatePolicyGone().WithPayload(&models.Error{
+			Code:    http.StatusGone,
+			Message: override.TombstoneReason,
+		})
+	}
+	if errors.Is(err, errNotFound) {
+		return results.NewEvaluatePolicyNotFound()
+	}
+	if err == nil {
+		var b []byte
+		if b, err = res.read(ctx); err == nil {
+			var result models.ScorecardResult
+			if err = result.UnmarshalBinary(b); err == nil {
+				ret := evaluatePolicy(&result, params.Policy, time.Now())
+				ret.Name = fmt.Sprintf("%s/%s/%s", params.Platform, params.Org, params.Repo)
+				return results.NewEvaluatePolicyOK().WithPayload(ret)
+			}
+		}
+	}
+
+	return results.NewEvaluatePolicyDefault(http.StatusInternalServerError).WithPayload(&models.Error{
+		Code:    http.StatusInternalServerError,
+		Message: err.Error(),
+	})
+}
+
+// validatePolicy returns the invalid fields of the policy, if any.
+func validatePolicy(policy *models.Policy) []*models.FieldError {
+	var fields []*models.FieldError
+	invalid := func(field, format string, args ...any) {
+		fields = append(fields, &models.FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+	if policy == nil {
+		invalid("policy", "is required")
+		return fields
+	}
+	if policy.MinScore < 0 || policy.MinScore > maxPolicyScore || math.IsNaN(policy.MinScore) {
+		invalid("minScore", "must be between 0 and %d", maxPolicyScore)
+	}
+	for _, check := range sortedKeys(policy.MinCheckScores) {
+		if score := policy.MinCheckScores[check]; score < 0 || score > maxPolicyScore {
+			invalid("minCheckScores."+check, "must be between 0 and %d", maxPolicyScore)
+		}
+	}
+	for i, check := range policy.RequiredChecks {
+		if strings.TrimSpace(check) == "" {
+			invalid(fmt.Sprintf("requiredChecks.%d", i), "is empty")
+		}
+	}
+	if policy.MaxAgeDays < 0 {
+		invalid("maxAgeDays", "can't be negative")
+	}
+	if policy.MinScorecardVersion != "" && !semver.IsValid(policy.MinScorecardVersion) {
+		invalid("minScorecardVersion", "%q isn't a semantic version, e.g. v5.0.0", policy.MinScorecardVersion)
+	}
+	return fields
+}
+
+// evaluatePolicy checks every rule of the policy against the result, as of now.
+// Violations are listed in the order of the policy's fields, and by check name within a rule.
+func evaluatePolicy(result *models.ScorecardResult, policy *models.Policy, now time.Time) *models.PolicyEvaluation {
+	ret := &models.PolicyEvaluation{Date: result.Date}
+	if result.Repo != nil {
+		ret.Commit = result.Repo.Commit
+	}
+	if result.Scorecard != nil {
+		ret.ScorecardVersion = result.Scorecard.Version
+	}
+	violate := func(rule, check, format string, args ...any) {
+		ret.Violations = append(ret.Violations, &models.PolicyViolation{
+			Rule:    rule,
+			Check:   check,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	if policy.MinScore > 0 && result.Score < policy.MinScore {
+		violate(models.PolicyViolationRuleMinScore, "", "aggregate score %.1f is below %.1f", result.Score, policy.MinScore)
+	}
+
+	scores := map[string]int64{}
+	for _, check := range result.Checks {
+		if check != nil {
+			scores[check.Name] = check.Score
+		}
+	}
+	for _, name := range sortedKeys(policy.MinCheckScores) {
+		minScore := policy.MinCheckScores[name]
+		score, ok := scores[name]
+		switch {
+		case !ok:
+			violate(models.PolicyViolationRuleMinCheckScores, name, "check is missing")
+		case score == inconclusiveScore:
+			violate(models.PolicyViolationRuleMinCheckScores, name, "check is inconclusive")
+		case score < minScore:
+			violate(models.PolicyViolationRuleMinCheckScores, name, "score %d is below %d", score, minScore)
+		}
+	}
+	for _, name := range policy.RequiredChecks {
+		score, ok := scores[name]
+		switch {
+		case !ok:
+			violate(models.PolicyViolationRuleRequiredChecks, name, "check is missing")
+		case score == inconclusiveScore:
+			violate(models.PolicyViolationRuleRequiredChecks, name, "check is inconclusive")
+		}
+	}
+
+	if policy.MaxAgeDays > 0 {
+		date, err := index.ParseDate(result.Date)
+		switch {
+		case err != nil:
+			violate(models.PolicyViolationRuleMaxAgeDays, "", "result has no valid date: %v", err)
+		case now.Sub(date) > time.Duration(policy.MaxAgeDays)*day:
+			violate(models.PolicyViolationRuleMaxAgeDays, "", "result is %d days old, more than %d",
+				int64(now.Sub(date)/day), policy.MaxAgeDays)
+		}
+	}
+
+	if policy.MinScorecardVersion != "" {
+		switch {
+		case !semver.IsValid(ret.ScorecardVersion):
+			violate(models.PolicyViolationRuleMinScorecardVersion, "",
+				"scorecard version %q isn't a semantic version", ret.ScorecardVersion)
+		case semver.Compare(ret.ScorecardVersion, policy.MinScorecardVersion) < 0:
+			violate(models.PolicyViolationRuleMinScorecardVersion, "",
+				"scorecard version %s is older than %s", ret.ScorecardVersion, policy.MinScorecardVersion)
+		}
+	}
+
+	ret.Pass = len(ret.Violations) == 0
+	return ret
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+func Test_evaluatePolicy(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	result := &models.ScorecardResult{
+		Date:      "2024-02-01",
+		Repo:      &models.Repo{Name: "github.com/org/repo", Commit: "abc"},
+		Scorecard: &models.ScorecardVersion{Version: "v4.13.1"},
+		Score:     6.4,
+		Checks: []*models.ScorecardCheck{
+			{Name: "Code-Review", Score: 8},
+			{Name: "Fuzzing", Score: -1},
+			{Name: "License", Score: 10},
+			{Name: "Maintained", Score: 3},
+		},
+	}
+	tests := []struct {
+		policy *models.Policy
+		name   string
+		want   []*models.PolicyViolation
+	}{
+		{
+			name:   "empty policy",
+			policy: &models.Policy{},
+		},
+		{
+			name: "passing",
+			policy: &models.Policy{
+				MinScore:            6,
+				MinCheckScores:      map[string]int64{"Code-Review": 8, "License": 5},
+				RequiredChecks:      []string{"Maintained"},
+				MaxAgeDays:          30,
+				MinScorecardVersion: "v4.10.0",
+			},
+		},
+		{
+			name: "failing",
+			policy: &models.Policy{
+				MinScore:            7,
+				MinCheckScores:      map[string]int64{"Maintained": 5, "Code-Review": 9, "SAST": 1, "Fuzzing": 0},
+				RequiredChecks:      []string{"License", "Fuzzing", "Webhooks"},
+				MaxAgeDays:          14,
+				MinScorecardVersion: "v5",
+			},
+			want: []*models.PolicyViolation{
+				{Rule: "minScore", Message: "aggregate score 6.4 is below 7.0"},
+				{Rule: "minCheckScores", Check: "Code-Review", Message: "score 8 is below 9"},
+				{Rule: "minCheckScores", Check: "Fuzzing", Message: "check is inconclusive"},
+				{Rule: "minCheckScores", Check: "Maintained", Message: "score 3 is below 5"},
+				{Rule: "minCheckScores", Check: "SAST", Message: "check is missing"},
+				{Rule: "requiredChecks", Check: "Fuzzing", Message: "check is inconclusive"},
+				{Rule: "requiredChecks", Check: "Webhooks", Message: "check is missing"},
+				{Rule: "maxAgeDays", Message: "result is 29 days old, more than 14"},
+				{Rule: "minScorecardVersion", Message: "scorecard version v4.13.1 is older than v5"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := evaluatePolicy(result, tt.policy, now)
+			want := &models.PolicyEvaluation{
+				Pass:             len(tt.want) == 0,
+				Date:             "2024-02-01",
+				Commit:           "abc",
+				ScorecardVersion: "v4.13.1",
+				Violations:       tt.want,
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("evaluatePolicy() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_evaluatePolicy_unversioned(t *testing.T) {
+	t.Parallel()
+	result := &models.ScorecardResult{Date: "yesterday", Scorecard: &models.ScorecardVersion{Version: "devel"}}
+	got := evaluatePolicy(result, &models.Policy{MaxAgeDays: 7, MinScorecardVersion: "v5.0.0"}, time.Now())
+	if got.Pass || len(got.Violations) != 2 {
+		t.Errorf("evaluatePolicy() = %+v, want maxAgeDays and minScorecardVersion violations", got)
+	}
+}
+
+func Test_validatePolicy(t *testing.T) {
+	t.Parallel()
+	fields := validatePolicy(&models.Policy{
+		MinScore:            11,
+		MinCheckScores:      map[string]int64{"License": 10, "Fuzzing": -1},
+		RequiredChecks:      []string{"License", " "},
+		MaxAgeDays:          -1,
+		MinScorecardVersion: "5.0",
+	})
+	var got []string
+	for _, f := range fields {
+		got = append(got, f.Field)
+	}
+	want := []string{"minScore", "minCheckScores.Fuzzing", "requiredChecks.1", "maxAgeDays", "minScorecardVersion"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("validatePolicy() mismatch (-want +got):\n%s", diff)
+	}
+	if fields := validatePolicy(nil); len(fields) != 1 {
+		t.Errorf("validatePolicy(nil) = %v, want one field", fields)
+	}
+}
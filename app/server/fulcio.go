@@ -0,0 +1,183 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ossf/scorecard-webapp/app/server/internal/ct"
+)
+
+var (
+	errNoLeafCert = errors.New("entry has no certificate")
+	errLeafIsCA   = errors.New("entry's leaf certificate is a CA")
+)
+
+// fulcioTrust is the set of Fulcio certificates entries' certificates must chain to, and the
+// certificate transparency logs Fulcio publishes them to.
+type fulcioTrust struct {
+	roots         *x509.CertPool
+	intermediates *x509.CertPool
+	ctLogs        ct.Logs
+	// requireSCT rejects certificates without an SCT from one of ctLogs, so a compromised
+	// intermediate alone can't issue certificates we accept.
+	requireSCT bool
+}
+
+var (
+	fulcioOnce sync.Once
+	fulcio     fulcioTrust
+	errFulcio  error
+)
+
+// LoadFulcio loads the process wide Fulcio trust, see newFulcio. It's called at startup, so
+// results are never verified against the public good instance when another one is configured.
+func LoadFulcio() error {
+	fulcioOnce.Do(func() {
+		fulcio, errFulcio = newFulcio(os.Getenv)
+	})
+	return errFulcio
+}
+
+// getFulcio returns the trust loaded by LoadFulcio.
+func getFulcio() fulcioTrust {
+	if err := LoadFulcio(); err != nil {
+		// The server doesn't start when this fails, so it can't be serving.
+		log.Fatal(err)
+	}
+	return fulcio
+}
+
+// newFulcio configures the Fulcio trust from the environment:
+//   - FULCIO_ROOTS is the path to the PEM encoded root certificates, the public good instance's
+//     by default.
+//   - FULCIO_INTERMEDIATES is the path to the PEM encoded intermediate certificates to use when
+//     an entry only holds its leaf certificate, the public good instance's by default.
+//   - CT_LOG_PUBLIC_KEYS is the path to the PEM encoded public keys of the certificate
+//     transparency logs Fulcio publishes to, the public good instance's by default.
+//   - REQUIRE_SCT requires certificates to embed an SCT from one of these logs when true.
+//     Otherwise certificates without one are accepted, but invalid SCTs are still rejected.
+//
+// Settings which are set but can't be loaded are an error, rather than falling back to the
+// public good instance.
+func newFulcio(getenv func(string) string) (fulcioTrust, error) {
+	pools := map[string][]byte{"FULCIO_ROOTS": fulcioRoot, "FULCIO_INTERMEDIATES": fulcioIntermediate}
+	var trust fulcioTrust
+	for name, embedded := range pools {
+		pool, err := getCertPool(embedded)
+		if err != nil {
+			// The embedded certificates are tested.
+			panic(fmt.Sprintf("parsing embedded %s: %v", name, err))
+		}
+		if path := getenv(name); path != "" {
+			if pool, err = readCertPool(path); err != nil {
+				return fulcioTrust{}, fmt.Errorf("reading %s: %w", name, err)
+			}
+		}
+		if name == "FULCIO_ROOTS" {
+			trust.roots = pool
+		} else {
+			trust.intermediates = pool
+		}
+	}
+
+	logs, err := ct.ParseLogs(ctfePub)
+	if err != nil {
+		panic(fmt.Sprintf("parsing embedded CT log public key: %v", err))
+	}
+	trust.ctLogs = logs
+	if path := getenv("CT_LOG_PUBLIC_KEYS"); path != "" {
+		if trust.ctLogs, err = readCTLogs(path); err != nil {
+			return fulcioTrust{}, fmt.Errorf("reading CT_LOG_PUBLIC_KEYS: %w", err)
+		}
+	}
+	if v := getenv("REQUIRE_SCT"); v != "" {
+		if trust.requireSCT, err = strconv.ParseBool(v); err != nil {
+			return fulcioTrust{}, fmt.Errorf("invalid REQUIRE_SCT %q: %w", v, err)
+		}
+	}
+	return trust, nil
+}
+
+func readCTLogs(path string) (ct.Logs, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading CT log public keys: %w", err)
+	}
+	return ct.ParseLogs(b)
+}
+
+func readCertPool(path string) (*x509.CertPool, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading certificates: %w", err)
+	}
+	return getCertPool(b)
+}
+
+// verify checks chain, a leaf certificate followed by any intermediates, chains to a root for
+// code signing, the leaf was valid when the entry was logged, and its SCTs are valid.
+func (f fulcioTrust) verify(chain []*x509.Certificate, integratedTime time.Time) error {
+	if len(chain) == 0 {
+		return errNoLeafCert
+	}
+	cert := chain[0]
+	if cert.IsCA {
+		return errLeafIsCA
+	}
+	intermediates := f.intermediates.Clone()
+	for _, c := range chain[1:] {
+		intermediates.AddCert(c)
+	}
+	chains, err := cert.Verify(x509.VerifyOptions{
+		// Fulcio certificates are short lived, the signature's time is checked below.
+		CurrentTime:   cert.NotBefore,
+		Roots:         f.roots,
+		Intermediates: intermediates,
+		KeyUsages: []x509.ExtKeyUsage{
+			x509.ExtKeyUsageCodeSigning,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("verifying Fulcio issued certificate: %w", err)
+	}
+	// The leaf isn't a root, so every chain has its issuer.
+	switch err := f.ctLogs.VerifyEmbedded(cert, chains[0][1]); {
+	case errors.Is(err, ct.ErrNoSCT), errors.Is(err, ct.ErrUnknownLog):
+		if f.requireSCT {
+			return fmt.Errorf("verifying certificate transparency: %w", err)
+		}
+	case err != nil:
+		return fmt.Errorf("verifying certificate transparency: %w", err)
+	}
+
+	// Verify that cert isn't expired.
+	if cert.NotAfter.Before(integratedTime) {
+		return fmt.Errorf("certificate expired before signatures were entered in log: %s is before %s",
+			cert.NotAfter, integratedTime)
+	}
+	if cert.NotBefore.After(integratedTime) {
+		return fmt.Errorf("certificate was issued after signatures were entered in log: %s is after %s",
+			cert.NotBefore, integratedTime)
+	}
+	return nil
+}
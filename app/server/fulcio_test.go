@@ -0,0 +1,273 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ossf/scorecard-webapp/app/server/internal/rekor"
+)
+
+// testCA issues certificates like Fulcio's, from a root through an intermediate.
+type testCA struct {
+	root, intermediate *x509.Certificate
+	intermediateKey    *ecdsa.PrivateKey
+}
+
+func newTestCert(t *testing.T, tmpl, parent *x509.Certificate, parentKey *ecdsa.PrivateKey,
+) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("ecdsa.GenerateKey: %v", err)
+	}
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatalf("x509.CreateCertificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("x509.ParseCertificate: %v", err)
+	}
+	return cert, key
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	ca := func(name string) *x509.Certificate {
+		return &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: name},
+			NotBefore:             time.Now().Add(-time.Hour),
+			NotAfter:              time.Now().Add(time.Hour),
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageCertSign,
+		}
+	}
+	root, rootKey := newTestCert(t, ca("root"), nil, nil)
+	intermediate, intermediateKey := newTestCert(t, ca("intermediate"), root, rootKey)
+	return &testCA{root: root, intermediate: intermediate, intermediateKey: intermediateKey}
+}
+
+// leaf issues a code signing certificate valid from notBefore for 10 minutes.
+func (ca *testCA) leaf(t *testing.T, notBefore time.Time) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	return newTestCert(t, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		NotBefore:    notBefore,
+		NotAfter:     notBefore.Add(10 * time.Minute),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}, ca.intermediate, ca.intermediateKey)
+}
+
+func certPool(certs ...*x509.Certificate) *x509.CertPool {
+	pool := x509.NewCertPool()
+	for _, c := range certs {
+		pool.AddCert(c)
+	}
+	return pool
+}
+
+func Test_fulcioTrust_verify(t *testing.T) {
+	t.Parallel()
+	ca := newTestCA(t)
+	other := newTestCA(t)
+	now := time.Now().Truncate(time.Second)
+	leaf, _ := ca.leaf(t, now)
+	otherLeaf, _ := other.leaf(t, now)
+
+	withIntermediates := fulcioTrust{roots: certPool(ca.root), intermediates: certPool(ca.intermediate)}
+	rootsOnly := fulcioTrust{roots: certPool(ca.root), intermediates: certPool()}
+	tests := []struct {
+		trust   fulcioTrust
+		logged  time.Time
+		name    string
+		chain   []*x509.Certificate
+		wantErr bool
+	}{
+		{name: "configured intermediate", trust: withIntermediates, chain: []*x509.Certificate{leaf}, logged: now},
+		{
+			name: "intermediate in the entry", trust: rootsOnly,
+			chain: []*x509.Certificate{leaf, ca.intermediate}, logged: now,
+		},
+		{name: "missing intermediate", trust: rootsOnly, chain: []*x509.Certificate{leaf}, logged: now, wantErr: true},
+		{
+			name: "untrusted root", trust: withIntermediates,
+			chain: []*x509.Certificate{otherLeaf, other.intermediate}, logged: now, wantErr: true,
+		},
+		{
+			name: "intermediate as the leaf", trust: withIntermediates,
+			chain: []*x509.Certificate{ca.intermediate}, logged: now, wantErr: true,
+		},
+		{
+			name: "logged after expiry", trust: withIntermediates,
+			chain: []*x509.Certificate{leaf}, logged: now.Add(time.Hour), wantErr: true,
+		},
+		{
+			name: "logged before issuance", trust: withIntermediates,
+			chain: []*x509.Certificate{leaf}, logged: now.Add(-time.Minute), wantErr: true,
+		},
+		{name: "no certificate", trust: withIntermediates, logged: now, wantErr: true},
+		{
+			name: "SCT required", trust: fulcioTrust{
+				roots: certPool(ca.root), intermediates: certPool(ca.intermediate), requireSCT: true,
+			},
+			chain: []*x509.Certificate{leaf}, logged: now, wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := tt.trust.verify(tt.chain, tt.logged); (err != nil) != tt.wantErr {
+				t.Errorf("verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_newFulcio(t *testing.T) {
+	t.Parallel()
+	ca := newTestCA(t)
+	dir := t.TempDir()
+	writePEM := func(name string, cert *x509.Certificate) string {
+		path := filepath.Join(dir, name)
+		b := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+		if err := os.WriteFile(path, b, 0o600); err != nil {
+			t.Fatalf("os.WriteFile: %v", err)
+		}
+		return path
+	}
+	roots := writePEM("roots.pem", ca.root)
+	intermediates := writePEM("intermediates.pem", ca.intermediate)
+	leaf, _ := ca.leaf(t, time.Now())
+	publicGoodLeaf := savedEntryCert(t)
+	// Rekor's key is a valid log key, but not of the log which issued the SCT.
+	ctLogs := filepath.Join(dir, "ctlogs.pub")
+	if err := os.WriteFile(ctLogs, rekorPub, 0o600); err != nil {
+		t.Fatalf("os.WriteFile: %v", err)
+	}
+
+	tests := []struct {
+		env       map[string]string
+		cert      *x509.Certificate
+		name      string
+		wantValid bool
+		wantErr   bool
+	}{
+		{name: "public good instance"},
+		{name: "public good certificate", cert: publicGoodLeaf, wantValid: true},
+		{
+			name: "public good certificate with SCT required", cert: publicGoodLeaf,
+			env: map[string]string{"REQUIRE_SCT": "true"}, wantValid: true,
+		},
+		{
+			name: "SCT from an unknown log", cert: publicGoodLeaf,
+			env: map[string]string{"CT_LOG_PUBLIC_KEYS": ctLogs}, wantValid: true,
+		},
+		{
+			name: "SCT from an unknown log required", cert: publicGoodLeaf,
+			env: map[string]string{"CT_LOG_PUBLIC_KEYS": ctLogs, "REQUIRE_SCT": "true"},
+		},
+		{
+			name: "invalid CT log keys path", cert: publicGoodLeaf,
+			env:     map[string]string{"CT_LOG_PUBLIC_KEYS": filepath.Join(dir, "missing.pub"), "REQUIRE_SCT": "1"},
+			wantErr: true,
+		},
+		{
+			name:      "private instance",
+			env:       map[string]string{"FULCIO_ROOTS": roots, "FULCIO_INTERMEDIATES": intermediates},
+			wantValid: true,
+		},
+		{name: "intermediates from the entry only", env: map[string]string{"FULCIO_ROOTS": roots}},
+		{name: "invalid path", env: map[string]string{"FULCIO_ROOTS": filepath.Join(dir, "missing.pem")}, wantErr: true},
+		{
+			name:    "invalid intermediates path",
+			env:     map[string]string{"FULCIO_ROOTS": roots, "FULCIO_INTERMEDIATES": filepath.Join(dir, "missing.pem")},
+			wantErr: true,
+		},
+		{
+			name: "private instance without SCT required",
+			env: map[string]string{
+				"FULCIO_ROOTS": roots, "FULCIO_INTERMEDIATES": intermediates, "REQUIRE_SCT": "true",
+			},
+		},
+		{
+			name: "invalid REQUIRE_SCT",
+			env: map[string]string{
+				"FULCIO_ROOTS": roots, "FULCIO_INTERMEDIATES": intermediates, "REQUIRE_SCT": "always",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cert := leaf
+			if tt.cert != nil {
+				cert = tt.cert
+			}
+			trust, err := newFulcio(func(name string) string { return tt.env[name] })
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newFulcio() error = %v, want error %t", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			err = trust.verify([]*x509.Certificate{cert}, cert.NotBefore)
+			if (err == nil) != tt.wantValid {
+				t.Errorf("verify() error = %v, want valid %t", err, tt.wantValid)
+			}
+		})
+	}
+}
+
+// savedEntryCert returns the certificate of the saved Rekor entry, issued by the public good
+// Fulcio instance with an SCT from its CT log.
+func savedEntryCert(t *testing.T) *x509.Certificate {
+	t.Helper()
+	f, err := os.Open("testdata/rekor/log-entries-response.json")
+	if err != nil {
+		t.Fatalf("os.Open: %v", err)
+	}
+	defer f.Close()
+	_, entry, err := rekor.ParseEntry(f)
+	if err != nil {
+		t.Fatalf("rekor.ParseEntry: %v", err)
+	}
+	body, err := tlogEntry(*entry).rekord()
+	if err != nil {
+		t.Fatalf("rekord: %v", err)
+	}
+	certs, err := body.Certs()
+	if err != nil {
+		t.Fatalf("Certs: %v", err)
+	}
+	return certs[0]
+}
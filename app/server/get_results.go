@@ -16,13 +16,21 @@ package server
 
 import (
 	"context"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"gocloud.dev/blob"
 	_ "gocloud.dev/blob/gcsblob" // Needed to link in GCP drivers.
 
@@ -37,29 +45,155 @@ const (
 	// 1 year, invalidated if updated by the weekly scan or scorecard action.
 	fastlyTTL       = "max-age=31557600"
 	browserCacheTTL = "max-age=600" // 10 minutes
+
+	formatSARIF = "sarif"
+	formatProbe = "probe"
 )
 
 var errInvalidInputs = errors.New("invalid inputs provided")
 
 func GetResultHandler(params results.GetResultParams) middleware.Responder {
-	res, err := getResults(params.Platform, params.Org, params.Repo, params.Commit)
-
-	if errors.Is(err, errNotFound) {
-		return results.NewGetResultNotFound().
+	ctx := requestContext(params.HTTPRequest)
+	if format := swag.StringValue(params.Format); swag.BoolValue(params.Envelope) &&
+		(format == formatSARIF || format == formatProbe) {
+		return results.NewGetResultBadRequest().
 			WithSurrogateControl(fastlyTTL).
-			WithCacheControl(browserCacheTTL)
+			WithCacheControl(browserCacheTTL).
+			WithPayload(&models.Error{
+				Code:    http.StatusBadRequest,
+				Message: "envelope is only supported with the json format",
+			})
 	}
+	if format := swag.StringValue(params.Format); (swag.BoolValue(params.Envelope) ||
+		format == formatSARIF || format == formatProbe) && !acceptsJSON(params.HTTPRequest) {
+		// Cached, it would be served to the clients which do accept JSON.
+		return results.NewGetResultNotAcceptable().
+			WithSurrogateControl("no-store").
+			WithCacheControl("no-store").
+			WithPayload(&models.Error{
+				Code:    http.StatusNotAcceptable,
+				Message: "the sarif and probe formats, and envelopes, are only available as JSON",
+			})
+	}
+	res, err := lookupResult(ctx, params.Platform, params.Org, params.Repo, params.Commit,
+		swag.StringValue(params.Source))
+	surrogateKey := repoSurrogateKey(params.Platform, params.Org, params.Repo)
+
 	if errors.Is(err, errInvalidInputs) {
 		return results.NewGetResultBadRequest().
 			WithSurrogateControl(fastlyTTL).
 			WithCacheControl(browserCacheTTL)
 	}
 	if err == nil {
-		var ret models.ScorecardResult
-		if err = ret.UnmarshalBinary(res); err == nil {
-			return results.NewGetResultOK().WithPayload(&ret).
+		defer res.Close()
+	}
+	// Taken down results are gone whether or not they're still stored. The commit of the latest
+	// result is only known once it's read, which conditional requests don't need, so only the
+	// repository's override is resolved until then.
+	store := getOverrides()
+	override, overrideErr := resolveOverride(ctx, store, nil, params.Platform, params.Org, params.Repo,
+		swag.StringValue(params.Commit))
+	if overrideErr != nil {
+		err = overrideErr
+	} else if override.Tombstoned {
+		return resultGone(surrogateKey, override.TombstoneReason)
+	}
+
+	if errors.Is(err, errNotFound) {
+		return results.NewGetResultNotFound().
+			WithSurrogateKey(surrogateKey).
+			WithSurrogateControl(fastlyTTL).
+			WithCacheControl(browserCacheTTL)
+	}
+	if err == nil {
+		age := res.age(time.Now())
+		if params.MaxAge != nil && age > *params.MaxAge {
+			// Publishing a newer result purges this response.
+			return results.NewGetResultUnprocessableEntity().
+				WithXScorecardSource(res.source).
+				WithXScorecardAge(age).
+				WithSurrogateKey(surrogateKey).
 				WithSurrogateControl(fastlyTTL).
-				WithCacheControl(browserCacheTTL)
+				WithCacheControl(browserCacheTTL).
+				WithPayload(&models.Error{
+					Code: http.StatusUnprocessableEntity,
+					Message: fmt.Sprintf("the latest %s result is %d seconds old, more than maxAge %d",
+						res.source, age, *params.MaxAge),
+				})
+		}
+		h := resultHeaders{
+			etag:             res.etag(representation(params)),
+			lastModified:     res.modTime.UTC().Format(http.TimeFormat),
+			surrogateKey:     surrogateKey,
+			surrogateControl: fastlyTTL,
+			cacheControl:     browserCacheTTL,
+			source:           res.source,
+			annotation:       override.Annotation,
+			age:              age,
+		}
+		if params.MaxAge != nil {
+			// The response must not be served once the result gets older than maxAge.
+			ttl := fmt.Sprintf("max-age=%d", *params.MaxAge-age)
+			h.surrogateControl, h.cacheControl = ttl, ttl
+		}
+		if notModified(params.IfNoneMatch, params.IfModifiedSince, h.etag, res.modTime) {
+			return results.NewGetResultNotModified().
+				WithETag(h.etag).
+				WithLastModified(h.lastModified).
+				WithXScorecardSource(h.source).
+				WithXScorecardAge(h.age).
+				WithXScorecardAnnotation(h.annotation).
+				WithSurrogateKey(h.surrogateKey).
+				WithSurrogateControl(h.surrogateControl).
+				WithCacheControl(h.cacheControl)
+		}
+		if params.Commit == nil {
+			override, err = resolveOverride(ctx, store, res, params.Platform, params.Org, params.Repo, "")
+			if err == nil && override.Tombstoned {
+				return resultGone(surrogateKey, override.TombstoneReason)
+			}
+			h.annotation = override.Annotation
+		}
+
+		var b []byte
+		if err == nil {
+			b, err = res.read(ctx)
+		}
+		if err == nil {
+			var ret models.ScorecardResult
+			if err = ret.UnmarshalBinary(b); err == nil {
+				switch representation(params) {
+				case formatSARIF:
+					return jsonResponder(sarifContentType, toSARIF(&ret), h)
+				case formatProbe:
+					if len(ret.Findings) == 0 {
+						return results.NewGetResultNotFound().
+							WithSurrogateKey(surrogateKey).
+							WithSurrogateControl(fastlyTTL).
+							WithCacheControl(browserCacheTTL)
+					}
+					return jsonResponder(runtime.JSONMime, toProbeResult(&ret), h)
+				}
+				// The default representation keeps the legacy checks shape.
+				ret.Findings = nil
+				if swag.BoolValue(params.Envelope) {
+					return jsonResponder(runtime.JSONMime, &models.ResultEnvelope{
+						Source:       res.source,
+						LastModified: strfmt.DateTime(res.modTime.UTC()),
+						Age:          age,
+						Result:       &ret,
+					}, h)
+				}
+				return results.NewGetResultOK().WithPayload(&ret).
+					WithETag(h.etag).
+					WithLastModified(h.lastModified).
+					WithXScorecardSource(h.source).
+					WithXScorecardAge(h.age).
+					WithXScorecardAnnotation(h.annotation).
+					WithSurrogateKey(h.surrogateKey).
+					WithSurrogateControl(h.surrogateControl).
+					WithCacheControl(h.cacheControl)
+			}
 		}
 	}
 
@@ -69,35 +203,270 @@ func GetResultHandler(params results.GetResultParams) middleware.Responder {
 	})
 }
 
-func getResults(host, orgName, repoName string, commit *string) ([]byte, error) {
-	// Sanitize input and log query.
-	cleanResultsFile, err := sanitizeInputs(host, orgName, repoName, commit)
+// resultGone is the response for a result which was taken down, for reason.
+func resultGone(surrogateKey, reason string) middleware.Responder {
+	// Restoring the result purges this response.
+	return results.NewGetResultGone().
+		WithSurrogateKey(surrogateKey).
+		WithSurrogateControl(fastlyTTL).
+		WithCacheControl(browserCacheTTL).
+		WithPayload(&models.Error{
+			Code:    http.StatusGone,
+			Message: reason,
+		})
+}
+
+// resultHeaders are the caching and freshness headers sent with every representation of a result.
+type resultHeaders struct {
+	etag             string
+	lastModified     string
+	surrogateKey     string
+	surrogateControl string
+	cacheControl     string
+	source           string
+	// annotation is the admins' note on the result, if any.
+	annotation string
+	age        int64
+}
+
+// repoSurrogateKey is the Fastly surrogate key attached to every cached response about a repository,
+// so a single purge invalidates all of them (results, commits, formats and badges).
+// Repository names are case-insensitive, so the key is too.
+func repoSurrogateKey(host, orgName, repoName string) string {
+	return strings.ToLower(fmt.Sprintf("repo:%s/%s/%s", host, orgName, repoName))
+}
+
+// toProbeResult converts the result into the probe shape, as output by scorecard --format=probe.
+func toProbeResult(result *models.ScorecardResult) *models.ScorecardProbeResult {
+	return &models.ScorecardProbeResult{
+		Date:      result.Date,
+		Repo:      result.Repo,
+		Scorecard: result.Scorecard,
+		Findings:  result.Findings,
+		Metadata:  result.Metadata,
+	}
+}
+
+// jsonResponder writes an alternative JSON representation of the result, such as a SARIF log,
+// with the same headers as the JSON result.
+func jsonResponder(contentType string, payload any, h resultHeaders) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, producer runtime.Producer) {
+		rw.Header().Set("Content-Type", contentType)
+		rw.Header().Set("Surrogate-Control", h.surrogateControl)
+		rw.Header().Set("Cache-Control", h.cacheControl)
+		if h.etag != "" {
+			rw.Header().Set("ETag", h.etag)
+		}
+		rw.Header().Set("Last-Modified", h.lastModified)
+		rw.Header().Set("Surrogate-Key", h.surrogateKey)
+		rw.Header().Set("X-Scorecard-Source", h.source)
+		rw.Header().Set("X-Scorecard-Age", strconv.FormatInt(h.age, 10))
+		if h.annotation != "" {
+			rw.Header().Set("X-Scorecard-Annotation", h.annotation)
+		}
+		rw.WriteHeader(http.StatusOK)
+		// These representations are always JSON, regardless of the negotiated producer.
+		if err := json.NewEncoder(rw).Encode(payload); err != nil {
+			log.Printf("error writing %s response: %v", contentType, err)
+		}
+	})
+}
+
+// storedResult is a results.json blob which has been located, but not necessarily read yet.
+// Keeping the read separate lets conditional requests be answered from the blob attributes alone.
+type storedResult struct {
+	bucket  *blob.Bucket
+	key     string
+	md5     []byte
+	etagRaw string
+	modTime time.Time
+	// source is IndexSourceAction or IndexSourceCron, depending on the bucket.
+	source string
+	// data is the result once read.
+	data []byte
+}
+
+func (r *storedResult) read(ctx context.Context) ([]byte, error) {
+	if r.data != nil {
+		return r.data, nil
+	}
+	b, err := r.bucket.ReadAll(ctx, r.key)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("bucket.ReadAll: %w", err)
 	}
-	log.Printf("Querying GCS bucket for: %s", cleanResultsFile)
+	r.data = b
+	return b, nil
+}
+
+// commit returns the commit the result is for.
+func (r *storedResult) commit(ctx context.Context) (string, error) {
+	b, err := r.read(ctx)
+	if err != nil {
+		return "", err
+	}
+	var result struct {
+		Repo struct {
+			Commit string `json:"commit"`
+		} `json:"repo"`
+	}
+	if err := json.Unmarshal(b, &result); err != nil {
+		return "", fmt.Errorf("json.Unmarshal: %w", err)
+	}
+	return result.Repo.Commit, nil
+}
+
+// age is the number of seconds since the result was written, as of now.
+func (r *storedResult) age(now time.Time) int64 {
+	if r.modTime.IsZero() || now.Before(r.modTime) {
+		return 0
+	}
+	return int64(now.Sub(r.modTime) / time.Second)
+}
+
+func (r *storedResult) Close() error {
+	return r.bucket.Close()
+}
 
-	// Query GCS bucket.
-	ctx := context.Background()
-	if bucket, err := blob.OpenBucket(ctx, scorecardResultBucketURL); err == nil {
-		if results, err := bucket.ReadAll(ctx, cleanResultsFile); err == nil {
-			return results, nil
+// etag returns a strong validator for a given representation of the result.
+// It's derived from the blob's MD5 when the bucket provides one, falling back to the bucket's own ETag
+// (the object generation for GCS). Non-JSON representations get a suffix, as their bytes differ.
+func (r *storedResult) etag(variant string) string {
+	tag := hex.EncodeToString(r.md5)
+	if tag == "" {
+		tag = strings.Trim(strings.TrimPrefix(r.etagRaw, "W/"), `"`)
+	}
+	if tag == "" {
+		return ""
+	}
+	if variant != "" {
+		tag += "-" + variant
+	}
+	return `"` + tag + `"`
+}
+
+// representation identifies which encoding of the result the request will get, so each has its own ETag.
+// JSON is the default representation and has no suffix.
+func representation(params results.GetResultParams) string {
+	if params.Format != nil && (*params.Format == formatSARIF || *params.Format == formatProbe) {
+		return *params.Format
+	}
+	if swag.BoolValue(params.Envelope) {
+		return "envelope"
+	}
+	if params.HTTPRequest == nil {
+		return ""
+	}
+	var offers []string
+	if route := middleware.MatchedRouteFrom(params.HTTPRequest); route != nil {
+		offers = route.Produces
+	}
+	mediaType := middleware.NegotiateContentType(params.HTTPRequest, offers, runtime.JSONMime)
+	if mediaType == "" || mediaType == runtime.JSONMime {
+		return ""
+	}
+	_, subtype, _ := strings.Cut(mediaType, "/")
+	return subtype
+}
+
+// acceptsJSON reports whether the request's Accept header, if any, accepts JSON.
+func acceptsJSON(r *http.Request) bool {
+	if r == nil || r.Header.Get(runtime.HeaderAccept) == "" {
+		return true
+	}
+	return middleware.NegotiateContentType(r, []string{runtime.JSONMime}, "") != ""
+}
+
+// notModified evaluates If-None-Match and If-Modified-Since as described in RFC 9110, section 13.
+// If-Modified-Since is only considered when If-None-Match isn't sent.
+func notModified(ifNoneMatch, ifModifiedSince *string, etag string, modTime time.Time) bool {
+	if ifNoneMatch != nil && strings.TrimSpace(*ifNoneMatch) != "" {
+		if etag == "" {
+			return false
+		}
+		for _, candidate := range strings.Split(*ifNoneMatch, ",") {
+			candidate = strings.TrimSpace(candidate)
+			// If-None-Match uses the weak comparison function.
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+				return true
+			}
 		}
+		return false
 	}
+	if ifModifiedSince != nil && *ifModifiedSince != "" && !modTime.IsZero() {
+		since, err := http.ParseTime(*ifModifiedSince)
+		if err != nil {
+			return false
+		}
+		// HTTP dates have second granularity.
+		return !modTime.Truncate(time.Second).After(since)
+	}
+	return false
+}
+
+// resultSource is a bucket results are served from.
+type resultSource struct {
+	bucketURL string
+	// name is IndexSourceAction or IndexSourceCron.
+	name string
+}
+
+// resultSources lists the action's results first, so they win ties.
+var resultSources = []resultSource{
+	{bucketURL: scorecardResultBucketURL, name: IndexSourceAction},
+	{bucketURL: scorecardCronResultBucketURL, name: IndexSourceCron},
+}
 
-	cleanResultsFile2, err := sanitizeInputs(host, orgName, repoName, commit)
+// lookupResult finds the results file for the repository in the results and weekly cron buckets.
+// If source is set, only that source is considered.
+func lookupResult(ctx context.Context, host, orgName, repoName string, commit *string, source string,
+) (*storedResult, error) {
+	// Sanitize input and log query.
+	cleanResultsFile, err := sanitizeInputs(host, orgName, repoName, commit)
 	if err != nil {
 		return nil, err
 	}
+	log.Printf("Querying GCS bucket for: %s", cleanResultsFile)
+	return findResult(ctx, resultSources, cleanResultsFile, source)
+}
 
-	// Try the backup cron bucket.
-	if bucket, err := blob.OpenBucket(ctx, scorecardCronResultBucketURL); err == nil {
-		if results, err := bucket.ReadAll(ctx, cleanResultsFile2); err == nil {
-			return results, nil
+// findResult returns the most recently written of the sources' results files, so a project
+// which stopped running the Scorecard action still gets its newer cron results.
+func findResult(ctx context.Context, sources []resultSource, key, source string) (*storedResult, error) {
+	var newest *storedResult
+	for _, s := range sources {
+		if source != "" && source != s.name {
+			continue
+		}
+		bucket, err := blob.OpenBucket(ctx, s.bucketURL)
+		if err != nil {
+			continue
+		}
+		attrs, err := bucket.Attributes(ctx, key)
+		if err != nil {
+			bucket.Close()
+			continue
+		}
+		if newest != nil && !attrs.ModTime.After(newest.modTime) {
+			bucket.Close()
+			continue
+		}
+		if newest != nil {
+			newest.Close()
+		}
+		newest = &storedResult{
+			bucket:  bucket,
+			key:     key,
+			md5:     attrs.MD5,
+			etagRaw: attrs.ETag,
+			modTime: attrs.ModTime,
+			source:  s.name,
 		}
 	}
 
-	return nil, errNotFound
+	if newest == nil {
+		return nil, errNotFound
+	}
+	return newest, nil
 }
 
 func sanitizeInputs(host, orgName, repoName string, commit *string) (string, error) {
@@ -15,10 +15,24 @@
 package server
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/google/go-cmp/cmp"
+	_ "gocloud.dev/blob/fileblob" // Needed for local buckets.
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+	"github.com/ossf/scorecard-webapp/app/generated/restapi/operations/results"
 )
 
 func TestSanitizePath(t *testing.T) {
@@ -83,3 +97,355 @@ func TestSanitizePath(t *testing.T) {
 		})
 	}
 }
+
+func TestStoredResultETag(t *testing.T) {
+	t.Parallel()
+	md5 := []byte{0xde, 0xad, 0xbe, 0xef}
+	testcases := []struct {
+		name    string
+		result  storedResult
+		variant string
+		want    string
+	}{
+		{
+			name:   "md5",
+			result: storedResult{md5: md5, etagRaw: `"12345"`},
+			want:   `"deadbeef"`,
+		},
+		{
+			name:    "md5 with variant",
+			result:  storedResult{md5: md5},
+			variant: "sarif",
+			want:    `"deadbeef-sarif"`,
+		},
+		{
+			name:   "falls back to bucket etag",
+			result: storedResult{etagRaw: `W/"12345"`},
+			want:   `"12345"`,
+		},
+		{
+			name:   "no validator",
+			result: storedResult{},
+			want:   "",
+		},
+	}
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.result.etag(tt.variant); got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNotModified(t *testing.T) {
+	t.Parallel()
+	etag := `"deadbeef"`
+	modTime := time.Date(2024, 5, 1, 12, 0, 0, 500, time.UTC)
+	str := func(s string) *string { return &s }
+	testcases := []struct {
+		name            string
+		ifNoneMatch     *string
+		ifModifiedSince *string
+		etag            string
+		want            bool
+	}{
+		{
+			name: "no conditions",
+			etag: etag,
+			want: false,
+		},
+		{
+			name:        "matching etag",
+			ifNoneMatch: str(etag),
+			etag:        etag,
+			want:        true,
+		},
+		{
+			name:        "matching weak etag in list",
+			ifNoneMatch: str(`"other", W/"deadbeef"`),
+			etag:        etag,
+			want:        true,
+		},
+		{
+			name:        "wildcard",
+			ifNoneMatch: str("*"),
+			etag:        etag,
+			want:        true,
+		},
+		{
+			name:        "different etag",
+			ifNoneMatch: str(`"other"`),
+			etag:        etag,
+			want:        false,
+		},
+		{
+			name:            "if-none-match takes precedence",
+			ifNoneMatch:     str(`"other"`),
+			ifModifiedSince: str("Wed, 01 May 2024 12:00:00 GMT"),
+			etag:            etag,
+			want:            false,
+		},
+		{
+			name:            "not modified since",
+			ifModifiedSince: str("Wed, 01 May 2024 12:00:00 GMT"),
+			etag:            etag,
+			want:            true,
+		},
+		{
+			name:            "modified since",
+			ifModifiedSince: str("Wed, 01 May 2024 11:59:59 GMT"),
+			etag:            etag,
+			want:            false,
+		},
+		{
+			name:            "invalid date",
+			ifModifiedSince: str("yesterday"),
+			etag:            etag,
+			want:            false,
+		},
+	}
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := notModified(tt.ifNoneMatch, tt.ifModifiedSince, tt.etag, modTime); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRepresentation(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		format   *string
+		want     string
+		envelope bool
+	}{
+		{format: nil, want: ""},
+		{format: swag.String("json"), want: ""},
+		{format: swag.String("sarif"), want: "sarif"},
+		{format: swag.String("probe"), want: "probe"},
+		{format: swag.String("json"), envelope: true, want: "envelope"},
+	}
+	for _, tt := range testcases {
+		params := results.GetResultParams{Format: tt.format, Envelope: swag.Bool(tt.envelope)}
+		if got := representation(params); got != tt.want {
+			t.Errorf("representation(%v, %v) = %q, want %q", swag.StringValue(tt.format), tt.envelope, got, tt.want)
+		}
+	}
+}
+
+func TestAcceptsJSON(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		accept string
+		want   bool
+	}{
+		{accept: "", want: true},
+		{accept: "*/*", want: true},
+		{accept: "application/json", want: true},
+		{accept: "text/csv, application/json;q=0.5", want: true},
+		{accept: "application/yaml", want: false},
+		{accept: "text/markdown, text/csv", want: false},
+	}
+	for _, tt := range testcases {
+		r := httptest.NewRequest(http.MethodGet, "/projects/github.com/org/repo?format=sarif", nil)
+		if tt.accept != "" {
+			r.Header.Set("Accept", tt.accept)
+		}
+		if got := acceptsJSON(r); got != tt.want {
+			t.Errorf("acceptsJSON(%q) = %t, want %t", tt.accept, got, tt.want)
+		}
+	}
+}
+
+func TestGetResultHandler_notAcceptable(t *testing.T) {
+	t.Parallel()
+	r := httptest.NewRequest(http.MethodGet, "/projects/github.com/org/repo?format=sarif", nil)
+	r.Header.Set("Accept", "application/yaml")
+	params := results.GetResultParams{
+		HTTPRequest: r, Platform: "github.com", Org: "org", Repo: "repo", Format: swag.String(formatSARIF),
+	}
+	if got, ok := GetResultHandler(params).(*results.GetResultNotAcceptable); !ok || got.CacheControl != "no-store" {
+		t.Errorf("GetResultHandler() = %#v, want an uncached 406", got)
+	}
+}
+
+func TestToProbeResult(t *testing.T) {
+	t.Parallel()
+	// Trimmed output of scorecard v5 with both checks and probe findings.
+	data := []byte(`{
+		"date": "2024-06-01",
+		"repo": {"name": "github.com/ossf/scorecard", "commit": "a9711caf8ecbe3c035c6bbe3f3bdab8ccc78093b"},
+		"scorecard": {"version": "v5.0.0", "commit": "70d045b9ef00e7171ce3950aca38eef6ea4d7308"},
+		"score": 8,
+		"checks": [{"name": "Dangerous-Workflow", "score": 0, "reason": "dangerous workflow patterns detected"}],
+		"findings": [{
+			"probe": "hasDangerousWorkflowScriptInjection",
+			"outcome": "True",
+			"message": "script injection with untrusted input 'github.event.issue.title'",
+			"location": {"type": 1, "path": ".github/workflows/triage.yml", "lineStart": 12, "snippet": "run: echo"},
+			"remediation": {"text": "Avoid untrusted input", "markdown": "Avoid *untrusted* input", "effort": 2},
+			"values": {"job": "triage"}
+		}]
+	}`)
+	var result models.ScorecardResult
+	if err := result.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+	if err := result.Validate(strfmt.Default); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+
+	want := &models.ScorecardProbeResult{
+		Date:      "2024-06-01",
+		Repo:      result.Repo,
+		Scorecard: result.Scorecard,
+		Findings: []*models.ProbeFinding{{
+			Probe:   "hasDangerousWorkflowScriptInjection",
+			Outcome: models.ProbeFindingOutcomeTrue,
+			Message: "script injection with untrusted input 'github.event.issue.title'",
+			Location: &models.FindingLocation{
+				Type: 1, Path: ".github/workflows/triage.yml", LineStart: 12, Snippet: "run: echo",
+			},
+			Remediation: &models.FindingRemediation{
+				Text: "Avoid untrusted input", Markdown: "Avoid *untrusted* input", Effort: 2,
+			},
+			Values: map[string]string{"job": "triage"},
+		}},
+	}
+	if diff := cmp.Diff(want, toProbeResult(&result)); diff != "" {
+		t.Errorf("toProbeResult() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestStoredResultAge(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	testcases := []struct {
+		name    string
+		modTime time.Time
+		want    int64
+	}{
+		{name: "old", modTime: now.Add(-90*time.Minute - 500*time.Millisecond), want: 5400},
+		{name: "clock skew", modTime: now.Add(time.Minute), want: 0},
+		{name: "unknown", want: 0},
+	}
+	for _, tt := range testcases {
+		r := storedResult{modTime: tt.modTime}
+		if got := r.age(now); got != tt.want {
+			t.Errorf("%s: age() = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestJSONResponder(t *testing.T) {
+	t.Parallel()
+	h := resultHeaders{
+		etag:             `"abc-envelope"`,
+		lastModified:     "Fri, 01 Mar 2024 12:00:00 GMT",
+		surrogateKey:     "repo:github.com/org/repo",
+		surrogateControl: "max-age=60",
+		cacheControl:     "max-age=60",
+		source:           "cron",
+		annotation:       "results under review",
+		age:              42,
+	}
+	envelope := &models.ResultEnvelope{Source: "cron", Age: 42, Result: &models.ScorecardResult{Score: 5}}
+	rec := httptest.NewRecorder()
+	jsonResponder(runtime.JSONMime, envelope, h).WriteResponse(rec, nil)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	for header, want := range map[string]string{
+		"Content-Type":           runtime.JSONMime,
+		"ETag":                   h.etag,
+		"Surrogate-Control":      "max-age=60",
+		"X-Scorecard-Source":     "cron",
+		"X-Scorecard-Age":        "42",
+		"X-Scorecard-Annotation": "results under review",
+	} {
+		if got := rec.Header().Get(header); got != want {
+			t.Errorf("%s = %q, want %q", header, got, want)
+		}
+	}
+	var got models.ResultEnvelope
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if got.Source != "cron" || got.Age != 42 || got.Result == nil || got.Result.Score != 5 {
+		t.Errorf("envelope = %+v", got)
+	}
+}
+
+func TestFindResult(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const key = "github.com/org/repo/results.json"
+	now := time.Now()
+	source := func(t *testing.T, name string, modTime time.Time) resultSource {
+		t.Helper()
+		dir := t.TempDir()
+		if !modTime.IsZero() {
+			path := filepath.Join(dir, filepath.FromSlash(key))
+			if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+				t.Fatalf("os.MkdirAll: %v", err)
+			}
+			if err := os.WriteFile(path, []byte(`{"date":"2024-01-01"}`), 0o600); err != nil {
+				t.Fatalf("os.WriteFile: %v", err)
+			}
+			if err := os.Chtimes(path, modTime, modTime); err != nil {
+				t.Fatalf("os.Chtimes: %v", err)
+			}
+		}
+		return resultSource{bucketURL: "file://" + filepath.ToSlash(dir), name: name}
+	}
+	older, newer := now.Add(-90*24*time.Hour), now.Add(-24*time.Hour)
+
+	testcases := []struct {
+		name        string
+		actionTime  time.Time
+		cronTime    time.Time
+		source      string
+		wantSource  string
+		wantMissing bool
+	}{
+		{name: "newer action result", actionTime: newer, cronTime: older, wantSource: "action"},
+		{name: "newer cron result", actionTime: older, cronTime: newer, wantSource: "cron"},
+		{name: "action wins ties", actionTime: newer, cronTime: newer, wantSource: "action"},
+		{name: "only cron", cronTime: older, wantSource: "cron"},
+		{name: "forced action", actionTime: older, cronTime: newer, source: "action", wantSource: "action"},
+		{name: "forced cron", actionTime: newer, cronTime: older, source: "cron", wantSource: "cron"},
+		{name: "forced missing source", actionTime: newer, source: "cron", wantMissing: true},
+		{name: "missing", wantMissing: true},
+	}
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			sources := []resultSource{
+				source(t, IndexSourceAction, tt.actionTime),
+				source(t, IndexSourceCron, tt.cronTime),
+			}
+			got, err := findResult(ctx, sources, key, tt.source)
+			if tt.wantMissing {
+				if !errors.Is(err, errNotFound) {
+					t.Errorf("findResult() = %v, want errNotFound", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("findResult: %v", err)
+			}
+			defer got.Close()
+			if got.source != tt.wantSource {
+				t.Errorf("source = %q, want %q", got.source, tt.wantSource)
+			}
+			if _, err := got.read(ctx); err != nil {
+				t.Errorf("read: %v", err)
+			}
+		})
+	}
+}
@@ -19,11 +19,19 @@ import (
 	"net/http"
 )
 
+// githubTransport authenticates GitHub API requests with the token, sending them through base,
+// or http.DefaultTransport if nil.
 type githubTransport struct {
+	base  http.RoundTripper
 	token string
 }
 
 func (transport githubTransport) RoundTrip(r *http.Request) (*http.Response, error) {
-	r.Header.Add("Authorization", fmt.Sprintf("Bearer %s", transport.token))
-	return http.DefaultTransport.RoundTrip(r)
+	// A RoundTripper mustn't modify the caller's request.
+	r = r.Clone(r.Context())
+	r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", transport.token))
+	if transport.base == nil {
+		return http.DefaultTransport.RoundTrip(r)
+	}
+	return transport.base.RoundTrip(r)
 }
@@ -20,26 +20,51 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 )
 
-var errPurgeFailed = errors.New("purge failed")
+const fastlyAPIURL = "https://api.fastly.com"
+
+// ErrKeysUnsupported is returned by the purgers which aren't configured to purge surrogate keys.
+var ErrKeysUnsupported = errors.New("surrogate key purging isn't configured")
+
+var (
+	errPurgeFailed   = errors.New("purge failed")
+	errNoServiceID   = fmt.Errorf("%w: no Fastly service ID", ErrKeysUnsupported)
+	errNoCacheTags   = fmt.Errorf("%w: Cloudflare tag purging not enabled", ErrKeysUnsupported)
+	errEmptyPurgeKey = errors.New("empty surrogate key")
+)
 
 // Purger is the interface for purging URLs from a CDN.
 type Purger interface {
 	Purge(ctx context.Context, url string) error
+	// PurgeKey purges every cached response tagged with the given surrogate key.
+	PurgeKey(ctx context.Context, key string) error
 }
 
 // FastlyClient implements Purger for Fastly.
 type FastlyClient struct {
-	token   string
-	baseURL string
+	client    *http.Client
+	token     string
+	baseURL   string
+	apiURL    string
+	serviceID string
 }
 
 // NewFastlyClient creates a new FastlyClient.
-func NewFastlyClient(token, baseURL string) *FastlyClient {
+// serviceID is only needed for surrogate key purges and may be empty.
+func NewFastlyClient(token, baseURL, serviceID string) *FastlyClient {
 	baseURL = strings.TrimSuffix(baseURL, "/")
-	return &FastlyClient{token: token, baseURL: baseURL}
+	return &FastlyClient{
+		client: http.DefaultClient, token: token, baseURL: baseURL, apiURL: fastlyAPIURL, serviceID: serviceID,
+	}
+}
+
+// WithHTTPClient sets the client purge requests are sent with, http.DefaultClient by default.
+func (c *FastlyClient) WithHTTPClient(client *http.Client) *FastlyClient {
+	c.client = client
+	return c
 }
 
 // Purge purges the given URL from Fastly.
@@ -50,8 +75,31 @@ func (c *FastlyClient) Purge(ctx context.Context, path string) error {
 		return fmt.Errorf("http.NewRequest: %w", err)
 	}
 	req.Header.Set("Fastly-Key", c.token)
+	return doPurge(c.client, req)
+}
+
+// PurgeKey purges all responses tagged with the surrogate key from Fastly.
+// It sends a POST request to the service's purge endpoint with the Fastly-Key header.
+// See https://www.fastly.com/documentation/reference/api/purging/#purge-tag
+func (c *FastlyClient) PurgeKey(ctx context.Context, key string) error {
+	if c.serviceID == "" {
+		return errNoServiceID
+	}
+	if key == "" {
+		return errEmptyPurgeKey
+	}
+	endpoint := fmt.Sprintf("%s/service/%s/purge/%s", c.apiURL, url.PathEscape(c.serviceID), url.PathEscape(key))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("http.NewRequest: %w", err)
+	}
+	req.Header.Set("Fastly-Key", c.token)
+	return doPurge(c.client, req)
+}
 
-	resp, err := http.DefaultClient.Do(req)
+// doPurge sends a purge request, treating anything but 200 OK as a failure.
+func doPurge(client *http.Client, req *http.Request) error {
+	resp, err := client.Do(req)
 	if err != nil {
 		return fmt.Errorf("http.Do: %w", err)
 	}
@@ -75,3 +123,8 @@ func NewNoOpClient() *NoOpClient {
 func (c *NoOpClient) Purge(ctx context.Context, url string) error {
 	return nil
 }
+
+// PurgeKey does nothing.
+func (c *NoOpClient) PurgeKey(ctx context.Context, key string) error {
+	return nil
+}
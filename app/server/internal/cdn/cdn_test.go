@@ -16,6 +16,7 @@ package cdn
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -35,7 +36,7 @@ func TestFastlyClient_Purge(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 	}))
 	defer server.Close()
-	client := NewFastlyClient(token, server.URL)
+	client := NewFastlyClient(token, server.URL, "")
 	if err := client.Purge(context.Background(), "/foo"); err != nil {
 		t.Errorf("unexpected error: %v", err)
 	}
@@ -47,8 +48,39 @@ func TestFastlyClient_Purge_Error(t *testing.T) {
 		w.WriteHeader(http.StatusInternalServerError)
 	}))
 	defer server.Close()
-	client := NewFastlyClient(token, server.URL)
+	client := NewFastlyClient(token, server.URL, "")
 	if err := client.Purge(context.Background(), "/foo"); err == nil {
 		t.Error("expected error, got nil")
 	}
 }
+
+func TestFastlyClient_PurgeKey(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected method POST, got %s", r.Method)
+		}
+		if r.Header.Get("Fastly-Key") != token {
+			t.Errorf("expected Fastly-Key header %s, got %s", token, r.Header.Get("Fastly-Key"))
+		}
+		want := "/service/svc123/purge/repo:github.com%2Forg%2Frepo"
+		if r.URL.EscapedPath() != want {
+			t.Errorf("expected path %s, got %s", want, r.URL.EscapedPath())
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	client := NewFastlyClient(token, "https://api.example.com", "svc123")
+	client.apiURL = server.URL
+	if err := client.PurgeKey(context.Background(), "repo:github.com/org/repo"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestFastlyClient_PurgeKey_NoServiceID(t *testing.T) {
+	t.Parallel()
+	client := NewFastlyClient(token, "https://api.example.com", "")
+	if err := client.PurgeKey(context.Background(), "repo:github.com/org/repo"); !errors.Is(err, errNoServiceID) {
+		t.Errorf("expected %v, got %v", errNoServiceID, err)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdn
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const cloudflareAPIURL = "https://api.cloudflare.com/client/v4"
+
+// CloudflareClient implements Purger for Cloudflare, purging from a single zone.
+// Tag purges match the Cache-Tag header, which the origin doesn't set, so they're
+// only sent once enabled with WithTagPurging.
+type CloudflareClient struct {
+	client  *http.Client
+	token   string
+	zoneID  string
+	baseURL string
+	apiURL  string
+	tags    bool
+}
+
+// NewCloudflareClient creates a new CloudflareClient.
+// baseURL is the public URL the zone serves, used to build the URLs to purge.
+func NewCloudflareClient(token, zoneID, baseURL string) *CloudflareClient {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	return &CloudflareClient{
+		client: http.DefaultClient, token: token, zoneID: zoneID, baseURL: baseURL, apiURL: cloudflareAPIURL,
+	}
+}
+
+// WithHTTPClient sets the client purge requests are sent with, http.DefaultClient by default.
+func (c *CloudflareClient) WithHTTPClient(client *http.Client) *CloudflareClient {
+	c.client = client
+	return c
+}
+
+// WithTagPurging enables purging surrogate keys as cache tags, for zones where an edge rule
+// mirrors the Surrogate-Key header into Cache-Tag. Without it, PurgeKey returns ErrKeysUnsupported.
+func (c *CloudflareClient) WithTagPurging() *CloudflareClient {
+	c.tags = true
+	return c
+}
+
+type cloudflarePurgeRequest struct {
+	Files []string `json:"files,omitempty"`
+	Tags  []string `json:"tags,omitempty"`
+}
+
+type cloudflareResponse struct {
+	Errors []struct {
+		Message string `json:"message"`
+		Code    int    `json:"code"`
+	} `json:"errors"`
+	Success bool `json:"success"`
+}
+
+// Purge purges the given path from the zone.
+// See https://developers.cloudflare.com/api/operations/zone-purge
+func (c *CloudflareClient) Purge(ctx context.Context, path string) error {
+	return c.purge(ctx, cloudflarePurgeRequest{Files: []string{c.baseURL + path}})
+}
+
+// PurgeKey purges all responses tagged with the cache tag from the zone.
+func (c *CloudflareClient) PurgeKey(ctx context.Context, key string) error {
+	if !c.tags {
+		return errNoCacheTags
+	}
+	if key == "" {
+		return errEmptyPurgeKey
+	}
+	return c.purge(ctx, cloudflarePurgeRequest{Tags: []string{key}})
+}
+
+func (c *CloudflareClient) purge(ctx context.Context, body cloudflarePurgeRequest) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+	endpoint := fmt.Sprintf("%s/zones/%s/purge_cache", c.apiURL, url.PathEscape(c.zoneID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("http.NewRequest: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("http.Do: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result cloudflareResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil && resp.StatusCode == http.StatusOK {
+		return fmt.Errorf("decoding Cloudflare response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK || !result.Success {
+		if len(result.Errors) > 0 {
+			return fmt.Errorf("%w: %s: %d %s", errPurgeFailed, resp.Status, result.Errors[0].Code, result.Errors[0].Message)
+		}
+		return fmt.Errorf("%w: %s", errPurgeFailed, resp.Status)
+	}
+	return nil
+}
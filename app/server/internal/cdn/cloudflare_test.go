@@ -0,0 +1,120 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdn
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCloudflareClient(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		purge   func(ctx context.Context, c *CloudflareClient) error
+		want    cloudflarePurgeRequest
+		name    string
+		status  int
+		resp    string
+		wantErr bool
+	}{
+		{
+			name: "purge url",
+			purge: func(ctx context.Context, c *CloudflareClient) error {
+				return c.Purge(ctx, "/projects/github.com/org/repo")
+			},
+			want:   cloudflarePurgeRequest{Files: []string{"https://api.example.com/projects/github.com/org/repo"}},
+			status: http.StatusOK,
+			resp:   `{"success":true,"errors":[]}`,
+		},
+		{
+			name: "purge tag",
+			purge: func(ctx context.Context, c *CloudflareClient) error {
+				return c.PurgeKey(ctx, "repo:github.com/org/repo")
+			},
+			want:   cloudflarePurgeRequest{Tags: []string{"repo:github.com/org/repo"}},
+			status: http.StatusOK,
+			resp:   `{"success":true,"errors":[]}`,
+		},
+		{
+			name: "api error",
+			purge: func(ctx context.Context, c *CloudflareClient) error {
+				return c.PurgeKey(ctx, "key")
+			},
+			want:    cloudflarePurgeRequest{Tags: []string{"key"}},
+			status:  http.StatusBadRequest,
+			resp:    `{"success":false,"errors":[{"code":1012,"message":"Request must contain one of \"purge_everything\", \"files\", \"tags\""}]}`,
+			wantErr: true,
+		},
+		{
+			name: "unsuccessful with 200",
+			purge: func(ctx context.Context, c *CloudflareClient) error {
+				return c.Purge(ctx, "/")
+			},
+			want:    cloudflarePurgeRequest{Files: []string{"https://api.example.com/"}},
+			status:  http.StatusOK,
+			resp:    `{"success":false}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("expected method POST, got %s", r.Method)
+				}
+				if r.URL.Path != "/zones/zone123/purge_cache" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				if got := r.Header.Get("Authorization"); got != "Bearer "+token {
+					t.Errorf("unexpected Authorization header %q", got)
+				}
+				var got cloudflarePurgeRequest
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Errorf("decoding request: %v", err)
+				}
+				if diff := cmp.Diff(tt.want, got); diff != "" {
+					t.Errorf("request mismatch (-want +got):\n%s", diff)
+				}
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.resp)) //nolint:errcheck
+			}))
+			defer server.Close()
+			client := NewCloudflareClient(token, "zone123", "https://api.example.com/").WithTagPurging()
+			client.apiURL = server.URL
+			err := tt.purge(context.Background(), client)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err != nil && !errors.Is(err, errPurgeFailed) {
+				t.Errorf("expected %v, got %v", errPurgeFailed, err)
+			}
+		})
+	}
+}
+
+func TestCloudflareClient_PurgeKey_NoTagPurging(t *testing.T) {
+	t.Parallel()
+	client := NewCloudflareClient(token, "zone123", "https://api.example.com")
+	if err := client.PurgeKey(context.Background(), "repo:github.com/org/repo"); !errors.Is(err, ErrKeysUnsupported) {
+		t.Errorf("expected %v, got %v", ErrKeysUnsupported, err)
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdn
+
+import (
+	"context"
+	"errors"
+)
+
+// MultiPurger fans purges out to several CDNs, e.g. when a mirror sits behind a different provider.
+// Every purger is tried, and the errors of those which failed are joined.
+type MultiPurger struct {
+	purgers []Purger
+}
+
+// NewMultiPurger creates a new MultiPurger.
+func NewMultiPurger(purgers ...Purger) *MultiPurger {
+	return &MultiPurger{purgers: purgers}
+}
+
+// Purge purges the URL from every CDN.
+func (m *MultiPurger) Purge(ctx context.Context, url string) error {
+	var errs []error
+	for _, p := range m.purgers {
+		if err := p.Purge(ctx, url); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// PurgeKey purges the surrogate key from every CDN.
+func (m *MultiPurger) PurgeKey(ctx context.Context, key string) error {
+	var errs []error
+	for _, p := range m.purgers {
+		if err := p.PurgeKey(ctx, key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdn
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestMultiPurger(t *testing.T) {
+	t.Parallel()
+	failing := &flakyPurger{failures: 1}
+	working := &flakyPurger{}
+	m := NewMultiPurger(failing, working, NewNoOpClient())
+
+	if err := m.PurgeKey(context.Background(), "key"); !errors.Is(err, errFlaky) {
+		t.Errorf("expected %v, got %v", errFlaky, err)
+	}
+	// The failure doesn't stop the remaining purgers.
+	if len(working.keys) != 1 {
+		t.Errorf("expected key purged by working purger, got %v", working.keys)
+	}
+	if err := m.Purge(context.Background(), "/foo"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(failing.urls) != 1 || len(working.urls) != 1 {
+		t.Errorf("expected url purged by both purgers, got %v and %v", failing.urls, working.urls)
+	}
+}
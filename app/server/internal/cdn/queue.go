@@ -0,0 +1,389 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdn
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	mathrand "math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+
+	"gocloud.dev/blob"
+)
+
+const (
+	pendingPrefix = "pending/"
+	deadPrefix    = "dead/"
+)
+
+// Job is a single purge request. The surrogate keys are purged first, and the URLs are also
+// purged if a key purge fails. The job is only done once every key is purged, or once the
+// URLs are if the purger can't purge keys. Only the keys which failed are retried.
+type Job struct {
+	NextAttempt time.Time `json:"nextAttempt"`
+	Created     time.Time `json:"created"`
+	ID          string    `json:"id"`
+	LastError   string    `json:"lastError,omitempty"`
+	Keys        []string  `json:"keys,omitempty"`
+	URLs        []string  `json:"urls,omitempty"`
+	Attempts    int       `json:"attempts"`
+}
+
+// QueueOptions configures retries for a Queue.
+type QueueOptions struct {
+	// MaxAttempts is the number of attempts before a job is dead-lettered.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry, doubling on each attempt.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between attempts.
+	MaxDelay time.Duration
+}
+
+// DefaultQueueOptions retries for roughly half a day before giving up on a purge.
+var DefaultQueueOptions = QueueOptions{
+	MaxAttempts: 20,
+	BaseDelay:   5 * time.Second,
+	MaxDelay:    time.Hour,
+}
+
+// Queue purges asynchronously, retrying failed purges with exponential backoff.
+// Jobs which keep failing are dead-lettered. If a bucket is provided, pending and
+// dead-lettered jobs are persisted to it, so pending purges survive restarts.
+type Queue struct {
+	purger  Purger
+	bucket  *blob.Bucket
+	wake    chan struct{}
+	cancel  context.CancelFunc
+	pending []*Job
+	opts    QueueOptions
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	active  int
+	dead    int
+}
+
+// NewQueue creates a Queue which purges through purger. bucket may be nil to keep jobs in memory only.
+func NewQueue(purger Purger, bucket *blob.Bucket, opts QueueOptions) *Queue {
+	return &Queue{
+		purger: purger,
+		bucket: bucket,
+		opts:   opts,
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+// Start loads any persisted pending jobs and starts processing in the background.
+func (q *Queue) Start(ctx context.Context) error {
+	if err := q.load(ctx); err != nil {
+		return err
+	}
+	ctx, q.cancel = context.WithCancel(ctx)
+	q.wg.Add(1)
+	go func() {
+		defer q.wg.Done()
+		q.run(ctx)
+	}()
+	return nil
+}
+
+// Close stops processing. Pending jobs stay persisted and are resumed by the next Start.
+func (q *Queue) Close() {
+	if q.cancel != nil {
+		q.cancel()
+	}
+	q.wg.Wait()
+}
+
+// Enqueue schedules a purge of the surrogate keys, falling back to the URLs.
+func (q *Queue) Enqueue(ctx context.Context, keys []string, urls ...string) error {
+	id, err := newJobID()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	job := &Job{
+		ID:          id,
+		Keys:        keys,
+		URLs:        urls,
+		Created:     now,
+		NextAttempt: now,
+	}
+	// A persistence failure shouldn't stop the purge, it only loses durability.
+	err = q.persist(ctx, pendingPrefix, job)
+
+	q.mu.Lock()
+	q.pending = append(q.pending, job)
+	q.mu.Unlock()
+	q.notify()
+	return err
+}
+
+// Backlog returns the number of jobs waiting to be purged, including any in progress.
+func (q *Queue) Backlog() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.pending) + q.active
+}
+
+// DeadLetters returns the number of jobs given up on since the queue was created.
+func (q *Queue) DeadLetters() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.dead
+}
+
+func (q *Queue) notify() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (q *Queue) run(ctx context.Context) {
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+		job, wait := q.next()
+		if job != nil {
+			q.process(ctx, job)
+			continue
+		}
+		var timer <-chan time.Time
+		if wait > 0 {
+			timer = time.After(wait)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.wake:
+		case <-timer:
+		}
+	}
+}
+
+// next pops the earliest due job. If none is due, it returns how long until one is,
+// or zero if the queue is empty.
+func (q *Queue) next() (*Job, time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.pending) == 0 {
+		return nil, 0
+	}
+	earliest := 0
+	for i, job := range q.pending {
+		if job.NextAttempt.Before(q.pending[earliest].NextAttempt) {
+			earliest = i
+		}
+	}
+	job := q.pending[earliest]
+	if wait := time.Until(job.NextAttempt); wait > 0 {
+		return nil, wait
+	}
+	q.pending = append(q.pending[:earliest], q.pending[earliest+1:]...)
+	q.active++
+	return job, 0
+}
+
+func (q *Queue) process(ctx context.Context, job *Job) {
+	err := purgeJob(ctx, q.purger, job)
+	if ctx.Err() != nil {
+		// Shutting down, leave the job for the next Start.
+		q.requeue(job)
+		return
+	}
+	if err == nil {
+		q.finish(ctx, job)
+		return
+	}
+
+	job.Attempts++
+	job.LastError = err.Error()
+	if job.Attempts >= q.opts.MaxAttempts {
+		q.deadLetter(ctx, job)
+		return
+	}
+	job.NextAttempt = time.Now().Add(q.backoff(job.Attempts))
+	if err := q.persist(ctx, pendingPrefix, job); err != nil {
+		log.Printf("error persisting purge job %s: %v", job.ID, err)
+	}
+	q.requeue(job)
+	log.Printf("purge job %s failed (attempt %d/%d), retrying at %s, backlog %d: %v",
+		job.ID, job.Attempts, q.opts.MaxAttempts, job.NextAttempt.Format(time.RFC3339), q.Backlog(), err)
+}
+
+func purgeJob(ctx context.Context, purger Purger, job *Job) error {
+	var errs []error
+	var failed []string
+	unsupported := len(job.Keys) == 0
+	for _, key := range job.Keys {
+		switch err := purger.PurgeKey(ctx, key); {
+		case err == nil:
+		case keysUnsupported(err):
+			unsupported = true
+		default:
+			failed = append(failed, key)
+			errs = append(errs, fmt.Errorf("%s: %w", key, err))
+		}
+	}
+	job.Keys = failed
+	if len(failed) == 0 && !unsupported {
+		return nil
+	}
+	// The URLs are the only purge when keys are unsupported. Otherwise they're purged in the
+	// meantime, but they don't cover every response tagged with the keys, which are retried.
+	for _, u := range job.URLs {
+		if err := purger.Purge(ctx, u); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", u, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// keysUnsupported reports whether err, and every error joined in it, is ErrKeysUnsupported.
+func keysUnsupported(err error) bool {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			if !keysUnsupported(e) {
+				return false
+			}
+		}
+		return true
+	}
+	return errors.Is(err, ErrKeysUnsupported)
+}
+
+// backoff returns an exponential delay with jitter, so retries after an outage are spread out.
+func (q *Queue) backoff(attempts int) time.Duration {
+	delay := q.opts.MaxDelay
+	if shift := attempts - 1; shift < 32 {
+		if d := q.opts.BaseDelay << shift; d > 0 && d < delay {
+			delay = d
+		}
+	}
+	//nolint:gosec // jitter doesn't need a cryptographically secure source.
+	return delay/2 + time.Duration(mathrand.Int64N(int64(delay/2)+1))
+}
+
+func (q *Queue) requeue(job *Job) {
+	q.mu.Lock()
+	q.active--
+	q.pending = append(q.pending, job)
+	q.mu.Unlock()
+}
+
+// finish and deadLetter update the bucket before the backlog, so an empty backlog
+// means the bucket is up to date.
+func (q *Queue) finish(ctx context.Context, job *Job) {
+	if err := q.remove(ctx, pendingPrefix, job); err != nil {
+		log.Printf("error removing purge job %s: %v", job.ID, err)
+	}
+	q.mu.Lock()
+	q.active--
+	q.mu.Unlock()
+}
+
+func (q *Queue) deadLetter(ctx context.Context, job *Job) {
+	log.Printf("purge job %s dead-lettered after %d attempts: %s", job.ID, job.Attempts, job.LastError)
+	if err := q.persist(ctx, deadPrefix, job); err != nil {
+		log.Printf("error persisting dead-lettered purge job %s: %v", job.ID, err)
+	}
+	if err := q.remove(ctx, pendingPrefix, job); err != nil {
+		log.Printf("error removing purge job %s: %v", job.ID, err)
+	}
+	q.mu.Lock()
+	q.active--
+	q.dead++
+	q.mu.Unlock()
+}
+
+func (q *Queue) persist(ctx context.Context, prefix string, job *Job) error {
+	if q.bucket == nil {
+		return nil
+	}
+	b, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+	if err := q.bucket.WriteAll(ctx, prefix+job.ID+".json", b, nil); err != nil {
+		return fmt.Errorf("bucket.WriteAll: %w", err)
+	}
+	return nil
+}
+
+func (q *Queue) remove(ctx context.Context, prefix string, job *Job) error {
+	if q.bucket == nil {
+		return nil
+	}
+	if err := q.bucket.Delete(ctx, prefix+job.ID+".json"); err != nil {
+		return fmt.Errorf("bucket.Delete: %w", err)
+	}
+	return nil
+}
+
+// load restores pending jobs persisted by a previous Queue.
+func (q *Queue) load(ctx context.Context) error {
+	if q.bucket == nil {
+		return nil
+	}
+	iter := q.bucket.List(&blob.ListOptions{Prefix: pendingPrefix})
+	var jobs []*Job
+	for {
+		obj, err := iter.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("listing pending purge jobs: %w", err)
+		}
+		if !strings.HasSuffix(obj.Key, ".json") {
+			continue
+		}
+		b, err := q.bucket.ReadAll(ctx, obj.Key)
+		if err != nil {
+			return fmt.Errorf("reading pending purge job: %w", err)
+		}
+		var job Job
+		if err := json.Unmarshal(b, &job); err != nil {
+			log.Printf("skipping malformed purge job %s: %v", obj.Key, err)
+			continue
+		}
+		jobs = append(jobs, &job)
+	}
+	q.mu.Lock()
+	q.pending = append(q.pending, jobs...)
+	q.mu.Unlock()
+	if len(jobs) > 0 {
+		log.Printf("resuming %d pending purge jobs", len(jobs))
+	}
+	return nil
+}
+
+func newJobID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("rand.Read: %w", err)
+	}
+	// Prefixing with the time keeps persisted jobs listed in submission order.
+	return fmt.Sprintf("%d-%s", time.Now().UnixNano(), hex.EncodeToString(b)), nil
+}
@@ -0,0 +1,322 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdn
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/memblob"
+)
+
+var errFlaky = errors.New("flaky purge")
+
+// flakyPurger fails the first `failures` surrogate key purges.
+type flakyPurger struct {
+	keys     []string
+	urls     []string
+	failures int
+	mu       sync.Mutex
+	keyCalls int
+}
+
+func (f *flakyPurger) Purge(ctx context.Context, url string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.urls = append(f.urls, url)
+	return nil
+}
+
+func (f *flakyPurger) PurgeKey(ctx context.Context, key string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.keyCalls++
+	if f.keyCalls <= f.failures {
+		return errFlaky
+	}
+	f.keys = append(f.keys, key)
+	return nil
+}
+
+var testQueueOptions = QueueOptions{
+	MaxAttempts: 3,
+	BaseDelay:   time.Millisecond,
+	MaxDelay:    10 * time.Millisecond,
+}
+
+func waitForEmpty(t *testing.T, q *Queue) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for q.Backlog() > 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("queue still has %d jobs", q.Backlog())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func countKeys(t *testing.T, bucket *blob.Bucket, prefix string) int {
+	t.Helper()
+	objs, _, err := bucket.ListPage(context.Background(), blob.FirstPageToken, 100, &blob.ListOptions{Prefix: prefix})
+	if err != nil {
+		t.Fatalf("ListPage: %v", err)
+	}
+	return len(objs)
+}
+
+func TestQueue_Retries(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	bucket := memblob.OpenBucket(nil)
+	defer bucket.Close()
+	purger := &flakyPurger{failures: 2}
+	q := NewQueue(purger, bucket, testQueueOptions)
+	if err := q.Start(ctx); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	defer q.Close()
+
+	if err := q.Enqueue(ctx, []string{"repo:github.com/org/repo"}); err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+	waitForEmpty(t, q)
+
+	purger.mu.Lock()
+	defer purger.mu.Unlock()
+	if purger.keyCalls != 3 || len(purger.keys) != 1 {
+		t.Errorf("expected 3 attempts and 1 purged key, got %d attempts and keys %v", purger.keyCalls, purger.keys)
+	}
+	if n := countKeys(t, bucket, pendingPrefix); n != 0 {
+		t.Errorf("expected no pending jobs persisted, got %d", n)
+	}
+	if q.DeadLetters() != 0 {
+		t.Errorf("expected no dead letters, got %d", q.DeadLetters())
+	}
+}
+
+func TestQueue_RetriesFailedKeys(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	purger := &flakyPurger{failures: 1}
+	q := NewQueue(purger, nil, testQueueOptions)
+	if err := q.Start(ctx); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	defer q.Close()
+
+	if err := q.Enqueue(ctx, []string{"a", "b"}, "/a", "/b"); err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+	waitForEmpty(t, q)
+
+	purger.mu.Lock()
+	defer purger.mu.Unlock()
+	// The URLs purged in the meantime don't make up for the failed key.
+	if purger.keyCalls != 3 || strings.Join(purger.keys, ",") != "b,a" || len(purger.urls) != 2 {
+		t.Errorf("expected 3 key attempts, keys b,a and 2 url purges, got %d, %v and %v",
+			purger.keyCalls, purger.keys, purger.urls)
+	}
+}
+
+// urlPurger can't purge keys, and fails the first `failures` URL purges.
+type urlPurger struct {
+	urls     []string
+	failures int
+	mu       sync.Mutex
+	calls    int
+}
+
+func (u *urlPurger) Purge(ctx context.Context, url string) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.calls++
+	if u.calls <= u.failures {
+		return errFlaky
+	}
+	u.urls = append(u.urls, url)
+	return nil
+}
+
+func (u *urlPurger) PurgeKey(ctx context.Context, key string) error {
+	return ErrKeysUnsupported
+}
+
+func TestQueue_KeysUnsupported(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	purger := &urlPurger{failures: 1}
+	// One of the CDNs can purge keys, the URL purges are for the other.
+	q := NewQueue(NewMultiPurger(purger, NewNoOpClient()), nil, testQueueOptions)
+	if err := q.Start(ctx); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	defer q.Close()
+
+	if err := q.Enqueue(ctx, []string{"key"}, "/a", "/b"); err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+	waitForEmpty(t, q)
+
+	purger.mu.Lock()
+	defer purger.mu.Unlock()
+	if purger.calls != 4 || strings.Join(purger.urls, ",") != "/b,/a,/b" {
+		t.Errorf("expected the URLs to be purged again after a failure, got %d calls and %v", purger.calls, purger.urls)
+	}
+	if q.DeadLetters() != 0 {
+		t.Errorf("expected no dead letters, got %d", q.DeadLetters())
+	}
+}
+
+func TestQueue_DeadLetter(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	bucket := memblob.OpenBucket(nil)
+	defer bucket.Close()
+	purger := &flakyPurger{failures: 100}
+	q := NewQueue(purger, bucket, testQueueOptions)
+	if err := q.Start(ctx); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	defer q.Close()
+
+	if err := q.Enqueue(ctx, []string{"key"}); err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+	waitForEmpty(t, q)
+
+	if q.DeadLetters() != 1 {
+		t.Errorf("expected 1 dead letter, got %d", q.DeadLetters())
+	}
+	if n := countKeys(t, bucket, pendingPrefix); n != 0 {
+		t.Errorf("expected no pending jobs persisted, got %d", n)
+	}
+	objs, _, err := bucket.ListPage(ctx, blob.FirstPageToken, 10, &blob.ListOptions{Prefix: deadPrefix})
+	if err != nil || len(objs) != 1 {
+		t.Fatalf("expected 1 dead-lettered job, got %d (err %v)", len(objs), err)
+	}
+	b, err := bucket.ReadAll(ctx, objs[0].Key)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	var job Job
+	if err := json.Unmarshal(b, &job); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if job.Attempts != testQueueOptions.MaxAttempts || !strings.HasSuffix(job.LastError, errFlaky.Error()) {
+		t.Errorf("unexpected dead-lettered job: %+v", job)
+	}
+}
+
+func TestQueue_ResumesPersistedJobs(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	bucket := memblob.OpenBucket(nil)
+	defer bucket.Close()
+
+	// Enqueue without starting, as if the process stopped before purging.
+	stopped := NewQueue(&flakyPurger{}, bucket, testQueueOptions)
+	if err := stopped.Enqueue(ctx, []string{"key"}); err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+
+	purger := &flakyPurger{}
+	q := NewQueue(purger, bucket, testQueueOptions)
+	if err := q.Start(ctx); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	defer q.Close()
+	waitForEmpty(t, q)
+
+	purger.mu.Lock()
+	defer purger.mu.Unlock()
+	if len(purger.keys) != 1 || purger.keys[0] != "key" {
+		t.Errorf("expected persisted job to be purged, got %v", purger.keys)
+	}
+	if n := countKeys(t, bucket, pendingPrefix); n != 0 {
+		t.Errorf("expected no pending jobs persisted, got %d", n)
+	}
+}
+
+// blockingPurger blocks every purge until it's cancelled.
+type blockingPurger struct {
+	started chan struct{}
+}
+
+func (b *blockingPurger) Purge(ctx context.Context, url string) error {
+	return b.PurgeKey(ctx, url)
+}
+
+func (b *blockingPurger) PurgeKey(ctx context.Context, key string) error {
+	select {
+	case b.started <- struct{}{}:
+	default:
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestQueue_CloseDuringPurge(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	purger := &blockingPurger{started: make(chan struct{}, 1)}
+	q := NewQueue(purger, nil, testQueueOptions)
+	if err := q.Start(ctx); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if err := q.Enqueue(ctx, []string{"key"}); err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+	<-purger.started
+
+	closed := make(chan struct{})
+	go func() {
+		q.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close didn't return while a purge was in progress")
+	}
+	if n := q.Backlog(); n != 1 {
+		t.Errorf("Backlog() = %d after Close, want the interrupted job left pending", n)
+	}
+}
+
+func TestQueue_Backoff(t *testing.T) {
+	t.Parallel()
+	q := NewQueue(NewNoOpClient(), nil, QueueOptions{BaseDelay: time.Second, MaxDelay: time.Minute})
+	tests := []struct {
+		attempts int
+		max      time.Duration
+	}{
+		{attempts: 1, max: time.Second},
+		{attempts: 3, max: 4 * time.Second},
+		{attempts: 10, max: time.Minute},
+		{attempts: 100, max: time.Minute},
+	}
+	for _, tt := range tests {
+		got := q.backoff(tt.attempts)
+		if got < tt.max/2 || got > tt.max {
+			t.Errorf("backoff(%d) = %v, want between %v and %v", tt.attempts, got, tt.max/2, tt.max)
+		}
+	}
+}
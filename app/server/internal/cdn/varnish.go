@@ -0,0 +1,87 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// VarnishMethod is the request method a VarnishClient invalidates URLs with.
+type VarnishMethod string
+
+const (
+	// VarnishPurge removes the exact URL from the cache.
+	VarnishPurge VarnishMethod = "PURGE"
+	// VarnishBan invalidates every cached variant of the URL.
+	VarnishBan VarnishMethod = "BAN"
+)
+
+// varnishKeyHeader carries the pattern of a surrogate key BAN, matching the key as a whole
+// word of a Surrogate-Key header. The VCL is expected to ban objects whose Surrogate-Key matches it, e.g.
+//
+//	ban("obj.http.Surrogate-Key ~ " + req.http.X-Ban-Surrogate-Key);
+const varnishKeyHeader = "X-Ban-Surrogate-Key"
+
+// VarnishClient implements Purger for Varnish (or any cache accepting PURGE/BAN requests).
+type VarnishClient struct {
+	client  *http.Client
+	baseURL string
+	method  VarnishMethod
+}
+
+// NewVarnishClient creates a new VarnishClient sending requests to the cache at baseURL.
+func NewVarnishClient(baseURL string, method VarnishMethod) *VarnishClient {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	return &VarnishClient{client: http.DefaultClient, baseURL: baseURL, method: method}
+}
+
+// WithHTTPClient sets the client purge requests are sent with, http.DefaultClient by default.
+func (c *VarnishClient) WithHTTPClient(client *http.Client) *VarnishClient {
+	c.client = client
+	return c
+}
+
+// Purge invalidates the given path, with a PURGE or BAN request depending on the client's method.
+func (c *VarnishClient) Purge(ctx context.Context, path string) error {
+	req, err := http.NewRequestWithContext(ctx, string(c.method), c.baseURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("http.NewRequest: %w", err)
+	}
+	return doPurge(c.client, req)
+}
+
+// PurgeKey bans every object tagged with the surrogate key.
+// Surrogate keys always use BAN, since PURGE only works on a single object.
+func (c *VarnishClient) PurgeKey(ctx context.Context, key string) error {
+	if key == "" {
+		return errEmptyPurgeKey
+	}
+	req, err := http.NewRequestWithContext(ctx, string(VarnishBan), c.baseURL+"/", nil)
+	if err != nil {
+		return fmt.Errorf("http.NewRequest: %w", err)
+	}
+	req.Header.Set(varnishKeyHeader, varnishKeyPattern(key))
+	return doPurge(c.client, req)
+}
+
+// varnishKeyPattern is the regular expression matching key, and no other key, in a space-separated
+// Surrogate-Key header. Keys contain dots, which would otherwise match any character.
+func varnishKeyPattern(key string) string {
+	return `(^|\s)` + regexp.QuoteMeta(key) + `(\s|$)`
+}
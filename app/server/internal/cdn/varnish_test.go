@@ -0,0 +1,111 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdn
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+)
+
+func TestVarnishClient(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		purge      func(ctx context.Context, c *VarnishClient) error
+		name       string
+		method     VarnishMethod
+		wantMethod string
+		wantPath   string
+		wantKey    string
+	}{
+		{
+			name:       "purge url",
+			method:     VarnishPurge,
+			purge:      func(ctx context.Context, c *VarnishClient) error { return c.Purge(ctx, "/projects/a/b/c") },
+			wantMethod: "PURGE",
+			wantPath:   "/projects/a/b/c",
+		},
+		{
+			name:       "ban url",
+			method:     VarnishBan,
+			purge:      func(ctx context.Context, c *VarnishClient) error { return c.Purge(ctx, "/projects/a/b/c") },
+			wantMethod: "BAN",
+			wantPath:   "/projects/a/b/c",
+		},
+		{
+			name:       "ban key",
+			method:     VarnishPurge,
+			purge:      func(ctx context.Context, c *VarnishClient) error { return c.PurgeKey(ctx, "repo:a.b/c/d") },
+			wantMethod: "BAN",
+			wantPath:   "/",
+			wantKey:    `(^|\s)repo:a\.b/c/d(\s|$)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != tt.wantMethod {
+					t.Errorf("expected method %s, got %s", tt.wantMethod, r.Method)
+				}
+				if r.URL.Path != tt.wantPath {
+					t.Errorf("expected path %s, got %s", tt.wantPath, r.URL.Path)
+				}
+				if got := r.Header.Get(varnishKeyHeader); got != tt.wantKey {
+					t.Errorf("expected key %q, got %q", tt.wantKey, got)
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+			if err := tt.purge(context.Background(), NewVarnishClient(server.URL, tt.method)); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestVarnishClient_Error(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}))
+	defer server.Close()
+	if err := NewVarnishClient(server.URL, VarnishBan).PurgeKey(context.Background(), "key"); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
+
+func Test_varnishKeyPattern(t *testing.T) {
+	t.Parallel()
+	re := regexp.MustCompile(varnishKeyPattern("repo:github.com/org/repo"))
+	tests := []struct {
+		header string
+		want   bool
+	}{
+		{header: "repo:github.com/org/repo", want: true},
+		{header: "org:github.com/org repo:github.com/org/repo", want: true},
+		{header: "repo:github.com/org/repo org:github.com/org", want: true},
+		{header: "repo:github.com/org/repo2"},
+		{header: "xrepo:github.com/org/repo"},
+		{header: "repo:githubxcom/org/repo"},
+	}
+	for _, tt := range tests {
+		if got := re.MatchString(tt.header); got != tt.want {
+			t.Errorf("matching %q = %v, want %v", tt.header, got, tt.want)
+		}
+	}
+}
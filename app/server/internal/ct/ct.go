@@ -0,0 +1,218 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ct verifies the signed certificate timestamps (SCTs) embedded in certificates, which
+// prove a certificate transparency log promised to publish the certificate.
+// See https://www.rfc-editor.org/rfc/rfc6962
+package ct
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ossf/scorecard-webapp/app/server/internal/hashedrekord"
+)
+
+var (
+	// ErrNoSCT is returned when a certificate has no embedded SCT.
+	ErrNoSCT = errors.New("certificate has no embedded SCT")
+	// ErrUnknownLog is returned when none of a certificate's SCTs is from a known log.
+	ErrUnknownLog = errors.New("no SCT from a known log")
+
+	errMalformedSCT = errors.New("malformed SCT list")
+)
+
+// sctListOID is the extension of the SCTs embedded in a certificate.
+var sctListOID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 2}
+
+// Logs are the public keys of trusted logs, by log ID: the SHA-256 of the key's DER encoding.
+type Logs map[[sha256.Size]byte]crypto.PublicKey
+
+// ParseLogs parses the PEM encoded public keys of logs.
+func ParseLogs(b []byte) (Logs, error) {
+	logs := Logs{}
+	for {
+		var block *pem.Block
+		block, b = pem.Decode(b)
+		if block == nil {
+			break
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parsing log public key: %w", err)
+		}
+		logs[sha256.Sum256(block.Bytes)] = key
+	}
+	if len(logs) == 0 {
+		return nil, errors.New("no PEM encoded public keys")
+	}
+	return logs, nil
+}
+
+// sct is a v1 signed certificate timestamp.
+type sct struct {
+	extensions []byte
+	signature  []byte
+	timestamp  uint64
+	logID      [sha256.Size]byte
+}
+
+// VerifyEmbedded verifies the SCTs embedded in cert, issued by issuer. It succeeds if one
+// of them is from a known log, and fails if any from a known log has an invalid signature.
+// ErrNoSCT or ErrUnknownLog are returned when no SCT could be verified.
+func (l Logs) VerifyEmbedded(cert, issuer *x509.Certificate) error {
+	var list []byte
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(sctListOID) {
+			if _, err := asn1.Unmarshal(ext.Value, &list); err != nil {
+				return fmt.Errorf("%w: %w", errMalformedSCT, err)
+			}
+		}
+	}
+	if list == nil {
+		return ErrNoSCT
+	}
+	scts, err := parseSCTList(list)
+	if err != nil {
+		return err
+	}
+	tbs, err := precertTBS(cert.RawTBSCertificate)
+	if err != nil {
+		return err
+	}
+	issuerKeyHash := sha256.Sum256(issuer.RawSubjectPublicKeyInfo)
+
+	verified := false
+	for _, s := range scts {
+		key, ok := l[s.logID]
+		if !ok {
+			continue
+		}
+		signed := s.signedData(issuerKeyHash, tbs)
+		if err := hashedrekord.VerifySignature(key, signed, crypto.SHA256, s.signature); err != nil {
+			return fmt.Errorf("SCT from log %x: %w", s.logID, err)
+		}
+		verified = true
+	}
+	if !verified {
+		return ErrUnknownLog
+	}
+	return nil
+}
+
+// parseSCTList parses a TLS encoded SignedCertificateTimestampList.
+func parseSCTList(b []byte) ([]sct, error) {
+	list, rest, ok := readVector(b, 2)
+	if !ok || len(rest) > 0 {
+		return nil, errMalformedSCT
+	}
+	var scts []sct
+	for len(list) > 0 {
+		var raw []byte
+		if raw, list, ok = readVector(list, 2); !ok {
+			return nil, errMalformedSCT
+		}
+		// Version, log ID, timestamp, extensions, hash and signature algorithms, signature.
+		if len(raw) < 1+sha256.Size+8 || raw[0] != 0 {
+			// Only v1 SCTs exist, skip anything else.
+			continue
+		}
+		var s sct
+		copy(s.logID[:], raw[1:])
+		s.timestamp = binary.BigEndian.Uint64(raw[1+sha256.Size:])
+		raw = raw[1+sha256.Size+8:]
+		if s.extensions, raw, ok = readVector(raw, 2); !ok || len(raw) < 2 {
+			return nil, errMalformedSCT
+		}
+		if s.signature, raw, ok = readVector(raw[2:], 2); !ok || len(raw) > 0 {
+			return nil, errMalformedSCT
+		}
+		scts = append(scts, s)
+	}
+	return scts, nil
+}
+
+// readVector reads a TLS vector with a length prefix of n bytes.
+func readVector(b []byte, n int) (vector, rest []byte, ok bool) {
+	if len(b) < n {
+		return nil, nil, false
+	}
+	var length int
+	for _, c := range b[:n] {
+		length = length<<8 | int(c)
+	}
+	if len(b) < n+length {
+		return nil, nil, false
+	}
+	return b[n : n+length], b[n+length:], true
+}
+
+// signedData is what the log signed for a precertificate entry.
+func (s sct) signedData(issuerKeyHash [sha256.Size]byte, tbs []byte) []byte {
+	b := []byte{0, 0} // v1, certificate_timestamp.
+	b = binary.BigEndian.AppendUint64(b, s.timestamp)
+	b = append(b, 0, 1) // precert_entry.
+	b = append(b, issuerKeyHash[:]...)
+	b = append(b, byte(len(tbs)>>16), byte(len(tbs)>>8), byte(len(tbs)))
+	b = append(b, tbs...)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(s.extensions))) //nolint:gosec // Read with a 2 byte length.
+	return append(b, s.extensions...)
+}
+
+// tbsCertificate is a TBSCertificate, keeping the encoding of the fields we don't change.
+type tbsCertificate struct {
+	Version            int `asn1:"optional,explicit,default:0,tag:0"`
+	SerialNumber       *big.Int
+	SignatureAlgorithm asn1.RawValue
+	Issuer             asn1.RawValue
+	Validity           asn1.RawValue
+	Subject            asn1.RawValue
+	PublicKey          asn1.RawValue
+	UniqueID           asn1.BitString  `asn1:"optional,tag:1"`
+	SubjectUniqueID    asn1.BitString  `asn1:"optional,tag:2"`
+	Extensions         []asn1.RawValue `asn1:"optional,explicit,tag:3"`
+}
+
+// precertTBS rebuilds the precertificate the log signed from the certificate: the
+// certificate without its SCTs.
+func precertTBS(raw []byte) ([]byte, error) {
+	var tbs tbsCertificate
+	if rest, err := asn1.Unmarshal(raw, &tbs); err != nil || len(rest) > 0 {
+		return nil, fmt.Errorf("parsing TBSCertificate: %w", err)
+	}
+	var extensions []asn1.RawValue
+	for _, ext := range tbs.Extensions {
+		var parsed pkix.Extension
+		if _, err := asn1.Unmarshal(ext.FullBytes, &parsed); err != nil {
+			return nil, fmt.Errorf("parsing extension: %w", err)
+		}
+		if !parsed.Id.Equal(sctListOID) {
+			extensions = append(extensions, ext)
+		}
+	}
+	tbs.Extensions = extensions
+	b, err := asn1.Marshal(tbs)
+	if err != nil {
+		return nil, fmt.Errorf("encoding precertificate TBSCertificate: %w", err)
+	}
+	return b, nil
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ct
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/ossf/scorecard-webapp/app/server/internal/hashedrekord"
+)
+
+func readCert(t *testing.T, path string) *x509.Certificate {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("os.ReadFile: %v", err)
+	}
+	block, _ := pem.Decode(b)
+	if block == nil {
+		t.Fatalf("no PEM block in %s", path)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("x509.ParseCertificate: %v", err)
+	}
+	return cert
+}
+
+func readLogs(t *testing.T, path string) Logs {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("os.ReadFile: %v", err)
+	}
+	logs, err := ParseLogs(b)
+	if err != nil {
+		t.Fatalf("ParseLogs: %v", err)
+	}
+	return logs
+}
+
+// tampered returns a copy of cert with the last byte of its SCT's timestamp changed.
+func tampered(cert *x509.Certificate) *x509.Certificate {
+	c := *cert
+	c.Extensions = slices.Clone(cert.Extensions)
+	for i, ext := range c.Extensions {
+		if ext.Id.Equal(sctListOID) {
+			value := bytes.Clone(ext.Value)
+			// OCTET STRING header, list length, SCT length, version, log ID, timestamp.
+			value[2+2+2+1+32+7]++
+			c.Extensions[i].Value = value
+		}
+	}
+	return &c
+}
+
+func TestLogs_VerifyEmbedded(t *testing.T) {
+	t.Parallel()
+	// The leaf certificate of the saved Rekor entry, issued by the public good Fulcio
+	// and logged to its CT log.
+	leaf := readCert(t, "testdata/leaf.pem")
+	intermediate := readCert(t, "../../fulcio_intermediate.crt.pem")
+	root := readCert(t, "../../fulcio_v1.crt.pem")
+	logs := readLogs(t, "../../ctfe.pub")
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("ecdsa.GenerateKey: %v", err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("x509.MarshalPKIXPublicKey: %v", err)
+	}
+	otherLogs, err := ParseLogs(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	if err != nil {
+		t.Fatalf("ParseLogs: %v", err)
+	}
+
+	tests := []struct {
+		wantErr error
+		logs    Logs
+		cert    *x509.Certificate
+		issuer  *x509.Certificate
+		name    string
+	}{
+		{name: "valid", logs: logs, cert: leaf, issuer: intermediate},
+		{name: "unknown log", logs: otherLogs, cert: leaf, issuer: intermediate, wantErr: ErrUnknownLog},
+		{name: "no SCT", logs: logs, cert: intermediate, issuer: root, wantErr: ErrNoSCT},
+		{
+			name: "wrong issuer", logs: logs, cert: leaf, issuer: root,
+			wantErr: hashedrekord.ErrInvalidSignature,
+		},
+		{
+			name: "tampered SCT", logs: logs, cert: tampered(leaf), issuer: intermediate,
+			wantErr: hashedrekord.ErrInvalidSignature,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.logs.VerifyEmbedded(tt.cert, tt.issuer)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("VerifyEmbedded() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseLogs(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "empty", input: "", wantErr: true},
+		{name: "not a key", input: "-----BEGIN PUBLIC KEY-----\nAAAA\n-----END PUBLIC KEY-----\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := ParseLogs([]byte(tt.input)); (err != nil) != tt.wantErr {
+				t.Errorf("ParseLogs() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
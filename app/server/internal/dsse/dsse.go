@@ -0,0 +1,177 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dsse implements the Rekor entry kinds logging a DSSE envelope, such as a signed
+// in-toto attestation. Rekor only keeps the hashes of the envelope and its payload, so the
+// entries are matched against the payload.
+package dsse
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/ossf/scorecard-webapp/app/server/internal/hashedrekord"
+)
+
+// https://github.com/sigstore/rekor/blob/f01f9cd2c55eaddba9be28624fea793a26ad28c4/pkg/types/README.md
+const (
+	Kind       = "dsse"
+	IntotoKind = "intoto"
+)
+
+// IntotoPayloadType is the payload type of in-toto attestations.
+const IntotoPayloadType = "application/vnd.in-toto+json"
+
+var errNoSignatures = errors.New("entry has no signatures")
+
+// Body is a dsse entry.
+//
+// https://github.com/sigstore/rekor/blob/f01f9cd2c55eaddba9be28624fea793a26ad28c4/pkg/types/dsse/v0.0.1/dsse_v0_0_1_schema.json
+//
+//nolint:lll
+type Body struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Spec       Spec   `json:"spec"`
+}
+type Spec struct {
+	EnvelopeHash hashedrekord.Hash `json:"envelopeHash"`
+	PayloadHash  hashedrekord.Hash `json:"payloadHash"`
+	Signatures   []Signature       `json:"signatures"`
+}
+type Signature struct {
+	// Signature is the base64 encoded signature of the envelope.
+	Signature string `json:"signature"`
+	// Verifier is the base64 encoded PEM certificate (or key) verifying the signature.
+	Verifier string `json:"verifier"`
+}
+
+// Matches checks if payload is the entry's DSSE payload.
+func (b Body) Matches(payload []byte) bool {
+	return b.Spec.PayloadHash.Matches(payload)
+}
+
+// Certs extracts the x509 certs of all the entry's signatures.
+func (b Body) Certs() ([]*x509.Certificate, error) {
+	verifiers := make([]string, len(b.Spec.Signatures))
+	for i, sig := range b.Spec.Signatures {
+		verifiers[i] = sig.Verifier
+	}
+	return parseCerts(verifiers)
+}
+
+// VerifySignature verifies one of the entry's signatures is the payload's, by the public key.
+// dsse entries don't log the payload type, so the payload must be an in-toto attestation's.
+func (b Body) VerifySignature(payload []byte, pub crypto.PublicKey) error {
+	sigs := make([]string, len(b.Spec.Signatures))
+	for i, sig := range b.Spec.Signatures {
+		sigs[i] = sig.Signature
+	}
+	return verifySignatures(PAE(IntotoPayloadType, payload), sigs, pub)
+}
+
+// IntotoBody is an intoto entry, of version 0.0.2.
+//
+// https://github.com/sigstore/rekor/blob/f01f9cd2c55eaddba9be28624fea793a26ad28c4/pkg/types/intoto/v0.0.2/intoto_v0_0_2_schema.json
+//
+//nolint:lll
+type IntotoBody struct {
+	APIVersion string     `json:"apiVersion"`
+	Kind       string     `json:"kind"`
+	Spec       IntotoSpec `json:"spec"`
+}
+type IntotoSpec struct {
+	Content IntotoContent `json:"content"`
+}
+type IntotoContent struct {
+	Envelope    Envelope          `json:"envelope"`
+	Hash        hashedrekord.Hash `json:"hash"`
+	PayloadHash hashedrekord.Hash `json:"payloadHash"`
+}
+
+// Envelope is a DSSE envelope, without its payload.
+type Envelope struct {
+	PayloadType string              `json:"payloadType"`
+	Signatures  []EnvelopeSignature `json:"signatures"`
+}
+type EnvelopeSignature struct {
+	// Sig is the base64 encoded signature, itself base64 encoded.
+	Sig string `json:"sig"`
+	// PublicKey is the base64 encoded PEM certificate (or key) verifying the signature.
+	PublicKey string `json:"publicKey"`
+}
+
+// Matches checks if payload is the entry's DSSE payload.
+func (b IntotoBody) Matches(payload []byte) bool {
+	return b.Spec.Content.PayloadHash.Matches(payload)
+}
+
+// Certs extracts the x509 certs of all the entry's signatures.
+func (b IntotoBody) Certs() ([]*x509.Certificate, error) {
+	keys := make([]string, len(b.Spec.Content.Envelope.Signatures))
+	for i, sig := range b.Spec.Content.Envelope.Signatures {
+		keys[i] = sig.PublicKey
+	}
+	return parseCerts(keys)
+}
+
+// VerifySignature verifies one of the entry's signatures is the payload's, by the public key.
+func (b IntotoBody) VerifySignature(payload []byte, pub crypto.PublicKey) error {
+	var sigs []string
+	for i, sig := range b.Spec.Content.Envelope.Signatures {
+		decoded, err := base64.StdEncoding.DecodeString(sig.Sig)
+		if err != nil {
+			return fmt.Errorf("signature %d: decode: %w", i, err)
+		}
+		sigs = append(sigs, string(decoded))
+	}
+	return verifySignatures(PAE(b.Spec.Content.Envelope.PayloadType, payload), sigs, pub)
+}
+
+// PAE is the pre-authentication encoding of a DSSE payload, which is what's signed.
+// See https://github.com/secure-systems-lab/dsse/blob/master/protocol.md
+func PAE(payloadType string, payload []byte) []byte {
+	return fmt.Appendf(nil, "DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload)
+}
+
+// verifySignatures verifies one of the base64 encoded signatures is message's, by pub.
+func verifySignatures(message []byte, sigs []string, pub crypto.PublicKey) error {
+	err := errNoSignatures
+	for i, encoded := range sigs {
+		sig, decodeErr := base64.StdEncoding.DecodeString(encoded)
+		if decodeErr != nil {
+			return fmt.Errorf("signature %d: decode: %w", i, decodeErr)
+		}
+		err = hashedrekord.VerifySignature(pub, message, hashedrekord.SignatureHash(pub), sig)
+		if err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+func parseCerts(publicKeys []string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for i, key := range publicKeys {
+		c, err := hashedrekord.ParseCerts(key)
+		if err != nil {
+			return nil, fmt.Errorf("signature %d: %w", i, err)
+		}
+		certs = append(certs, c...)
+	}
+	return certs, nil
+}
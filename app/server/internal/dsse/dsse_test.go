@@ -0,0 +1,205 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dsse
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ossf/scorecard-webapp/app/server/internal/hashedrekord"
+)
+
+var payload = []byte(`{"_type":"https://in-toto.io/Statement/v1"}`)
+
+// newCert returns a base64 encoded PEM certificate, as found in entries.
+func newCert(t *testing.T) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("ecdsa.GenerateKey: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("x509.CreateCertificate: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestMatches(t *testing.T) {
+	t.Parallel()
+	sha256Sum := sha256.Sum256(payload)
+	sha384Sum := sha512.Sum384(payload)
+	sha512Sum := sha512.Sum512(payload)
+	tests := []struct {
+		name string
+		hash hashedrekord.Hash
+		want bool
+	}{
+		{name: "sha256", hash: hashedrekord.Hash{Algorithm: "sha256", Value: hex.EncodeToString(sha256Sum[:])}, want: true},
+		{name: "sha384", hash: hashedrekord.Hash{Algorithm: "sha384", Value: hex.EncodeToString(sha384Sum[:])}, want: true},
+		{name: "sha512", hash: hashedrekord.Hash{Algorithm: "sha512", Value: hex.EncodeToString(sha512Sum[:])}, want: true},
+		{name: "mismatched", hash: hashedrekord.Hash{Algorithm: "sha256", Value: "foo"}},
+		{name: "unsupported", hash: hashedrekord.Hash{Algorithm: "md5", Value: hex.EncodeToString(sha256Sum[:])}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dsse := Body{Kind: Kind, Spec: Spec{
+				// The envelope hash is never matched against.
+				EnvelopeHash: hashedrekord.Hash{Algorithm: "sha256", Value: hex.EncodeToString(sha256Sum[:])},
+				PayloadHash:  tt.hash,
+			}}
+			if got := dsse.Matches(payload); got != tt.want {
+				t.Errorf("Body.Matches() = %t, want %t", got, tt.want)
+			}
+			intoto := IntotoBody{Kind: IntotoKind, Spec: IntotoSpec{Content: IntotoContent{
+				Hash:        hashedrekord.Hash{Algorithm: "sha256", Value: hex.EncodeToString(sha256Sum[:])},
+				PayloadHash: tt.hash,
+			}}}
+			if got := intoto.Matches(payload); got != tt.want {
+				t.Errorf("IntotoBody.Matches() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCerts(t *testing.T) {
+	t.Parallel()
+	cert := newCert(t)
+	tests := []struct {
+		name      string
+		keys      []string
+		wantCount int
+		wantErr   bool
+	}{
+		{name: "one signature", keys: []string{cert}, wantCount: 1},
+		{name: "two signatures", keys: []string{cert, cert}, wantCount: 2},
+		{name: "no signatures"},
+		{name: "invalid", keys: []string{cert, "not base64"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dsse := Body{}
+			intoto := IntotoBody{}
+			for _, key := range tt.keys {
+				dsse.Spec.Signatures = append(dsse.Spec.Signatures, Signature{Signature: "sig", Verifier: key})
+				intoto.Spec.Content.Envelope.Signatures = append(intoto.Spec.Content.Envelope.Signatures,
+					EnvelopeSignature{Sig: "sig", PublicKey: key})
+			}
+			for name, body := range map[string]interface {
+				Certs() ([]*x509.Certificate, error)
+			}{"Body": dsse, "IntotoBody": intoto} {
+				certs, err := body.Certs()
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("%s.Certs() error = %v, wantErr %v", name, err, tt.wantErr)
+				}
+				if len(certs) != tt.wantCount {
+					t.Errorf("%s.Certs() parsed %d certs, want %d", name, len(certs), tt.wantCount)
+				}
+			}
+		})
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	t.Parallel()
+	keys := map[string]*ecdsa.PrivateKey{}
+	for name, curve := range map[string]elliptic.Curve{"P-256": elliptic.P256(), "P-384": elliptic.P384()} {
+		key, err := ecdsa.GenerateKey(curve, rand.Reader)
+		if err != nil {
+			t.Fatalf("ecdsa.GenerateKey: %v", err)
+		}
+		keys[name] = key
+	}
+	sign := func(key *ecdsa.PrivateKey, payloadType string) string {
+		hash := hashedrekord.SignatureHash(&key.PublicKey)
+		digest := hash.New()
+		digest.Write(PAE(payloadType, payload))
+		sig, err := ecdsa.SignASN1(rand.Reader, key, digest.Sum(nil))
+		if err != nil {
+			t.Fatalf("ecdsa.SignASN1: %v", err)
+		}
+		return base64.StdEncoding.EncodeToString(sig)
+	}
+
+	tests := []struct {
+		wantErr     error
+		signer      *ecdsa.PrivateKey
+		verifier    *ecdsa.PrivateKey
+		name        string
+		payloadType string
+		payload     []byte
+	}{
+		{name: "P-256", signer: keys["P-256"], verifier: keys["P-256"], payload: payload},
+		{name: "P-384", signer: keys["P-384"], verifier: keys["P-384"], payload: payload},
+		{
+			name: "other payload", signer: keys["P-256"], verifier: keys["P-256"], payload: []byte("{}"),
+			wantErr: hashedrekord.ErrInvalidSignature,
+		},
+		{
+			name: "other key", signer: keys["P-256"], verifier: keys["P-384"], payload: payload,
+			wantErr: hashedrekord.ErrInvalidSignature,
+		},
+		{
+			name: "other payload type", signer: keys["P-256"], verifier: keys["P-256"], payload: payload,
+			payloadType: "text/plain", wantErr: hashedrekord.ErrInvalidSignature,
+		},
+		{name: "no signatures", verifier: keys["P-256"], payload: payload, wantErr: errNoSignatures},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			payloadType := IntotoPayloadType
+			if tt.payloadType != "" {
+				payloadType = tt.payloadType
+			}
+			dsse := Body{}
+			intoto := IntotoBody{}
+			intoto.Spec.Content.Envelope.PayloadType = IntotoPayloadType
+			if tt.signer != nil {
+				sig := sign(tt.signer, payloadType)
+				dsse.Spec.Signatures = []Signature{{Signature: sig}}
+				intoto.Spec.Content.Envelope.Signatures = []EnvelopeSignature{
+					{Sig: base64.StdEncoding.EncodeToString([]byte(sig))},
+				}
+			}
+			if err := dsse.VerifySignature(tt.payload, &tt.verifier.PublicKey); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Body.VerifySignature() = %v, want %v", err, tt.wantErr)
+			}
+			if err := intoto.VerifySignature(tt.payload, &tt.verifier.PublicKey); !errors.Is(err, tt.wantErr) {
+				t.Errorf("IntotoBody.VerifySignature() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
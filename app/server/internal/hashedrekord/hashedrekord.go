@@ -15,11 +15,18 @@
 package hashedrekord
 
 import (
-	"crypto/sha256"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	_ "crypto/sha256" // Registers crypto.SHA256.
+	_ "crypto/sha512" // Registers crypto.SHA384 and crypto.SHA512.
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"log"
 )
@@ -27,7 +34,18 @@ import (
 // https://github.com/sigstore/rekor/blob/f01f9cd2c55eaddba9be28624fea793a26ad28c4/pkg/types/README.md
 const Kind = "hashedrekord"
 
-const sha256AlgorithmName = "sha256"
+// hashes are the hash algorithms Rekor entries may use.
+var hashes = map[string]crypto.Hash{
+	"sha256": crypto.SHA256,
+	"sha384": crypto.SHA384,
+	"sha512": crypto.SHA512,
+}
+
+var (
+	// ErrInvalidSignature is returned when a signature doesn't verify with the certificate's key.
+	ErrInvalidSignature = errors.New("invalid signature")
+	errUnsupportedKey   = errors.New("unsupported public key type")
+)
 
 // https://github.com/sigstore/rekor/blob/f01f9cd2c55eaddba9be28624fea793a26ad28c4/pkg/types/hashedrekord/v0.0.1/hashedrekord_v0_0_1_schema.json
 //
@@ -56,32 +74,96 @@ type PublicKey struct {
 	Content string `json:"content"`
 }
 
-// check if the rekord object matches a given blob (currently compares sha256 hash).
-func (b Body) Matches(blob []byte) bool {
-	if b.Spec.Data.Hash.Algorithm != sha256AlgorithmName {
-		log.Println("hashedrekord entry has no sha256")
+// Matches checks if the hash is the digest of blob, with sha256, sha384 or sha512.
+func (h Hash) Matches(blob []byte) bool {
+	hash, ok := hashes[h.Algorithm]
+	if !ok {
+		log.Printf("rekor entry has unsupported hash algorithm %q", h.Algorithm)
 		return false
 	}
-	sha := sha256.Sum256(blob)
-	have := hex.EncodeToString(sha[:])
-	want := b.Spec.Data.Hash.Value
-	return have == want
+	digest := hash.New()
+	digest.Write(blob)
+	return hex.EncodeToString(digest.Sum(nil)) == h.Value
+}
+
+// check if the rekord object matches a given blob (compares its hash).
+func (b Body) Matches(blob []byte) bool {
+	return b.Spec.Data.Hash.Matches(blob)
+}
+
+// VerifySignature verifies the entry's signature is blob's, by the public key.
+// Rekor checks it when the entry is logged, but we don't rely on that.
+func (b Body) VerifySignature(blob []byte, pub crypto.PublicKey) error {
+	hash, ok := hashes[b.Spec.Data.Hash.Algorithm]
+	if !ok {
+		return fmt.Errorf("unsupported hash algorithm %q", b.Spec.Data.Hash.Algorithm)
+	}
+	sig, err := base64.StdEncoding.DecodeString(b.Spec.Signature.Content)
+	if err != nil {
+		return fmt.Errorf("decode rekord signature: %w", err)
+	}
+	return VerifySignature(pub, blob, hash, sig)
+}
+
+// VerifySignature verifies sig is message's signature by pub, an ECDSA, RSA (PKCS #1 v1.5)
+// or Ed25519 key. hash is the hash message was signed with, unused for Ed25519 keys.
+func VerifySignature(pub crypto.PublicKey, message []byte, hash crypto.Hash, sig []byte) error {
+	if key, ok := pub.(ed25519.PublicKey); ok {
+		if !ed25519.Verify(key, message, sig) {
+			return ErrInvalidSignature
+		}
+		return nil
+	}
+	digest := hash.New()
+	digest.Write(message)
+	switch key := pub.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest.Sum(nil), sig) {
+			return ErrInvalidSignature
+		}
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(key, hash, digest.Sum(nil), sig); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidSignature, err)
+		}
+	default:
+		return fmt.Errorf("%w: %T", errUnsupportedKey, pub)
+	}
+	return nil
+}
+
+// SignatureHash is the hash Sigstore clients sign with using the key: the one matching the
+// curve of ECDSA keys, and SHA-256 otherwise.
+func SignatureHash(pub crypto.PublicKey) crypto.Hash {
+	if key, ok := pub.(*ecdsa.PublicKey); ok {
+		switch key.Curve {
+		case elliptic.P384():
+			return crypto.SHA384
+		case elliptic.P521():
+			return crypto.SHA512
+		}
+	}
+	return crypto.SHA256
 }
 
 // extracts all x509 certs from the hashedrekord tlog entry public key.
 func (b Body) Certs() ([]*x509.Certificate, error) {
-	publicKey, err := base64.StdEncoding.DecodeString(b.Spec.Signature.PublicKey.Content)
+	return ParseCerts(b.Spec.Signature.PublicKey.Content)
+}
+
+// ParseCerts extracts all x509 certs from a base64 encoded PEM public key, as found in Rekor entries.
+func ParseCerts(publicKey string) ([]*x509.Certificate, error) {
+	pemKey, err := base64.StdEncoding.DecodeString(publicKey)
 	if err != nil {
 		return nil, fmt.Errorf("decode rekord public key: %w", err)
 	}
 
-	remaining := publicKey
+	remaining := pemKey
 	var result []*x509.Certificate
 	for len(remaining) > 0 {
 		var certDer *pem.Block
 		certDer, remaining = pem.Decode(remaining)
 		if certDer == nil {
-			return nil, fmt.Errorf("error during PEM decoding: %w", err)
+			return nil, errors.New("error during PEM decoding")
 		}
 
 		cert, err := x509.ParseCertificate(certDer.Bytes)
@@ -15,10 +15,35 @@
 package hashedrekord
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
 	"os"
 	"testing"
 )
 
+// testBody is a real entry, signing testdata/uploaded-blob.md.
+var testBody = Body{
+	APIVersion: "0.0.1",
+	Kind:       Kind,
+	Spec: Spec{
+		Data: Data{
+			Hash: Hash{
+				Algorithm: "sha256",
+				Value:     "cd8327d867fce04bc97e149da50c3746340869575f7bf959a67284e34bfd46bc",
+			},
+		},
+		Signature: Signature{
+			Content: "MEYCIQDC3HNuKX8km/7TOo+HQ1WGr/FITRoYD9fw5RCRqc7WIgIhANAn6+yz0ziGGHH/52pDm5vCuOQgHnQ0AkGqi6POkEUB",
+			PublicKey: PublicKey{
+				//nolint:lll
+				Content: "LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCk1JSUMwVENDQWxpZ0F3SUJBZ0lVZFh4WHRDa2NCdmt4UGhIc20yTzdxczJidnIwd0NnWUlLb1pJemowRUF3TXcKTnpFVk1CTUdBMVVFQ2hNTWMybG5jM1J2Y21VdVpHVjJNUjR3SEFZRFZRUURFeFZ6YVdkemRHOXlaUzFwYm5SbApjbTFsWkdsaGRHVXdIaGNOTWpNd05qRXpNVGN6T1RReVdoY05Nak13TmpFek1UYzBPVFF5V2pBQU1Ga3dFd1lICktvWkl6ajBDQVFZSUtvWkl6ajBEQVFjRFFnQUVrUExsVkhGOFRURWM5YzMzbmxCWHFEelNqR3RPZUJoa0VXQ1AKNEw5c1hYdTQvNXdlYzN3eVY4Nkozazg5Ly9FVTQvSysreFI4ckpkZVFialpDUkFQaGFPQ0FYY3dnZ0Z6TUE0RwpBMVVkRHdFQi93UUVBd0lIZ0RBVEJnTlZIU1VFRERBS0JnZ3JCZ0VGQlFjREF6QWRCZ05WSFE0RUZnUVVWUk1MCklOUnpQZjBVTlF0T1RmVVpNMVNUUTNjd0h3WURWUjBqQkJnd0ZvQVUzOVBwejFZa0VaYjVxTmpwS0ZXaXhpNFkKWkQ4d0lRWURWUjBSQVFIL0JCY3dGWUVUYzNOamFISnZZMnRBWjI5dloyeGxMbU52YlRBc0Jnb3JCZ0VFQVlPLwpNQUVCQkI1b2RIUndjem92TDJkcGRHaDFZaTVqYjIwdmJHOW5hVzR2YjJGMWRHZ3dMZ1lLS3dZQkJBR0R2ekFCCkNBUWdEQjVvZEhSd2N6b3ZMMmRwZEdoMVlpNWpiMjB2Ykc5bmFXNHZiMkYxZEdnd2dZb0dDaXNHQVFRQjFua0MKQkFJRWZBUjZBSGdBZGdEZFBUQnF4c2NSTW1NWkhoeVpaemNDb2twZXVONDhyZitIaW5LQUx5bnVqZ0FBQVlpMQoxNDNUQUFBRUF3QkhNRVVDSUh3eXh1bHFJMm1JdU44ai94TlcrUmJxbGd0S2YzcVlxNzFjSDY4MWZFaHNBaUVBCmhlcVY3SVQzSzNkeEQxTzNXcElFczhsY1RhS1U4K2o1R2g3T1E4OVpOaTB3Q2dZSUtvWkl6ajBFQXdNRFp3QXcKWkFJd0JTT0R3YVJ1Q1hnY0VCRk1KQmh4OVYrSW92ZHdOOXp5SVJ6VWlvTlZrTHRQM281SHVvNVZjcExMcEI5bwpSUXNSQWpBVS9yRmFrU2dJamx4c2cwZWJJS280NVJwdGtDSkh6dUZWS3YzTDdMQitLczlRcnhWb2g4WmhhMVNhCjROemllNkU9Ci0tLS0tRU5EIENFUlRJRklDQVRFLS0tLS0K",
+			},
+		},
+	},
+}
+
 func Test_Body_certs(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -28,26 +53,8 @@ func Test_Body_certs(t *testing.T) {
 		wantErr   bool
 	}{
 		{
-			name: "basic",
-			body: Body{
-				APIVersion: "0.0.1",
-				Kind:       Kind,
-				Spec: Spec{
-					Data: Data{
-						Hash: Hash{
-							Algorithm: "sha256",
-							Value:     "cd8327d867fce04bc97e149da50c3746340869575f7bf959a67284e34bfd46bc",
-						},
-					},
-					Signature: Signature{
-						Content: "MEYCIQDC3HNuKX8km/7TOo+HQ1WGr/FITRoYD9fw5RCRqc7WIgIhANAn6+yz0ziGGHH/52pDm5vCuOQgHnQ0AkGqi6POkEUB",
-						PublicKey: PublicKey{
-							//nolint:lll
-							Content: "LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCk1JSUMwVENDQWxpZ0F3SUJBZ0lVZFh4WHRDa2NCdmt4UGhIc20yTzdxczJidnIwd0NnWUlLb1pJemowRUF3TXcKTnpFVk1CTUdBMVVFQ2hNTWMybG5jM1J2Y21VdVpHVjJNUjR3SEFZRFZRUURFeFZ6YVdkemRHOXlaUzFwYm5SbApjbTFsWkdsaGRHVXdIaGNOTWpNd05qRXpNVGN6T1RReVdoY05Nak13TmpFek1UYzBPVFF5V2pBQU1Ga3dFd1lICktvWkl6ajBDQVFZSUtvWkl6ajBEQVFjRFFnQUVrUExsVkhGOFRURWM5YzMzbmxCWHFEelNqR3RPZUJoa0VXQ1AKNEw5c1hYdTQvNXdlYzN3eVY4Nkozazg5Ly9FVTQvSysreFI4ckpkZVFialpDUkFQaGFPQ0FYY3dnZ0Z6TUE0RwpBMVVkRHdFQi93UUVBd0lIZ0RBVEJnTlZIU1VFRERBS0JnZ3JCZ0VGQlFjREF6QWRCZ05WSFE0RUZnUVVWUk1MCklOUnpQZjBVTlF0T1RmVVpNMVNUUTNjd0h3WURWUjBqQkJnd0ZvQVUzOVBwejFZa0VaYjVxTmpwS0ZXaXhpNFkKWkQ4d0lRWURWUjBSQVFIL0JCY3dGWUVUYzNOamFISnZZMnRBWjI5dloyeGxMbU52YlRBc0Jnb3JCZ0VFQVlPLwpNQUVCQkI1b2RIUndjem92TDJkcGRHaDFZaTVqYjIwdmJHOW5hVzR2YjJGMWRHZ3dMZ1lLS3dZQkJBR0R2ekFCCkNBUWdEQjVvZEhSd2N6b3ZMMmRwZEdoMVlpNWpiMjB2Ykc5bmFXNHZiMkYxZEdnd2dZb0dDaXNHQVFRQjFua0MKQkFJRWZBUjZBSGdBZGdEZFBUQnF4c2NSTW1NWkhoeVpaemNDb2twZXVONDhyZitIaW5LQUx5bnVqZ0FBQVlpMQoxNDNUQUFBRUF3QkhNRVVDSUh3eXh1bHFJMm1JdU44ai94TlcrUmJxbGd0S2YzcVlxNzFjSDY4MWZFaHNBaUVBCmhlcVY3SVQzSzNkeEQxTzNXcElFczhsY1RhS1U4K2o1R2g3T1E4OVpOaTB3Q2dZSUtvWkl6ajBFQXdNRFp3QXcKWkFJd0JTT0R3YVJ1Q1hnY0VCRk1KQmh4OVYrSW92ZHdOOXp5SVJ6VWlvTlZrTHRQM281SHVvNVZjcExMcEI5bwpSUXNSQWpBVS9yRmFrU2dJamx4c2cwZWJJS280NVJwdGtDSkh6dUZWS3YzTDdMQitLczlRcnhWb2g4WmhhMVNhCjROemllNkU9Ci0tLS0tRU5EIENFUlRJRklDQVRFLS0tLS0K",
-						},
-					},
-				},
-			},
+			name:      "basic",
+			body:      testBody,
 			wantCount: 1,
 			wantErr:   false,
 		},
@@ -105,7 +112,38 @@ func Test_Body_Matches(t *testing.T) {
 			want: false,
 		},
 		{
-			name:     "not sha256",
+			name:     "sha384",
+			blobPath: "./testdata/uploaded-blob.md",
+			body: Body{
+				Spec: Spec{
+					Data: Data{
+						Hash: Hash{
+							Algorithm: "sha384",
+							Value:     "6dd8d55c3803cfce32fe708eb0dd92e7cba071fb8d028de12897f0e2e51c5ccff91abea7f13a1d011eb6f4f55117fa37",
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name:     "sha512",
+			blobPath: "./testdata/uploaded-blob.md",
+			body: Body{
+				Spec: Spec{
+					Data: Data{
+						Hash: Hash{
+							Algorithm: "sha512",
+							//nolint:lll
+							Value: "3d4c0cacc860df1193f92d054eafa43ac1dbde946be3d90215e4bebcc60aa5219bd59c6a6ac97f05945e6ac50ccadaf7063efbb25031f7ff0cca3e639eb562f5",
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name:     "unsupported algorithm",
 			blobPath: "./testdata/uploaded-blob.md",
 			body: Body{
 				Spec: Spec{
@@ -134,3 +172,45 @@ func Test_Body_Matches(t *testing.T) {
 		})
 	}
 }
+
+func Test_Body_VerifySignature(t *testing.T) {
+	t.Parallel()
+	blob, err := os.ReadFile("./testdata/uploaded-blob.md")
+	if err != nil {
+		t.Fatalf("os.ReadFile: %v", err)
+	}
+	certs, err := testBody.Certs()
+	if err != nil {
+		t.Fatalf("Certs: %v", err)
+	}
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("ecdsa.GenerateKey: %v", err)
+	}
+	unsupported := testBody
+	unsupported.Spec.Data.Hash.Algorithm = "md5"
+
+	tests := []struct {
+		wantErr error
+		body    Body
+		pub     any
+		name    string
+		blob    []byte
+	}{
+		{name: "valid", body: testBody, pub: certs[0].PublicKey, blob: blob},
+		{name: "other blob", body: testBody, pub: certs[0].PublicKey, blob: []byte("other"), wantErr: ErrInvalidSignature},
+		{name: "other key", body: testBody, pub: &other.PublicKey, blob: blob, wantErr: ErrInvalidSignature},
+		{name: "unsupported key", body: testBody, pub: "key", blob: blob, wantErr: errUnsupportedKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := tt.body.VerifySignature(tt.blob, tt.pub); !errors.Is(err, tt.wantErr) {
+				t.Errorf("VerifySignature() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+	if err := unsupported.VerifySignature(blob, certs[0].PublicKey); err == nil {
+		t.Error("VerifySignature() with an md5 hash succeeded")
+	}
+}
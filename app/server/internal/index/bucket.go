@@ -0,0 +1,189 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+)
+
+const (
+	// bucketPrefix keeps index entries apart from results, which always start with a platform,
+	// so the index can live in the results bucket.
+	bucketPrefix = "_index/"
+	// maxBucketScan is the most entries a BucketIndex reads for a search.
+	maxBucketScan = 5000
+)
+
+// ErrTooManyEntries is returned by BucketIndex.Search when the query's platform and org have
+// more entries than it reads for a search. The SQL indexes don't have this limit.
+var ErrTooManyEntries = fmt.Errorf("more than %d index entries to search, filter by platform and org", maxBucketScan)
+
+// BucketIndex stores each entry as a JSON object in a bucket.
+// It needs no database, but every search reads every entry under the platform and org, so
+// searches of more entries than maxBucketScan fail. Put doesn't guard against concurrent writes
+// to the same repository.
+type BucketIndex struct {
+	bucket  *blob.Bucket
+	maxScan int
+}
+
+// NewBucketIndex creates a BucketIndex. The index owns the bucket and closes it on Close.
+func NewBucketIndex(bucket *blob.Bucket) *BucketIndex {
+	return &BucketIndex{bucket: bucket, maxScan: maxBucketScan}
+}
+
+func (b *BucketIndex) objectKey(e *Entry) string {
+	return bucketPrefix + e.key() + ".json"
+}
+
+// Put writes the entry, unless it would replace a newer entry, or an entry from the action with
+// a cron entry of the same time.
+func (b *BucketIndex) Put(ctx context.Context, e *Entry) (bool, error) {
+	existing, err := b.read(ctx, b.objectKey(e))
+	if err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+		return false, err
+	}
+	if !e.replaces(existing) {
+		return false, nil
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return false, fmt.Errorf("json.Marshal: %w", err)
+	}
+	if err := b.bucket.WriteAll(ctx, b.objectKey(e), data, nil); err != nil {
+		return false, fmt.Errorf("bucket.WriteAll: %w", err)
+	}
+	return true, nil
+}
+
+func (b *BucketIndex) read(ctx context.Context, key string) (*Entry, error) {
+	data, err := b.bucket.ReadAll(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("bucket.ReadAll: %w", err)
+	}
+	var e Entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+	return &e, nil
+}
+
+// Search reads the entries under the query's platform and org, then filters, sorts and pages them.
+// The keys are listed before any entry is read, so a search of too many entries fails early.
+func (b *BucketIndex) Search(ctx context.Context, q Query) ([]*Entry, bool, error) {
+	if err := q.Validate(); err != nil {
+		return nil, false, err
+	}
+	prefix := bucketPrefix
+	if q.Platform != "" {
+		prefix += strings.ToLower(q.Platform) + "/"
+		if q.Org != "" {
+			prefix += strings.ToLower(q.Org) + "/"
+		}
+	}
+
+	var keys []string
+	iter := b.bucket.List(&blob.ListOptions{Prefix: prefix})
+	for {
+		obj, err := iter.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("bucket.List: %w", err)
+		}
+		if obj.IsDir || !strings.HasSuffix(obj.Key, ".json") {
+			continue
+		}
+		if len(keys) == b.maxScan {
+			return nil, false, ErrTooManyEntries
+		}
+		keys = append(keys, obj.Key)
+	}
+
+	var entries []*Entry
+	for _, key := range keys {
+		e, err := b.read(ctx, key)
+		if err != nil {
+			log.Printf("skipping index entry %s: %v", key, err)
+			continue
+		}
+		if q.matches(e) {
+			entries = append(entries, e)
+		}
+	}
+
+	sortEntries(entries, &q)
+	if q.Offset >= len(entries) {
+		return nil, false, nil
+	}
+	entries = entries[q.Offset:]
+	if q.Limit > 0 && len(entries) > q.Limit {
+		return entries[:q.Limit], true, nil
+	}
+	return entries, false, nil
+}
+
+// sortEntries orders the entries like the SQL index does.
+func sortEntries(entries []*Entry, q *Query) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		var c int
+		switch q.Sort {
+		case SortScore:
+			sa, _ := q.score(a)
+			sb, _ := q.score(b)
+			c = cmp.Compare(sa, sb)
+		case SortDate:
+			c = cmp.Compare(a.Time().Unix(), b.Time().Unix())
+		}
+		if c == 0 {
+			c = compareNames(a, b)
+		}
+		if q.Descending {
+			return c > 0
+		}
+		return c < 0
+	})
+}
+
+func compareNames(a, b *Entry) int {
+	for _, pair := range [][2]string{
+		{strings.ToLower(a.Platform), strings.ToLower(b.Platform)},
+		{strings.ToLower(a.Org), strings.ToLower(b.Org)},
+		{strings.ToLower(a.Repo), strings.ToLower(b.Repo)},
+	} {
+		if c := strings.Compare(pair[0], pair[1]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// Close closes the bucket.
+func (b *BucketIndex) Close() error {
+	return b.bucket.Close()
+}
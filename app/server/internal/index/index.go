@@ -0,0 +1,244 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package index implements the secondary index of the latest result of each repository,
+// which the listing and search APIs read instead of scanning the result buckets.
+package index
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gocloud.dev/blob"
+)
+
+const (
+	// SourceAction marks entries published by the Scorecard action.
+	SourceAction = "action"
+	// SourceCron marks entries backfilled from the weekly cron scan.
+	SourceCron = "cron"
+
+	inconclusiveScore = -1
+)
+
+var (
+	errInvalidPattern = errors.New("only * and ? wildcards are supported")
+	errInvalidSort    = errors.New("invalid sort")
+)
+
+// Provenance records how a published result was verified.
+type Provenance struct {
+	Branch       string `json:"branch,omitempty"`
+	WorkflowPath string `json:"workflowPath,omitempty"`
+	WorkflowRef  string `json:"workflowRef,omitempty"`
+	TlogIndex    int64  `json:"tlogIndex,omitempty"`
+}
+
+// Entry is the searchable summary of a repository's latest result.
+type Entry struct {
+	Updated          time.Time        `json:"updated"`
+	Provenance       *Provenance      `json:"provenance,omitempty"`
+	Checks           map[string]int64 `json:"checks"`
+	Platform         string           `json:"platform"`
+	Org              string           `json:"org"`
+	Repo             string           `json:"repo"`
+	Commit           string           `json:"commit,omitempty"`
+	Date             string           `json:"date"`
+	ScorecardVersion string           `json:"scorecardVersion,omitempty"`
+	Source           string           `json:"source"`
+	Score            float64          `json:"score"`
+}
+
+// Name is the full name of the repository, e.g. github.com/org/repo.
+func (e *Entry) Name() string {
+	return fmt.Sprintf("%s/%s/%s", e.Platform, e.Org, e.Repo)
+}
+
+// Time is when the result was computed, falling back to when it was indexed.
+func (e *Entry) Time() time.Time {
+	if t, err := ParseDate(e.Date); err == nil {
+		return t
+	}
+	return e.Updated
+}
+
+// key identifies the repository. Repository names are case-insensitive, so it is too.
+func (e *Entry) key() string {
+	return strings.ToLower(e.Name())
+}
+
+// replaces reports whether e should overwrite existing: the newer result wins, as it does when
+// serving results, and results published by the action win ties with cron results.
+func (e *Entry) replaces(existing *Entry) bool {
+	if existing == nil {
+		return true
+	}
+	if t, existingTime := e.Time().Unix(), existing.Time().Unix(); t != existingTime {
+		return t > existingTime
+	}
+	return existing.Source != SourceAction || e.Source == SourceAction
+}
+
+// ParseDate accepts the date formats written by the different Scorecard versions.
+func ParseDate(date string) (time.Time, error) {
+	for _, layout := range []string{time.DateOnly, time.RFC3339} {
+		if t, err := time.Parse(layout, date); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized date %q", date)
+}
+
+// Sort is the order of search results. Ties are broken by name.
+type Sort string
+
+const (
+	// SortName orders by platform, org and repository name.
+	SortName Sort = "name"
+	// SortScore orders by the score of the queried check, or the aggregate score without one.
+	SortScore Sort = "score"
+	// SortDate orders by the date of the result.
+	SortDate Sort = "date"
+)
+
+// Query filters, orders and pages the entries. Zero values don't filter.
+type Query struct {
+	UpdatedSince time.Time
+	MinScore     *float64
+	MaxScore     *float64
+	Platform     string
+	Org          string
+	// Check is the check the score filters and sort apply to, instead of the aggregate score.
+	// Inconclusive check scores never match score filters.
+	Check string
+	// ScorecardVersion may contain * and ? wildcards.
+	ScorecardVersion string
+	Sort             Sort
+	Descending       bool
+	Limit            int
+	Offset           int
+}
+
+// Validate checks the query can be run by every implementation.
+func (q *Query) Validate() error {
+	if strings.ContainsAny(q.ScorecardVersion, `[]\`) {
+		return fmt.Errorf("scorecardVersion %q: %w", q.ScorecardVersion, errInvalidPattern)
+	}
+	switch q.Sort {
+	case "", SortName, SortScore, SortDate:
+	default:
+		return fmt.Errorf("%w: %q", errInvalidSort, q.Sort)
+	}
+	return nil
+}
+
+// score is the score the query's filters and sort apply to.
+func (q *Query) score(e *Entry) (float64, bool) {
+	if q.Check == "" {
+		return e.Score, true
+	}
+	score, ok := e.Checks[q.Check]
+	return float64(score), ok
+}
+
+func (q *Query) matches(e *Entry) bool {
+	if q.Platform != "" && !strings.EqualFold(q.Platform, e.Platform) {
+		return false
+	}
+	if q.Org != "" && !strings.EqualFold(q.Org, e.Org) {
+		return false
+	}
+	if q.ScorecardVersion != "" && !matchVersion(q.ScorecardVersion, e.ScorecardVersion) {
+		return false
+	}
+	if !q.UpdatedSince.IsZero() && e.Time().Before(q.UpdatedSince) {
+		return false
+	}
+	score, ok := q.score(e)
+	if !ok {
+		return false
+	}
+	if q.MinScore == nil && q.MaxScore == nil {
+		return true
+	}
+	if q.Check != "" && score == inconclusiveScore {
+		return false
+	}
+	if q.MinScore != nil && score < *q.MinScore {
+		return false
+	}
+	if q.MaxScore != nil && score > *q.MaxScore {
+		return false
+	}
+	return true
+}
+
+// matchVersion matches * and ? wildcards, the subset of patterns every implementation supports.
+func matchVersion(pattern, version string) bool {
+	if pattern == "" {
+		return version == ""
+	}
+	switch pattern[0] {
+	case '*':
+		for i := 0; i <= len(version); i++ {
+			if matchVersion(pattern[1:], version[i:]) {
+				return true
+			}
+		}
+		return false
+	case '?':
+		return version != "" && matchVersion(pattern[1:], version[1:])
+	default:
+		return version != "" && version[0] == pattern[0] && matchVersion(pattern[1:], version[1:])
+	}
+}
+
+// Index stores one Entry per repository.
+type Index interface {
+	// Put adds or replaces the repository's entry. Entries aren't replaced by older ones, nor by
+	// cron entries of the same time. It reports whether the entry was written.
+	Put(ctx context.Context, e *Entry) (bool, error)
+	// Search returns the entries matching the query, and whether there are more past the limit.
+	Search(ctx context.Context, q Query) ([]*Entry, bool, error)
+	Close() error
+}
+
+// Open opens the index at the given URL. postgres:// and postgresql:// URLs use Postgres,
+// sqlite:// URLs (e.g. sqlite:///var/lib/index.db or sqlite://:memory:) the embedded SQLite,
+// and any other URL is opened as a bucket storing one JSON object per entry.
+func Open(ctx context.Context, indexURL string) (Index, error) {
+	switch {
+	case strings.HasPrefix(indexURL, "postgres://"), strings.HasPrefix(indexURL, "postgresql://"):
+		idx, err := OpenPostgres(ctx, indexURL)
+		if err != nil {
+			return nil, err
+		}
+		return idx, nil
+	case strings.HasPrefix(indexURL, "sqlite://"):
+		idx, err := OpenSQLite(ctx, strings.TrimPrefix(indexURL, "sqlite://"))
+		if err != nil {
+			return nil, err
+		}
+		return idx, nil
+	default:
+		bucket, err := blob.OpenBucket(ctx, indexURL)
+		if err != nil {
+			return nil, fmt.Errorf("blob.OpenBucket: %w", err)
+		}
+		return NewBucketIndex(bucket), nil
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"gocloud.dev/blob/memblob"
+)
+
+func float(f float64) *float64 { return &f }
+
+func testEntries() []*Entry {
+	updated := time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)
+	return []*Entry{
+		{
+			Platform: "github.com", Org: "ossf", Repo: "scorecard", Date: "2024-03-01",
+			Score: 8.2, ScorecardVersion: "v5.0.0", Source: SourceAction, Commit: "abc", Updated: updated,
+			Checks:     map[string]int64{"Branch-Protection": 8, "Dangerous-Workflow": 10},
+			Provenance: &Provenance{Branch: "main", TlogIndex: 42},
+		},
+		{
+			Platform: "github.com", Org: "OSSF", Repo: "scorecard-webapp", Date: "2024-01-01T12:00:00Z",
+			Score: 6.5, ScorecardVersion: "v4.13.1", Source: SourceCron, Updated: updated,
+			Checks: map[string]int64{"Branch-Protection": 3, "Dangerous-Workflow": 0},
+		},
+		{
+			Platform: "github.com", Org: "other", Repo: "repo", Date: "2023-06-01",
+			Score: 2, ScorecardVersion: "v5.1.0", Source: SourceCron, Updated: updated,
+			Checks: map[string]int64{"Branch-Protection": -1, "Dangerous-Workflow": 0},
+		},
+		{
+			Platform: "gitlab.com", Org: "ab-c", Repo: "repo", Date: "2024-02-01",
+			Score: 6.5, ScorecardVersion: "v5.0.0", Source: SourceCron, Updated: updated,
+			Checks: map[string]int64{"Dangerous-Workflow": 10},
+		},
+	}
+}
+
+// implementations opens each Index implementation. Set INDEX_TEST_POSTGRES_URL to include Postgres.
+func implementations(t *testing.T) map[string]Index {
+	t.Helper()
+	ctx := context.Background()
+	sqlite, err := OpenSQLite(ctx, ":memory:")
+	if err != nil {
+		t.Fatalf("OpenSQLite: %v", err)
+	}
+	impls := map[string]Index{
+		"bucket": NewBucketIndex(memblob.OpenBucket(nil)),
+		"sqlite": sqlite,
+	}
+	if url := os.Getenv("INDEX_TEST_POSTGRES_URL"); url != "" {
+		postgres, err := OpenPostgres(ctx, url)
+		if err != nil {
+			t.Fatalf("OpenPostgres: %v", err)
+		}
+		for _, table := range []string{"scorecard_repos", "scorecard_checks"} {
+			if _, err := postgres.db.ExecContext(ctx, "DELETE FROM "+table); err != nil {
+				t.Fatalf("clearing %s: %v", table, err)
+			}
+		}
+		impls["postgres"] = postgres
+	}
+	for _, impl := range impls {
+		t.Cleanup(func() { impl.Close() })
+	}
+	return impls
+}
+
+func TestIndex(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		want     []string
+		query    Query
+		wantMore bool
+	}{
+		{
+			name: "everything by name",
+			want: []string{
+				"github.com/ossf/scorecard", "github.com/OSSF/scorecard-webapp",
+				"github.com/other/repo", "gitlab.com/ab-c/repo",
+			},
+		},
+		{
+			name:  "check below threshold skips inconclusive",
+			query: Query{Check: "Branch-Protection", MaxScore: float(3)},
+			want:  []string{"github.com/OSSF/scorecard-webapp"},
+		},
+		{
+			name:  "check must be present",
+			query: Query{Check: "Branch-Protection"},
+			want:  []string{"github.com/ossf/scorecard", "github.com/OSSF/scorecard-webapp", "github.com/other/repo"},
+		},
+		{
+			name:  "aggregate score range",
+			query: Query{MinScore: float(6), MaxScore: float(7)},
+			want:  []string{"github.com/OSSF/scorecard-webapp", "gitlab.com/ab-c/repo"},
+		},
+		{
+			name:  "platform and org are case-insensitive",
+			query: Query{Platform: "GitHub.com", Org: "ossf"},
+			want:  []string{"github.com/ossf/scorecard", "github.com/OSSF/scorecard-webapp"},
+		},
+		{
+			name:  "scorecard version wildcard",
+			query: Query{ScorecardVersion: "v5.?.*"},
+			want:  []string{"github.com/ossf/scorecard", "github.com/other/repo", "gitlab.com/ab-c/repo"},
+		},
+		{
+			name:  "updated since",
+			query: Query{UpdatedSince: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)},
+			want:  []string{"github.com/ossf/scorecard", "github.com/OSSF/scorecard-webapp", "gitlab.com/ab-c/repo"},
+		},
+		{
+			name:  "worst check scores first",
+			query: Query{Check: "Dangerous-Workflow", Sort: SortScore},
+			want: []string{
+				"github.com/OSSF/scorecard-webapp", "github.com/other/repo",
+				"github.com/ossf/scorecard", "gitlab.com/ab-c/repo",
+			},
+		},
+		{
+			name:  "newest first",
+			query: Query{Sort: SortDate, Descending: true},
+			want: []string{
+				"github.com/ossf/scorecard", "gitlab.com/ab-c/repo",
+				"github.com/OSSF/scorecard-webapp", "github.com/other/repo",
+			},
+		},
+		{
+			name:     "first page",
+			query:    Query{Sort: SortScore, Descending: true, Limit: 2},
+			want:     []string{"github.com/ossf/scorecard", "gitlab.com/ab-c/repo"},
+			wantMore: true,
+		},
+		{
+			name:  "last page",
+			query: Query{Sort: SortScore, Descending: true, Limit: 2, Offset: 2},
+			want:  []string{"github.com/OSSF/scorecard-webapp", "github.com/other/repo"},
+		},
+		{
+			name:  "past the end",
+			query: Query{Limit: 2, Offset: 10},
+		},
+	}
+
+	for implName, idx := range implementations(t) {
+		ctx := context.Background()
+		for _, e := range testEntries() {
+			if ok, err := idx.Put(ctx, e); err != nil || !ok {
+				t.Fatalf("%s: Put() = %v, %v", implName, ok, err)
+			}
+		}
+		for _, tt := range tests {
+			t.Run(implName+"/"+tt.name, func(t *testing.T) {
+				t.Parallel()
+				got, more, err := idx.Search(ctx, tt.query)
+				if err != nil {
+					t.Fatalf("Search: %v", err)
+				}
+				var names []string
+				for _, e := range got {
+					names = append(names, e.Name())
+				}
+				if diff := cmp.Diff(tt.want, names); diff != "" {
+					t.Errorf("Search() mismatch (-want +got):\n%s", diff)
+				}
+				if more != tt.wantMore {
+					t.Errorf("more = %v, want %v", more, tt.wantMore)
+				}
+			})
+		}
+	}
+}
+
+func TestIndex_Put(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	for implName, idx := range implementations(t) {
+		entry := testEntries()[0]
+		if _, err := idx.Put(ctx, entry); err != nil {
+			t.Fatalf("%s: Put: %v", implName, err)
+		}
+		got, _, err := idx.Search(ctx, Query{Org: "ossf"})
+		if err != nil {
+			t.Fatalf("%s: Search: %v", implName, err)
+		}
+		if diff := cmp.Diff([]*Entry{entry}, got); diff != "" {
+			t.Errorf("%s: round trip mismatch (-want +got):\n%s", implName, diff)
+		}
+
+		// Neither an older result nor a cron result of the same date replaces the action's.
+		for _, e := range []*Entry{
+			{Platform: "github.com", Org: "OSSF", Repo: "Scorecard", Source: SourceAction, Date: "2024-02-01"},
+			{Platform: "github.com", Org: "OSSF", Repo: "Scorecard", Source: SourceCron, Date: entry.Date},
+		} {
+			if ok, err := idx.Put(ctx, e); err != nil || ok {
+				t.Errorf("%s: Put(%s %s) = %v, %v, want false", implName, e.Source, e.Date, ok, err)
+			}
+		}
+		// A newer result does, along with its checks, whichever source it's from.
+		for _, e := range []*Entry{
+			{
+				Platform: "github.com", Org: "ossf", Repo: "scorecard", Source: SourceAction, Score: 9,
+				Date: "2024-04-01", Checks: map[string]int64{"License": 10},
+			},
+			{
+				Platform: "github.com", Org: "ossf", Repo: "scorecard", Source: SourceCron, Score: 7,
+				Date: "2024-05-01", Checks: map[string]int64{"License": 9},
+			},
+		} {
+			if ok, err := idx.Put(ctx, e); err != nil || !ok {
+				t.Errorf("%s: Put(%s %s) = %v, %v, want true", implName, e.Source, e.Date, ok, err)
+			}
+			got, _, err = idx.Search(ctx, Query{Org: "ossf"})
+			if err != nil {
+				t.Fatalf("%s: Search: %v", implName, err)
+			}
+			if diff := cmp.Diff([]*Entry{e}, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("%s: replaced entry mismatch (-want +got):\n%s", implName, diff)
+			}
+		}
+	}
+}
+
+func TestBucketIndex_maxScan(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	idx := NewBucketIndex(memblob.OpenBucket(nil))
+	defer idx.Close()
+	idx.maxScan = 2
+	for _, e := range testEntries() {
+		if _, err := idx.Put(ctx, e); err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+	}
+
+	if _, _, err := idx.Search(ctx, Query{}); !errors.Is(err, ErrTooManyEntries) {
+		t.Errorf("Search(all) = %v, want %v", err, ErrTooManyEntries)
+	}
+	got, _, err := idx.Search(ctx, Query{Platform: "github.com", Org: "ossf"})
+	if err != nil {
+		t.Fatalf("Search(org): %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("Search(org) returned %d entries, want 2", len(got))
+	}
+}
+
+func TestQuery_Validate(t *testing.T) {
+	t.Parallel()
+	for _, q := range []Query{{ScorecardVersion: "v[45].*"}, {Sort: "stars"}} {
+		if err := q.Validate(); err == nil {
+			t.Errorf("Validate(%+v) expected error", q)
+		}
+	}
+}
+
+func Test_likePattern(t *testing.T) {
+	t.Parallel()
+	if got, want := likePattern("v5.*_10%?!"), "v5.%!_10!%_!!"; got != want {
+		t.Errorf("likePattern() = %q, want %q", got, want)
+	}
+}
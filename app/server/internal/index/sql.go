@@ -0,0 +1,354 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	_ "github.com/jackc/pgx/v5/stdlib" // Registers the pgx database/sql driver.
+	_ "modernc.org/sqlite"             // Registers the sqlite database/sql driver.
+)
+
+// schema is shared by SQLite and Postgres. Repositories are keyed by their lowercased names,
+// which are also used for filtering and sorting, while the original case is kept for display.
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS scorecard_repos (
+		platform_key TEXT NOT NULL,
+		org_key TEXT NOT NULL,
+		repo_key TEXT NOT NULL,
+		platform TEXT NOT NULL,
+		org TEXT NOT NULL,
+		repo TEXT NOT NULL,
+		commit_sha TEXT NOT NULL,
+		date TEXT NOT NULL,
+		date_unix BIGINT NOT NULL,
+		score DOUBLE PRECISION NOT NULL,
+		scorecard_version TEXT NOT NULL,
+		source TEXT NOT NULL,
+		provenance TEXT NOT NULL,
+		updated TEXT NOT NULL,
+		PRIMARY KEY (platform_key, org_key, repo_key)
+	)`,
+	`CREATE TABLE IF NOT EXISTS scorecard_checks (
+		platform_key TEXT NOT NULL,
+		org_key TEXT NOT NULL,
+		repo_key TEXT NOT NULL,
+		name TEXT NOT NULL,
+		score INTEGER NOT NULL,
+		PRIMARY KEY (platform_key, org_key, repo_key, name)
+	)`,
+	`CREATE INDEX IF NOT EXISTS scorecard_checks_name_score ON scorecard_checks (name, score)`,
+	`CREATE INDEX IF NOT EXISTS scorecard_repos_score ON scorecard_repos (score)`,
+	`CREATE INDEX IF NOT EXISTS scorecard_repos_date ON scorecard_repos (date_unix)`,
+}
+
+const repoColumns = `r.platform, r.org, r.repo, r.commit_sha, r.date, r.score, ` +
+	`r.scorecard_version, r.source, r.provenance, r.updated`
+
+// SQLIndex stores entries in SQLite or Postgres.
+type SQLIndex struct {
+	db       *sql.DB
+	postgres bool
+}
+
+// OpenSQLite opens the embedded SQLite index at path, which may be :memory: for a throwaway index.
+func OpenSQLite(ctx context.Context, path string) (*SQLIndex, error) {
+	// Postgres' LIKE is case-sensitive, so SQLite's is made to match.
+	dsn := "file:" + path + "?_pragma=case_sensitive_like(1)&_pragma=busy_timeout(5000)"
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("sql.Open: %w", err)
+	}
+	// SQLite only allows one writer, and every connection to :memory: is a separate database.
+	db.SetMaxOpenConns(1)
+	return newSQLIndex(ctx, db, false)
+}
+
+// OpenPostgres opens the index in the Postgres database at the URL.
+func OpenPostgres(ctx context.Context, url string) (*SQLIndex, error) {
+	db, err := sql.Open("pgx", url)
+	if err != nil {
+		return nil, fmt.Errorf("sql.Open: %w", err)
+	}
+	return newSQLIndex(ctx, db, true)
+}
+
+func newSQLIndex(ctx context.Context, db *sql.DB, postgres bool) (*SQLIndex, error) {
+	for _, stmt := range schema {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("creating schema: %w", err)
+		}
+	}
+	return &SQLIndex{db: db, postgres: postgres}, nil
+}
+
+// rebind converts the ? placeholders to Postgres' numbered ones.
+func (s *SQLIndex) rebind(query string) string {
+	if !s.postgres {
+		return query
+	}
+	var sb strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			sb.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// Put upserts the entry and replaces its checks in a single transaction.
+func (s *SQLIndex) Put(ctx context.Context, e *Entry) (bool, error) {
+	provenance := ""
+	if e.Provenance != nil {
+		b, err := json.Marshal(e.Provenance)
+		if err != nil {
+			return false, fmt.Errorf("json.Marshal: %w", err)
+		}
+		provenance = string(b)
+	}
+	keys := []any{strings.ToLower(e.Platform), strings.ToLower(e.Org), strings.ToLower(e.Repo)}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("db.BeginTx: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck // no-op after Commit.
+
+	res, err := tx.ExecContext(ctx, s.rebind(`INSERT INTO scorecard_repos (
+			platform_key, org_key, repo_key, platform, org, repo, commit_sha, date, date_unix,
+			score, scorecard_version, source, provenance, updated
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (platform_key, org_key, repo_key) DO UPDATE SET
+			platform = excluded.platform, org = excluded.org, repo = excluded.repo,
+			commit_sha = excluded.commit_sha, date = excluded.date, date_unix = excluded.date_unix,
+			score = excluded.score, scorecard_version = excluded.scorecard_version,
+			source = excluded.source, provenance = excluded.provenance, updated = excluded.updated
+		WHERE excluded.date_unix > scorecard_repos.date_unix OR (excluded.date_unix = scorecard_repos.date_unix
+			AND (scorecard_repos.source <> ? OR excluded.source = ?))`),
+		append(keys, e.Platform, e.Org, e.Repo, e.Commit, e.Date, e.Time().Unix(),
+			e.Score, e.ScorecardVersion, e.Source, provenance, e.Updated.UTC().Format(time.RFC3339Nano),
+			SourceAction, SourceAction)...)
+	if err != nil {
+		return false, fmt.Errorf("upserting repo: %w", err)
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		// An older entry, or a cron entry, didn't replace the existing one.
+		return false, err
+	}
+
+	if _, err := tx.ExecContext(ctx, s.rebind(
+		`DELETE FROM scorecard_checks WHERE platform_key = ? AND org_key = ? AND repo_key = ?`), keys...); err != nil {
+		return false, fmt.Errorf("deleting checks: %w", err)
+	}
+	for name, score := range e.Checks {
+		if _, err := tx.ExecContext(ctx, s.rebind(
+			`INSERT INTO scorecard_checks (platform_key, org_key, repo_key, name, score) VALUES (?, ?, ?, ?, ?)`),
+			append(keys, name, score)...); err != nil {
+			return false, fmt.Errorf("inserting check: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("tx.Commit: %w", err)
+	}
+	return true, nil
+}
+
+// Search runs the query in the database, then loads the checks of the returned entries.
+func (s *SQLIndex) Search(ctx context.Context, q Query) ([]*Entry, bool, error) {
+	if err := q.Validate(); err != nil {
+		return nil, false, err
+	}
+	query, args := s.searchQuery(&q)
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, false, fmt.Errorf("db.Query: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*Entry
+	for rows.Next() {
+		var e Entry
+		var provenance, updated string
+		if err := rows.Scan(&e.Platform, &e.Org, &e.Repo, &e.Commit, &e.Date, &e.Score,
+			&e.ScorecardVersion, &e.Source, &provenance, &updated); err != nil {
+			return nil, false, fmt.Errorf("rows.Scan: %w", err)
+		}
+		if provenance != "" {
+			e.Provenance = &Provenance{}
+			if err := json.Unmarshal([]byte(provenance), e.Provenance); err != nil {
+				return nil, false, fmt.Errorf("json.Unmarshal: %w", err)
+			}
+		}
+		if e.Updated, err = time.Parse(time.RFC3339Nano, updated); err != nil {
+			return nil, false, fmt.Errorf("time.Parse: %w", err)
+		}
+		entries = append(entries, &e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	more := q.Limit > 0 && len(entries) > q.Limit
+	if more {
+		entries = entries[:q.Limit]
+	}
+	if err := s.loadChecks(ctx, entries); err != nil {
+		return nil, false, err
+	}
+	return entries, more, nil
+}
+
+func (s *SQLIndex) searchQuery(q *Query) (string, []any) {
+	var (
+		sb    strings.Builder
+		where []string
+		args  []any
+	)
+	scoreExpr := "r.score"
+	sb.WriteString("SELECT " + repoColumns + " FROM scorecard_repos r")
+	if q.Check != "" {
+		sb.WriteString(" JOIN scorecard_checks c ON c.platform_key = r.platform_key" +
+			" AND c.org_key = r.org_key AND c.repo_key = r.repo_key AND c.name = ?")
+		args = append(args, q.Check)
+		scoreExpr = "c.score"
+	}
+	if q.Platform != "" {
+		where = append(where, "r.platform_key = ?")
+		args = append(args, strings.ToLower(q.Platform))
+	}
+	if q.Org != "" {
+		where = append(where, "r.org_key = ?")
+		args = append(args, strings.ToLower(q.Org))
+	}
+	if q.ScorecardVersion != "" {
+		where = append(where, "r.scorecard_version LIKE ? ESCAPE '!'")
+		args = append(args, likePattern(q.ScorecardVersion))
+	}
+	if !q.UpdatedSince.IsZero() {
+		where = append(where, "r.date_unix >= ?")
+		args = append(args, q.UpdatedSince.Unix())
+	}
+	if q.Check != "" && (q.MinScore != nil || q.MaxScore != nil) {
+		where = append(where, "c.score <> ?")
+		args = append(args, inconclusiveScore)
+	}
+	if q.MinScore != nil {
+		where = append(where, scoreExpr+" >= ?")
+		args = append(args, *q.MinScore)
+	}
+	if q.MaxScore != nil {
+		where = append(where, scoreExpr+" <= ?")
+		args = append(args, *q.MaxScore)
+	}
+	if len(where) > 0 {
+		sb.WriteString(" WHERE " + strings.Join(where, " AND "))
+	}
+
+	dir := " ASC"
+	if q.Descending {
+		dir = " DESC"
+	}
+	order := []string{"r.platform_key", "r.org_key", "r.repo_key"}
+	switch q.Sort {
+	case SortScore:
+		order = append([]string{scoreExpr}, order...)
+	case SortDate:
+		order = append([]string{"r.date_unix"}, order...)
+	}
+	sb.WriteString(" ORDER BY " + strings.Join(order, dir+", ") + dir)
+
+	// Fetch one more than the limit to tell whether there are more.
+	limit := int64(math.MaxInt32)
+	if q.Limit > 0 {
+		limit = int64(q.Limit) + 1
+	}
+	sb.WriteString(" LIMIT ? OFFSET ?")
+	args = append(args, limit, q.Offset)
+	return s.rebind(sb.String()), args
+}
+
+// likePattern converts * and ? wildcards into a LIKE pattern escaped with !.
+func likePattern(pattern string) string {
+	var sb strings.Builder
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			sb.WriteByte('%')
+		case '?':
+			sb.WriteByte('_')
+		case '%', '_', '!':
+			sb.WriteRune('!')
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+func (s *SQLIndex) loadChecks(ctx context.Context, entries []*Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	byKey := map[[3]string]*Entry{}
+	values := make([]string, 0, len(entries))
+	args := make([]any, 0, 3*len(entries))
+	for _, e := range entries {
+		e.Checks = map[string]int64{}
+		key := [3]string{strings.ToLower(e.Platform), strings.ToLower(e.Org), strings.ToLower(e.Repo)}
+		byKey[key] = e
+		values = append(values, "(?, ?, ?)")
+		args = append(args, key[0], key[1], key[2])
+	}
+	rows, err := s.db.QueryContext(ctx, s.rebind(
+		"SELECT platform_key, org_key, repo_key, name, score FROM scorecard_checks"+
+			" WHERE (platform_key, org_key, repo_key) IN (VALUES "+strings.Join(values, ", ")+")"), args...)
+	if err != nil {
+		return fmt.Errorf("db.Query: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var key [3]string
+		var name string
+		var score int64
+		if err := rows.Scan(&key[0], &key[1], &key[2], &name, &score); err != nil {
+			return fmt.Errorf("rows.Scan: %w", err)
+		}
+		if e, ok := byKey[key]; ok {
+			e.Checks[name] = score
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("rows.Err: %w", err)
+	}
+	return nil
+}
+
+// Close closes the database.
+func (s *SQLIndex) Close() error {
+	return s.db.Close()
+}
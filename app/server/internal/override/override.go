@@ -0,0 +1,313 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package override stores the overrides of published results set by admins: tombstones, which take
+// results down, and annotations. Every change is recorded in an audit log.
+package override
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/memblob"
+	"gocloud.dev/gcerrors"
+)
+
+// Actions changing an override.
+const (
+	ActionTombstone = "tombstone"
+	ActionRestore   = "restore"
+	ActionAnnotate  = "annotate"
+)
+
+const (
+	overridePrefix = "overrides/"
+	auditPrefix    = "audit/"
+	repoFile       = "repository.json"
+	commitsDir     = "commits/"
+)
+
+var (
+	// ErrNoOverride is returned when restoring results which have no override.
+	ErrNoOverride = errors.New("no override to restore")
+
+	errUnknownAction = errors.New("unknown action")
+)
+
+// Override is the state set by admins of a repository's results, or of a commit's result.
+type Override struct {
+	UpdatedAt time.Time `json:"updatedAt"`
+	Host      string    `json:"host"`
+	Org       string    `json:"org"`
+	Repo      string    `json:"repo"`
+	Commit    string    `json:"commit,omitempty"`
+	// Reason is the reason of the latest change.
+	Reason string `json:"reason"`
+	// TombstoneReason is why the results were taken down, while they are.
+	TombstoneReason string `json:"tombstoneReason,omitempty"`
+	Annotation      string `json:"annotation,omitempty"`
+	UpdatedBy       string `json:"updatedBy"`
+	Tombstoned      bool   `json:"tombstoned"`
+}
+
+// Change is a change of an override by an admin. Commit is empty to change the repository's.
+type Change struct {
+	Actor      string `json:"actor"`
+	Action     string `json:"action"`
+	Host       string `json:"host"`
+	Org        string `json:"org"`
+	Repo       string `json:"repo"`
+	Commit     string `json:"commit,omitempty"`
+	Reason     string `json:"reason"`
+	Annotation string `json:"annotation,omitempty"`
+}
+
+// Event is an entry of the audit log.
+type Event struct {
+	Time time.Time `json:"time"`
+	Change
+}
+
+// Store keeps the overrides and the audit log in a bucket.
+type Store struct {
+	bucket *blob.Bucket
+	now    func() time.Time
+	// mu serializes the changes made through this Store.
+	mu sync.Mutex
+}
+
+// NewStore creates a Store keeping overrides in bucket, or in memory if nil.
+func NewStore(bucket *blob.Bucket) *Store {
+	if bucket == nil {
+		bucket = memblob.OpenBucket(nil)
+	}
+	return &Store{bucket: bucket, now: time.Now}
+}
+
+// Resolve returns the override applying to the repository's latest result, or to the commit's
+// result if commit isn't empty: tombstoned if either the repository or the commit is, with
+// the TombstoneReason of that tombstone, and annotated with the commit's annotation, or the repository's.
+// The zero Override is returned if none applies, or if s is nil.
+func (s *Store) Resolve(ctx context.Context, host, org, repo, commit string) (Override, error) {
+	if s == nil {
+		return Override{}, nil
+	}
+	repoOverride, err := s.get(ctx, overrideKey(host, org, repo, ""))
+	if err != nil {
+		return Override{}, err
+	}
+	if commit == "" {
+		return repoOverride, nil
+	}
+	commitOverride, err := s.get(ctx, overrideKey(host, org, repo, commit))
+	if err != nil {
+		return Override{}, err
+	}
+	return resolve(repoOverride, commitOverride), nil
+}
+
+// OrgOverrides are the overrides of an organization's repositories and commits, see Store.Org.
+type OrgOverrides map[string]Override
+
+// Org returns the overrides of every repository of the organization, and of their commits,
+// to resolve many of its results without reading each of their overrides. It returns nil if s is nil.
+func (s *Store) Org(ctx context.Context, host, org string) (OrgOverrides, error) {
+	if s == nil {
+		return nil, nil
+	}
+	overrides := OrgOverrides{}
+	err := s.each(ctx, overridePrefix+repoPath(host, org, "")+"/", func(b []byte) error {
+		var o Override
+		if err := json.Unmarshal(b, &o); err != nil {
+			return fmt.Errorf("decoding override: %w", err)
+		}
+		overrides[overrideKey(o.Host, o.Org, o.Repo, o.Commit)] = o
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// Resolve is like Store.Resolve, for a repository of the organization.
+func (o OrgOverrides) Resolve(host, org, repo, commit string) Override {
+	repoOverride := o[overrideKey(host, org, repo, "")]
+	if commit == "" {
+		return repoOverride
+	}
+	return resolve(repoOverride, o[overrideKey(host, org, repo, commit)])
+}
+
+// resolve combines the overrides of a repository and of one of its commits, see Store.Resolve.
+func resolve(repoOverride, commitOverride Override) Override {
+	resolved := repoOverride
+	if commitOverride.Tombstoned && !resolved.Tombstoned {
+		resolved.Tombstoned, resolved.TombstoneReason = true, commitOverride.TombstoneReason
+	}
+	if commitOverride.Annotation != "" {
+		resolved.Annotation = commitOverride.Annotation
+	}
+	return resolved
+}
+
+// Apply makes the change, recording it in the audit log first, and returns the updated override.
+func (s *Store) Apply(ctx context.Context, c Change) (*Override, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := overrideKey(c.Host, c.Org, c.Repo, c.Commit)
+	o, err := s.get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	exists := !o.UpdatedAt.IsZero()
+	switch c.Action {
+	case ActionTombstone:
+		o.Tombstoned, o.TombstoneReason = true, c.Reason
+	case ActionRestore:
+		if !exists {
+			return nil, ErrNoOverride
+		}
+		o.Tombstoned, o.TombstoneReason = false, ""
+	case ActionAnnotate:
+		o.Annotation = c.Annotation
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnknownAction, c.Action)
+	}
+	now := s.now().UTC()
+	o.Host, o.Org, o.Repo, o.Commit = c.Host, c.Org, c.Repo, strings.ToLower(c.Commit)
+	o.Reason, o.UpdatedBy, o.UpdatedAt = c.Reason, c.Actor, now
+
+	id, err := eventID(now)
+	if err != nil {
+		return nil, err
+	}
+	auditKey := auditPrefix + repoPath(c.Host, c.Org, c.Repo) + "/" + id + ".json"
+	if err := s.write(ctx, auditKey, Event{Time: now, Change: c}); err != nil {
+		return nil, fmt.Errorf("recording audit event: %w", err)
+	}
+	if err := s.write(ctx, key, o); err != nil {
+		return nil, fmt.Errorf("saving override: %w", err)
+	}
+	return &o, nil
+}
+
+// List returns the overrides of the repository and its commits, the repository's first.
+func (s *Store) List(ctx context.Context, host, org, repo string) ([]Override, error) {
+	var overrides []Override
+	err := s.each(ctx, overridePrefix+repoPath(host, org, repo)+"/", func(b []byte) error {
+		var o Override
+		if err := json.Unmarshal(b, &o); err != nil {
+			return fmt.Errorf("decoding override: %w", err)
+		}
+		overrides = append(overrides, o)
+		return nil
+	})
+	sort.SliceStable(overrides, func(i, j int) bool { return overrides[i].Commit < overrides[j].Commit })
+	return overrides, err
+}
+
+// Audit returns the audit log of the repository, oldest first.
+func (s *Store) Audit(ctx context.Context, host, org, repo string) ([]Event, error) {
+	var events []Event
+	err := s.each(ctx, auditPrefix+repoPath(host, org, repo)+"/", func(b []byte) error {
+		var e Event
+		if err := json.Unmarshal(b, &e); err != nil {
+			return fmt.Errorf("decoding audit event: %w", err)
+		}
+		events = append(events, e)
+		return nil
+	})
+	return events, err
+}
+
+// get returns the override stored at key, or the zero Override if there is none.
+func (s *Store) get(ctx context.Context, key string) (Override, error) {
+	var o Override
+	b, err := s.bucket.ReadAll(ctx, key)
+	if gcerrors.Code(err) == gcerrors.NotFound {
+		return o, nil
+	}
+	if err != nil {
+		return o, fmt.Errorf("bucket.ReadAll: %w", err)
+	}
+	if err := json.Unmarshal(b, &o); err != nil {
+		return o, fmt.Errorf("decoding override: %w", err)
+	}
+	return o, nil
+}
+
+func (s *Store) write(ctx context.Context, key string, v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+	if err := s.bucket.WriteAll(ctx, key, b, nil); err != nil {
+		return fmt.Errorf("bucket.WriteAll: %w", err)
+	}
+	return nil
+}
+
+// each calls f with every object under prefix, in key order.
+func (s *Store) each(ctx context.Context, prefix string, f func([]byte) error) error {
+	iter := s.bucket.List(&blob.ListOptions{Prefix: prefix})
+	for {
+		obj, err := iter.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("listing %s: %w", prefix, err)
+		}
+		b, err := s.bucket.ReadAll(ctx, obj.Key)
+		if err != nil {
+			return fmt.Errorf("bucket.ReadAll: %w", err)
+		}
+		if err := f(b); err != nil {
+			return err
+		}
+	}
+}
+
+// repoPath identifies the repository in keys. Repository names are case-insensitive, so it is too.
+func repoPath(host, org, repo string) string {
+	return strings.ToLower(path.Join(host, org, repo))
+}
+
+func overrideKey(host, org, repo, commit string) string {
+	if commit == "" {
+		return overridePrefix + repoPath(host, org, repo) + "/" + repoFile
+	}
+	return overridePrefix + repoPath(host, org, repo) + "/" + commitsDir + strings.ToLower(commit) + ".json"
+}
+
+func eventID(now time.Time) (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("rand.Read: %w", err)
+	}
+	// Prefixing with the time keeps events listed in order.
+	return fmt.Sprintf("%020d-%s", now.UnixNano(), hex.EncodeToString(b)), nil
+}
@@ -0,0 +1,192 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package override
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"gocloud.dev/blob/memblob"
+)
+
+const testCommit = "0123456789abcdef0123456789abcdef01234567"
+
+func change(action, commit, reason string) Change {
+	return Change{
+		Actor: "admin", Action: action, Host: "github.com", Org: "org", Repo: "repo",
+		Commit: commit, Reason: reason,
+	}
+}
+
+func TestStore_Resolve(t *testing.T) {
+	t.Parallel()
+	annotate := func(commit, annotation string) Change {
+		c := change(ActionAnnotate, commit, "note")
+		c.Annotation = annotation
+		return c
+	}
+	tests := []struct {
+		name    string
+		commit  string
+		changes []Change
+		want    Override
+	}{
+		{name: "none"},
+		{
+			name:    "tombstoned repository",
+			changes: []Change{change(ActionTombstone, "", "takedown request")},
+			want:    Override{Tombstoned: true, TombstoneReason: "takedown request"},
+		},
+		{
+			name:    "tombstoned repository, commit",
+			commit:  testCommit,
+			changes: []Change{change(ActionTombstone, "", "takedown request")},
+			want:    Override{Tombstoned: true, TombstoneReason: "takedown request"},
+		},
+		{
+			name:    "tombstoned commit",
+			commit:  testCommit,
+			changes: []Change{change(ActionTombstone, testCommit, "leaked secret")},
+			want:    Override{Tombstoned: true, TombstoneReason: "leaked secret"},
+		},
+		{
+			name:    "tombstoned commit, latest result",
+			changes: []Change{change(ActionTombstone, testCommit, "leaked secret")},
+		},
+		{
+			name:   "restored",
+			commit: testCommit,
+			changes: []Change{
+				change(ActionTombstone, testCommit, "leaked secret"),
+				change(ActionRestore, testCommit, "removed"),
+			},
+			want: Override{Reason: "removed"},
+		},
+		{
+			name:   "annotated tombstone",
+			commit: testCommit,
+			changes: []Change{
+				change(ActionTombstone, "", "takedown request"),
+				annotate("", "under review"),
+			},
+			want: Override{Tombstoned: true, TombstoneReason: "takedown request", Annotation: "under review"},
+		},
+		{
+			name:    "commit annotation wins",
+			commit:  testCommit,
+			changes: []Change{annotate("", "repository note"), annotate(testCommit, "commit note")},
+			want:    Override{Reason: "note", Annotation: "commit note"},
+		},
+		{
+			name:    "repository annotation",
+			commit:  testCommit,
+			changes: []Change{annotate("", "repository note")},
+			want:    Override{Reason: "note", Annotation: "repository note"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			s := NewStore(nil)
+			for _, c := range tt.changes {
+				if _, err := s.Apply(ctx, c); err != nil {
+					t.Fatalf("Apply: %v", err)
+				}
+			}
+			got, err := s.Resolve(ctx, "github.com", "Org", "Repo", tt.commit)
+			if err != nil {
+				t.Fatalf("Resolve: %v", err)
+			}
+			if got.Tombstoned != tt.want.Tombstoned || got.Annotation != tt.want.Annotation ||
+				got.TombstoneReason != tt.want.TombstoneReason {
+				t.Errorf("Resolve() = %+v, want %+v", got, tt.want)
+			}
+			org, err := s.Org(ctx, "GitHub.com", "ORG")
+			if err != nil {
+				t.Fatalf("Org: %v", err)
+			}
+			if diff := cmp.Diff(got, org.Resolve("github.com", "Org", "Repo", tt.commit)); diff != "" {
+				t.Errorf("Org().Resolve() mismatch (-Resolve() +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestStore_Apply(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	s := NewStore(memblob.OpenBucket(nil))
+	tick := now
+	s.now = func() time.Time {
+		tick = tick.Add(time.Second)
+		return tick
+	}
+
+	if _, err := s.Apply(ctx, change(ActionRestore, "", "nothing to restore")); !errors.Is(err, ErrNoOverride) {
+		t.Errorf("Apply(restore) = %v, want ErrNoOverride", err)
+	}
+	if _, err := s.Apply(ctx, change("delete", "", "unknown")); !errors.Is(err, errUnknownAction) {
+		t.Errorf("Apply(delete) = %v, want errUnknownAction", err)
+	}
+
+	changes := []Change{
+		change(ActionTombstone, "", "takedown request"),
+		change(ActionTombstone, "0123456789ABCDEF0123456789ABCDEF01234567", "leaked secret"),
+		change(ActionRestore, "", "request withdrawn"),
+	}
+	for _, c := range changes {
+		if _, err := s.Apply(ctx, c); err != nil {
+			t.Fatalf("Apply: %v", err)
+		}
+	}
+
+	overrides, err := s.List(ctx, "github.com", "org", "repo")
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	wantOverrides := []Override{
+		{
+			UpdatedAt: now.Add(3 * time.Second), Host: "github.com", Org: "org", Repo: "repo",
+			Reason: "request withdrawn", UpdatedBy: "admin",
+		},
+		{
+			UpdatedAt: now.Add(2 * time.Second), Host: "github.com", Org: "org", Repo: "repo", Commit: testCommit,
+			Reason: "leaked secret", TombstoneReason: "leaked secret", UpdatedBy: "admin", Tombstoned: true,
+		},
+	}
+	if diff := cmp.Diff(wantOverrides, overrides); diff != "" {
+		t.Errorf("List() mismatch (-want +got):\n%s", diff)
+	}
+
+	events, err := s.Audit(ctx, "github.com", "org", "repo")
+	if err != nil {
+		t.Fatalf("Audit: %v", err)
+	}
+	var got []Change
+	for i, e := range events {
+		if want := now.Add(time.Duration(i+1) * time.Second); !e.Time.Equal(want) {
+			t.Errorf("event time = %v, want %v", e.Time, want)
+		}
+		got = append(got, e.Change)
+	}
+	if diff := cmp.Diff(changes, got); diff != "" {
+		t.Errorf("Audit() mismatch (-want +got):\n%s", diff)
+	}
+}
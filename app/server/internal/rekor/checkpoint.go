@@ -0,0 +1,116 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rekor
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ossf/scorecard-webapp/app/server/internal/hashedrekord"
+)
+
+var (
+	errMalformedCheckpoint = errors.New("malformed checkpoint")
+	// ErrNoCheckpointSignature is returned when a checkpoint isn't signed by the expected key.
+	ErrNoCheckpointSignature = errors.New("no checkpoint signature by the log's key")
+)
+
+// Checkpoint is a log's signed tree head, in the signed note format.
+// See https://github.com/transparency-dev/formats/blob/main/log/README.md
+type Checkpoint struct {
+	// Origin identifies the log, e.g. "rekor.sigstore.dev - 2605736670972794746" for the
+	// public good instance's shard with tree ID 2605736670972794746.
+	Origin     string
+	RootHash   []byte
+	text       string
+	signatures []noteSignature
+	Size       uint64
+}
+
+// noteSignature is a signature line of a signed note.
+type noteSignature struct {
+	name      string
+	signature []byte
+	keyHash   [4]byte
+}
+
+// ParseCheckpoint parses a signed checkpoint, without verifying its signatures.
+func ParseCheckpoint(note string) (*Checkpoint, error) {
+	text, sigs, ok := strings.Cut(note, "\n\n")
+	if !ok {
+		return nil, fmt.Errorf("%w: no signatures", errMalformedCheckpoint)
+	}
+	text += "\n"
+	lines := strings.SplitN(text, "\n", 4)
+	if len(lines) < 4 || lines[0] == "" {
+		return nil, fmt.Errorf("%w: want origin, size and root hash", errMalformedCheckpoint)
+	}
+	size, err := strconv.ParseUint(lines[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: size: %w", errMalformedCheckpoint, err)
+	}
+	rootHash, err := base64.StdEncoding.DecodeString(lines[2])
+	if err != nil || len(rootHash) != sha256.Size {
+		return nil, fmt.Errorf("%w: root hash %q", errMalformedCheckpoint, lines[2])
+	}
+	c := &Checkpoint{Origin: lines[0], Size: size, RootHash: rootHash, text: text}
+
+	for _, line := range strings.Split(strings.TrimSuffix(sigs, "\n"), "\n") {
+		// "— <name> <base64 of the key hash followed by the signature>"
+		rest, ok := strings.CutPrefix(line, "— ")
+		name, b64, ok2 := strings.Cut(rest, " ")
+		sig, err := base64.StdEncoding.DecodeString(b64)
+		if !ok || !ok2 || err != nil || len(sig) <= 4 {
+			return nil, fmt.Errorf("%w: signature line %q", errMalformedCheckpoint, line)
+		}
+		s := noteSignature{name: name, signature: sig[4:]}
+		copy(s.keyHash[:], sig)
+		c.signatures = append(c.signatures, s)
+	}
+	return c, nil
+}
+
+// TreeID returns the ID of the log shard from the checkpoint's origin, or "" if it has none.
+func (c *Checkpoint) TreeID() string {
+	_, id, _ := strings.Cut(c.Origin, " - ")
+	return id
+}
+
+// Verify checks the checkpoint is signed by the log's key. Like Rekor, signatures are identified
+// by the first 4 bytes of the SHA-256 of the key's DER encoding.
+func (c *Checkpoint) Verify(pub crypto.PublicKey) error {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return fmt.Errorf("encoding public key: %w", err)
+	}
+	keyHash := sha256.Sum256(der)
+	for _, s := range c.signatures {
+		if !bytes.Equal(s.keyHash[:], keyHash[:4]) {
+			continue
+		}
+		if err := hashedrekord.VerifySignature(pub, []byte(c.text), crypto.SHA256, s.signature); err != nil {
+			return fmt.Errorf("checkpoint signature by %s: %w", s.name, err)
+		}
+		return nil
+	}
+	return ErrNoCheckpointSignature
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rekor
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ossf/scorecard-webapp/app/server/internal/hashedrekord"
+)
+
+// testCheckpoint is the checkpoint of the saved entry's inclusion proof.
+const testCheckpoint = "rekor.sigstore.dev - 2605736670972794746\n19488771\n" +
+	"84/Lpa5FpT4LPld1d1pNw6zvHFK0Ek9h7uPTlngdhLc=\nTimestamp: 1686678005992195077\n\n" +
+	"— rekor.sigstore.dev wNI9ajBFAiA8YlKEMJZTnw//agkOBkgh5bx/oqnKhJneZfNSeaOCOwIhAMNxEc5a" +
+	"fCZ60Skjq0ly4uQa7BxAtQMD7QJh1WMPJqDa\n"
+
+func rekorPublicKey(t *testing.T) crypto.PublicKey {
+	t.Helper()
+	b, err := os.ReadFile("../../rekor.pub")
+	if err != nil {
+		t.Fatalf("os.ReadFile: %v", err)
+	}
+	block, _ := pem.Decode(b)
+	if block == nil {
+		t.Fatal("no PEM block in rekor.pub")
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		t.Fatalf("x509.ParsePKIXPublicKey: %v", err)
+	}
+	return pub
+}
+
+func TestParseCheckpoint(t *testing.T) {
+	t.Parallel()
+	c, err := ParseCheckpoint(testCheckpoint)
+	if err != nil {
+		t.Fatalf("ParseCheckpoint: %v", err)
+	}
+	root, _ := base64.StdEncoding.DecodeString("84/Lpa5FpT4LPld1d1pNw6zvHFK0Ek9h7uPTlngdhLc=")
+	if c.Origin != "rekor.sigstore.dev - 2605736670972794746" || c.Size != 19488771 ||
+		string(c.RootHash) != string(root) || c.TreeID() != "2605736670972794746" {
+		t.Errorf("ParseCheckpoint() = %+v", c)
+	}
+
+	malformed := map[string]string{
+		"no signatures":   strings.SplitAfter(testCheckpoint, "\n\n")[0],
+		"invalid size":    strings.Replace(testCheckpoint, "19488771", "many", 1),
+		"invalid root":    strings.Replace(testCheckpoint, "84/Lpa5F", "84/Lpa5", 1),
+		"no root":         "rekor.sigstore.dev\n1\n\n— rekor.sigstore.dev wNI9ajBF\n",
+		"invalid line":    strings.Replace(testCheckpoint, "— ", "- ", 1),
+		"short signature": strings.SplitAfter(testCheckpoint, "\n\n")[0] + "— rekor.sigstore.dev wNI9ag==\n",
+	}
+	for name, note := range malformed {
+		if _, err := ParseCheckpoint(note); !errors.Is(err, errMalformedCheckpoint) {
+			t.Errorf("ParseCheckpoint(%s) = %v, want errMalformedCheckpoint", name, err)
+		}
+	}
+}
+
+func TestCheckpoint_Verify(t *testing.T) {
+	t.Parallel()
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("ecdsa.GenerateKey: %v", err)
+	}
+	tests := []struct {
+		wantErr error
+		pub     crypto.PublicKey
+		name    string
+		note    string
+	}{
+		{name: "valid", note: testCheckpoint, pub: rekorPublicKey(t)},
+		{
+			name: "tampered", note: strings.Replace(testCheckpoint, "Timestamp: 1", "Timestamp: 2", 1),
+			pub: rekorPublicKey(t), wantErr: hashedrekord.ErrInvalidSignature,
+		},
+		{name: "other key", note: testCheckpoint, pub: &other.PublicKey, wantErr: ErrNoCheckpointSignature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c, err := ParseCheckpoint(tt.note)
+			if err != nil {
+				t.Fatalf("ParseCheckpoint: %v", err)
+			}
+			if err := c.Verify(tt.pub); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Verify() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
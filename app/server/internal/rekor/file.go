@@ -0,0 +1,156 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rekor
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// FileClient implements Client with entries saved as files, for tests and local development.
+type FileClient struct {
+	entries map[string]*Entry
+	uuids   map[int64]string
+	hashes  map[string][]string
+	proofs  map[[2]uint64]*ConsistencyProof
+}
+
+// NewFileClient loads the entries saved in dir. Each *.json file holds a log entries response,
+// like the ones returned by /api/v1/log/entries?logIndex=<index>. Consistency proofs are
+// saved in dir/proofs, as <firstSize>-<lastSize>.json files holding the responses of
+// /api/v1/log/proof.
+func NewFileClient(dir string) (*FileClient, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("filepath.Glob: %w", err)
+	}
+	sort.Strings(paths)
+	c := &FileClient{
+		entries: map[string]*Entry{},
+		uuids:   map[int64]string{},
+		hashes:  map[string][]string{},
+		proofs:  map[[2]uint64]*ConsistencyProof{},
+	}
+	for _, path := range paths {
+		if err := c.load(path); err != nil {
+			return nil, fmt.Errorf("loading %s: %w", path, err)
+		}
+	}
+	proofs, err := filepath.Glob(filepath.Join(dir, "proofs", "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("filepath.Glob: %w", err)
+	}
+	for _, path := range proofs {
+		if err := c.loadProof(path); err != nil {
+			return nil, fmt.Errorf("loading %s: %w", path, err)
+		}
+	}
+	return c, nil
+}
+
+func (c *FileClient) loadProof(path string) error {
+	var sizes [2]uint64
+	if _, err := fmt.Sscanf(filepath.Base(path), "%d-%d.json", &sizes[0], &sizes[1]); err != nil {
+		return fmt.Errorf("want <firstSize>-<lastSize>.json: %w", err)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("os.ReadFile: %w", err)
+	}
+	var proof ConsistencyProof
+	if err := json.Unmarshal(b, &proof); err != nil {
+		return fmt.Errorf("decoding proof: %w", err)
+	}
+	c.proofs[sizes] = &proof
+	return nil
+}
+
+func (c *FileClient) load(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("os.Open: %w", err)
+	}
+	defer f.Close()
+	uuid, entry, err := ParseEntry(f)
+	if err != nil {
+		return err
+	}
+	c.entries[uuid] = entry
+	c.uuids[entry.LogIndex] = uuid
+	if hash := artifactHash(entry); hash != "" {
+		c.hashes[hash] = append(c.hashes[hash], uuid)
+	}
+	return nil
+}
+
+// artifactHash returns the hash an entry is indexed by for SearchByHash, or "" if it has none.
+func artifactHash(entry *Entry) string {
+	b, err := base64.StdEncoding.DecodeString(entry.Body)
+	if err != nil {
+		return ""
+	}
+	var body struct {
+		Spec struct {
+			Data struct {
+				Hash struct {
+					Algorithm string `json:"algorithm"`
+					Value     string `json:"value"`
+				} `json:"hash"`
+			} `json:"data"`
+		} `json:"spec"`
+	}
+	if err := json.Unmarshal(b, &body); err != nil || body.Spec.Data.Hash.Value == "" {
+		return ""
+	}
+	return body.Spec.Data.Hash.Algorithm + ":" + body.Spec.Data.Hash.Value
+}
+
+// SearchByHash returns the UUIDs of the saved entries for the artifact hash, which may be none.
+func (c *FileClient) SearchByHash(_ context.Context, hash string) ([]string, error) {
+	return append([]string{}, c.hashes[hash]...), nil
+}
+
+// EntryByIndex returns the saved entry at the log index.
+func (c *FileClient) EntryByIndex(_ context.Context, index int64) (string, *Entry, error) {
+	uuid, ok := c.uuids[index]
+	if !ok {
+		return "", nil, ErrNoEntry
+	}
+	return uuid, c.entries[uuid], nil
+}
+
+// EntryByUUID returns the saved entry with the UUID.
+func (c *FileClient) EntryByUUID(_ context.Context, uuid string) (*Entry, error) {
+	entry, ok := c.entries[uuid]
+	if !ok {
+		return nil, ErrNoEntry
+	}
+	return entry, nil
+}
+
+// ConsistencyProof returns the saved proof between the tree sizes, whatever the tree ID.
+func (c *FileClient) ConsistencyProof(_ context.Context, firstSize, lastSize uint64, _ string,
+) (*ConsistencyProof, error) {
+	proof, ok := c.proofs[[2]uint64{firstSize, lastSize}]
+	if !ok {
+		return nil, ErrNoProof
+	}
+	return proof, nil
+}
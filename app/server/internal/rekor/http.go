@@ -0,0 +1,150 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rekor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+var errServer = errors.New("rekor server error")
+
+// HTTPClient implements Client with Rekor's v1 REST API.
+type HTTPClient struct {
+	client  *http.Client
+	baseURL string
+}
+
+// NewHTTPClient creates an HTTPClient for the Rekor instance at baseURL, e.g. DefaultURL.
+// Requests are sent with client, which is expected to handle timeouts and retries
+// (see the upstream package), or http.DefaultClient if nil.
+func NewHTTPClient(baseURL string, client *http.Client) *HTTPClient {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &HTTPClient{
+		client:  client,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// SearchByHash queries the search index for the entries of the artifact hash.
+func (c *HTTPClient) SearchByHash(ctx context.Context, hash string) ([]string, error) {
+	body, err := json.Marshal(struct {
+		Hash string `json:"hash"`
+	}{Hash: hash})
+	if err != nil {
+		return nil, fmt.Errorf("marshaling json payload: %w", err)
+	}
+	resp, err := c.do(ctx, http.MethodPost, "/api/v1/index/retrieve", body)
+	if err != nil {
+		return nil, fmt.Errorf("looking up Rekor index: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// A non-OK status means the search index isn't answering usefully (e.g. it has
+	// been wound down and an intermediary returns an HTML error page). Surface that
+	// as ErrSearchUnavailable instead of letting the JSON decoder fail with an
+	// opaque "invalid character '<'" so callers can distinguish it from a real miss.
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("%w: status %d: %s", ErrSearchUnavailable,
+			resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	var uuids []string
+	if err := json.NewDecoder(resp.Body).Decode(&uuids); err != nil {
+		// A 200 with a non-JSON body likewise means the index isn't usable.
+		return nil, fmt.Errorf("%w: decoding response: %w", ErrSearchUnavailable, err)
+	}
+	return uuids, nil
+}
+
+// EntryByIndex fetches the entry at the log index.
+func (c *HTTPClient) EntryByIndex(ctx context.Context, index int64) (string, *Entry, error) {
+	return c.entry(ctx, fmt.Sprintf("/api/v1/log/entries?logIndex=%d", index))
+}
+
+// EntryByUUID fetches the entry with the UUID.
+func (c *HTTPClient) EntryByUUID(ctx context.Context, uuid string) (*Entry, error) {
+	_, entry, err := c.entry(ctx, "/api/v1/log/entries/"+url.PathEscape(uuid))
+	return entry, err
+}
+
+// ConsistencyProof fetches the proof that the tree of lastSize extends the tree of firstSize.
+func (c *HTTPClient) ConsistencyProof(ctx context.Context, firstSize, lastSize uint64, treeID string,
+) (*ConsistencyProof, error) {
+	query := url.Values{}
+	query.Set("firstSize", strconv.FormatUint(firstSize, 10))
+	query.Set("lastSize", strconv.FormatUint(lastSize, 10))
+	if treeID != "" {
+		query.Set("treeID", treeID)
+	}
+	resp, err := c.do(ctx, http.MethodGet, "/api/v1/log/proof?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("looking up Rekor consistency proof: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusBadRequest {
+		return nil, fmt.Errorf("%w: %s", ErrNoProof, resp.Status)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %s", errServer, resp.Status)
+	}
+	var proof ConsistencyProof
+	if err := json.NewDecoder(resp.Body).Decode(&proof); err != nil {
+		return nil, fmt.Errorf("decoding Rekor response: %w", err)
+	}
+	return &proof, nil
+}
+
+func (c *HTTPClient) entry(ctx context.Context, path string) (string, *Entry, error) {
+	resp, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return "", nil, fmt.Errorf("looking up Rekor entry: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return "", nil, ErrNoEntry
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", nil, fmt.Errorf("%w: %s", errServer, resp.Status)
+	}
+	return ParseEntry(resp.Body)
+}
+
+func (c *HTTPClient) do(ctx context.Context, method, path string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating new HTTP request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http.Do: %w", err)
+	}
+	return resp, nil
+}
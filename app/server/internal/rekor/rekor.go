@@ -0,0 +1,100 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rekor implements clients looking up entries of a Rekor transparency log.
+package rekor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// DefaultURL is the public good Rekor instance.
+const DefaultURL = "https://rekor.sigstore.dev"
+
+var (
+	// ErrNoEntry is returned when the log has no matching entry.
+	ErrNoEntry = errors.New("no transparency log entry found")
+
+	// ErrSearchUnavailable indicates the search-by-hash index could not be used to locate an
+	// entry: the endpoint returned a non-OK status or a non-JSON body. Rekor v2 removed the
+	// search index (a best-effort service) and the v1 index is wound down, so this path can fail
+	// independently of whether a payload is legitimately logged. Callers that have a log index
+	// should prefer looking entries up directly.
+	ErrSearchUnavailable = errors.New("rekor search index unavailable")
+
+	// ErrNoProof is returned when the log can't prove the consistency of two tree sizes.
+	ErrNoProof = errors.New("no consistency proof found")
+)
+
+// Client looks up transparency log entries.
+type Client interface {
+	// SearchByHash returns the UUIDs of the entries for an artifact hash, e.g. "sha256:<hex>".
+	SearchByHash(ctx context.Context, hash string) ([]string, error)
+	// EntryByIndex returns the UUID and entry at the log index.
+	EntryByIndex(ctx context.Context, index int64) (uuid string, entry *Entry, err error)
+	// EntryByUUID returns the entry with the UUID.
+	EntryByUUID(ctx context.Context, uuid string) (*Entry, error)
+	// ConsistencyProof returns the proof that the tree of lastSize extends the tree of firstSize,
+	// in the log shard treeID, or the active shard if empty.
+	ConsistencyProof(ctx context.Context, firstSize, lastSize uint64, treeID string) (*ConsistencyProof, error)
+}
+
+// Entry is a transparency log entry, as returned by Rekor's log entries API.
+type Entry struct {
+	Body           string        `json:"body"`
+	IntegratedTime int64         `json:"integratedTime"`
+	LogID          string        `json:"logID"`
+	LogIndex       int64         `json:"logIndex"`
+	Verification   *Verification `json:"verification"`
+}
+
+// Verification holds the proofs that an Entry was added to the log.
+type Verification struct {
+	InclusionProof       *InclusionProof `json:"inclusionProof,omitempty"`
+	SignedEntryTimestamp strfmt.Base64   `json:"signedEntryTimestamp,omitempty"`
+}
+
+// InclusionProof proves an Entry is in the log's Merkle tree of the given size.
+type InclusionProof struct {
+	Hashes   []string `json:"hashes"`
+	RootHash string   `json:"rootHash"`
+	// Checkpoint is the log's signed tree head for TreeSize, see ParseCheckpoint.
+	Checkpoint string `json:"checkpoint,omitempty"`
+	TreeSize   uint64 `json:"treeSize"`
+	LogIndex   uint64 `json:"logIndex"`
+}
+
+// ConsistencyProof proves the log's Merkle tree of a size extends a smaller one.
+type ConsistencyProof struct {
+	RootHash string   `json:"rootHash"`
+	Hashes   []string `json:"hashes"`
+}
+
+// ParseEntry decodes a log entries response, which maps the entry UUID to the entry.
+func ParseEntry(r io.Reader) (uuid string, entry *Entry, err error) {
+	var entries map[string]Entry
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return "", nil, fmt.Errorf("decoding Rekor response: %w", err)
+	}
+	for uuid, entry := range entries {
+		return uuid, &entry, nil
+	}
+	return "", nil, ErrNoEntry
+}
@@ -0,0 +1,191 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rekor
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	testdata  = "../../testdata/rekor"
+	testUUID  = "24296fb24b8ad77abaa457505061c4a0ef34197534bdd8b474acfafdc4d76c437726e98153c7b253"
+	testIndex = 23652179
+	testHash  = "sha256:cd8327d867fce04bc97e149da50c3746340869575f7bf959a67284e34bfd46bc"
+	testProof = `{"rootHash": "02", "hashes": ["01"]}`
+)
+
+// newTestServer serves the saved entry, after failing the given number of requests with status.
+func newTestServer(t *testing.T, failures int32, status int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	entry, err := os.ReadFile(testdata + "/log-entries-response.json")
+	if err != nil {
+		t.Fatalf("os.ReadFile: %v", err)
+	}
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) <= failures {
+			w.WriteHeader(status)
+			return
+		}
+		switch {
+		case r.URL.Path == "/api/v1/log/entries" && r.URL.Query().Get("logIndex") == "23652179",
+			r.URL.Path == "/api/v1/log/entries/"+testUUID:
+			w.Write(entry)
+		case r.URL.Path == "/api/v1/index/retrieve" && r.Method == http.MethodPost:
+			w.Write([]byte(`["` + testUUID + `"]`))
+		case r.URL.Path == "/api/v1/log/proof" && r.URL.Query().Get("firstSize") == "1" &&
+			r.URL.Query().Get("lastSize") == "2" && r.URL.Query().Get("treeID") == "3":
+			w.Write([]byte(testProof))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func TestHTTPClient(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		wantErr      error
+		name         string
+		failures     int32
+		status       int
+		index        int64
+		wantRequests int32
+	}{
+		{name: "found", index: testIndex, wantRequests: 1},
+		{name: "missing", index: 1, wantErr: ErrNoEntry, wantRequests: 1},
+		{
+			name: "server error", index: testIndex, failures: 1, status: http.StatusBadGateway,
+			wantErr: errServer, wantRequests: 1,
+		},
+		{
+			name: "client error", index: testIndex, failures: 1, status: http.StatusBadRequest,
+			wantErr: errServer, wantRequests: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv, requests := newTestServer(t, tt.failures, tt.status)
+			c := NewHTTPClient(srv.URL+"/", nil)
+			uuid, entry, err := c.EntryByIndex(context.Background(), tt.index)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("EntryByIndex() = %v, want %v", err, tt.wantErr)
+			}
+			if got := requests.Load(); got != tt.wantRequests {
+				t.Errorf("requests = %d, want %d", got, tt.wantRequests)
+			}
+			if tt.wantErr == nil && (uuid != testUUID || entry.LogIndex != testIndex) {
+				t.Errorf("EntryByIndex() = %s, %+v", uuid, entry)
+			}
+		})
+	}
+}
+
+func TestHTTPClient_Search(t *testing.T) {
+	t.Parallel()
+	srv, _ := newTestServer(t, 0, 0)
+	c := NewHTTPClient(srv.URL, nil)
+	uuids, err := c.SearchByHash(context.Background(), testHash)
+	if err != nil {
+		t.Fatalf("SearchByHash: %v", err)
+	}
+	if diff := cmp.Diff([]string{testUUID}, uuids); diff != "" {
+		t.Errorf("uuids mismatch (-want +got):\n%s", diff)
+	}
+	entry, err := c.EntryByUUID(context.Background(), testUUID)
+	if err != nil || entry.LogIndex != testIndex {
+		t.Errorf("EntryByUUID() = %+v, %v", entry, err)
+	}
+
+	proof, err := c.ConsistencyProof(context.Background(), 1, 2, "3")
+	if diff := cmp.Diff(&ConsistencyProof{RootHash: "02", Hashes: []string{"01"}}, proof); err != nil || diff != "" {
+		t.Errorf("ConsistencyProof() = %v, mismatch (-want +got):\n%s", err, diff)
+	}
+	if _, err := c.ConsistencyProof(context.Background(), 1, 3, ""); !errors.Is(err, ErrNoProof) {
+		t.Errorf("ConsistencyProof() = %v, want ErrNoProof", err)
+	}
+
+	down, _ := newTestServer(t, 10, http.StatusGone)
+	_, err = NewHTTPClient(down.URL, nil).SearchByHash(context.Background(), testHash)
+	if !errors.Is(err, ErrSearchUnavailable) {
+		t.Errorf("SearchByHash() = %v, want ErrSearchUnavailable", err)
+	}
+}
+
+func TestFileClient(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	dir := t.TempDir()
+	saved, err := os.ReadFile(testdata + "/log-entries-response.json")
+	if err != nil {
+		t.Fatalf("os.ReadFile: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "entry.json"), saved, 0o600); err != nil {
+		t.Fatalf("os.WriteFile: %v", err)
+	}
+	if err := os.Mkdir(filepath.Join(dir, "proofs"), 0o700); err != nil {
+		t.Fatalf("os.Mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "proofs", "1-2.json"), []byte(testProof), 0o600); err != nil {
+		t.Fatalf("os.WriteFile: %v", err)
+	}
+	c, err := NewFileClient(dir)
+	if err != nil {
+		t.Fatalf("NewFileClient: %v", err)
+	}
+
+	uuid, entry, err := c.EntryByIndex(ctx, testIndex)
+	if err != nil || uuid != testUUID || entry.Verification == nil || entry.Verification.InclusionProof == nil {
+		t.Errorf("EntryByIndex() = %s, %+v, %v", uuid, entry, err)
+	}
+	if _, _, err := c.EntryByIndex(ctx, 1); !errors.Is(err, ErrNoEntry) {
+		t.Errorf("EntryByIndex() = %v, want ErrNoEntry", err)
+	}
+	if got, err := c.EntryByUUID(ctx, testUUID); err != nil || got != entry {
+		t.Errorf("EntryByUUID() = %+v, %v", got, err)
+	}
+	if _, err := c.EntryByUUID(ctx, "missing"); !errors.Is(err, ErrNoEntry) {
+		t.Errorf("EntryByUUID() = %v, want ErrNoEntry", err)
+	}
+
+	if proof, err := c.ConsistencyProof(ctx, 1, 2, "any"); err != nil || proof.RootHash != "02" {
+		t.Errorf("ConsistencyProof() = %+v, %v", proof, err)
+	}
+	if _, err := c.ConsistencyProof(ctx, 2, 3, ""); !errors.Is(err, ErrNoProof) {
+		t.Errorf("ConsistencyProof() = %v, want ErrNoProof", err)
+	}
+
+	for hash, want := range map[string][]string{testHash: {testUUID}, "sha256:00": {}} {
+		got, err := c.SearchByHash(ctx, hash)
+		if err != nil {
+			t.Fatalf("SearchByHash: %v", err)
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("SearchByHash(%q) mismatch (-want +got):\n%s", hash, diff)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package upstream implements the HTTP transport used for calls to upstream services, with
+// timeouts, retries and circuit breaking.
+package upstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ErrCircuitOpen is returned without calling the upstream while its circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// Options configures the calls to an upstream.
+type Options struct {
+	// Timeout bounds each attempt, including reading the response body.
+	Timeout time.Duration
+	// Retries is the number of times a request failing with a network error or a 5xx or 429
+	// status is retried. Requests with a body are only retried if it can be replayed.
+	Retries int
+	// BaseDelay is the upper bound of the first retry's random delay, doubling on each retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between attempts. A Retry-After asking for more isn't retried.
+	MaxDelay time.Duration
+	// BreakerThreshold is the number of consecutive failed attempts which opens the circuit
+	// breaker, or 0 to never open it.
+	BreakerThreshold int
+	// BreakerCooldown is how long the breaker stays open before letting a trial request through.
+	BreakerCooldown time.Duration
+}
+
+// DefaultOptions retries twice within a few seconds, and stops calling an upstream for
+// 30 seconds after 5 consecutive failures.
+var DefaultOptions = Options{
+	Timeout:          10 * time.Second,
+	Retries:          2,
+	BaseDelay:        500 * time.Millisecond,
+	MaxDelay:         5 * time.Second,
+	BreakerThreshold: 5,
+	BreakerCooldown:  30 * time.Second,
+}
+
+// Transport is an http.RoundTripper calling an upstream through base.
+// Retried requests must be idempotent, which all of our upstream calls are.
+type Transport struct {
+	base    http.RoundTripper
+	breaker *breaker
+	sleep   func(ctx context.Context, d time.Duration) error
+	name    string
+	opts    Options
+}
+
+// NewTransport creates a Transport for the named upstream. base defaults to http.DefaultTransport.
+func NewTransport(name string, base http.RoundTripper, opts Options) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Transport{
+		base:    base,
+		breaker: newBreaker(opts.BreakerThreshold, opts.BreakerCooldown),
+		sleep:   sleep,
+		name:    name,
+		opts:    opts,
+	}
+}
+
+// NewClient creates an http.Client calling the named upstream through a new Transport.
+func NewClient(name string, opts Options) *http.Client {
+	return &http.Client{Transport: NewTransport(name, nil, opts)}
+}
+
+// RoundTrip sends the request, retrying failed attempts after a random delay, or the delay
+// asked for by a Retry-After header. The last response is returned, whatever its status.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		if !t.breaker.allow() {
+			return nil, fmt.Errorf("%s: %w", t.name, ErrCircuitOpen)
+		}
+		resp, err := t.attempt(req, attempt)
+		failed := err != nil ||
+			resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests
+		// The caller giving up isn't the upstream's fault.
+		if ctx.Err() == nil {
+			t.breaker.record(!failed)
+		} else {
+			t.breaker.release()
+		}
+		if !failed {
+			return resp, nil
+		}
+
+		delay, ok := t.retryDelay(req, resp, attempt)
+		if !ok {
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", t.name, err)
+			}
+			return resp, nil
+		}
+		if resp != nil {
+			// Drain the body so the connection can be reused.
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 4096)) //nolint:errcheck // Best effort.
+			resp.Body.Close()
+		}
+		if err := t.sleep(ctx, delay); err != nil {
+			return nil, fmt.Errorf("%s: waiting to retry: %w", t.name, err)
+		}
+	}
+}
+
+// attempt sends one attempt of the request, bounded by the upstream's timeout.
+func (t *Transport) attempt(req *http.Request, attempt int) (*http.Response, error) {
+	if attempt > 0 && req.Body != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("replaying request body: %w", err)
+		}
+		req = req.Clone(req.Context())
+		req.Body = body
+	}
+	if t.opts.Timeout <= 0 {
+		return t.base.RoundTrip(req) //nolint:wrapcheck // The transport's errors are returned as is.
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), t.opts.Timeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err //nolint:wrapcheck // The transport's errors are returned as is.
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// retryDelay returns how long to wait before retrying the failed attempt, and whether to retry.
+func (t *Transport) retryDelay(req *http.Request, resp *http.Response, attempt int) (time.Duration, bool) {
+	if attempt >= t.opts.Retries || (req.Body != nil && req.GetBody == nil) || req.Context().Err() != nil {
+		return 0, false
+	}
+	if resp != nil {
+		if after, ok := retryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			return after, after <= t.opts.MaxDelay
+		}
+	}
+	// Full jitter, see https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/
+	ceiling := t.opts.BaseDelay << attempt
+	if ceiling > t.opts.MaxDelay || ceiling <= 0 {
+		ceiling = t.opts.MaxDelay
+	}
+	if ceiling <= 0 {
+		return 0, true
+	}
+	return rand.N(ceiling), true //nolint:gosec // Jitter doesn't need a secure random source.
+}
+
+// retryAfter parses a Retry-After header, either a number of seconds or an HTTP date.
+func retryAfter(header string, now time.Time) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err() //nolint:wrapcheck // Wrapped by the caller.
+	case <-timer.C:
+		return nil
+	}
+}
+
+// cancelBody releases an attempt's timeout once its response body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close() //nolint:wrapcheck // The body's errors are returned as is.
+}
@@ -0,0 +1,315 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upstream
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var testOptions = Options{
+	Timeout:   time.Second,
+	Retries:   2,
+	BaseDelay: 100 * time.Millisecond,
+	MaxDelay:  time.Second,
+}
+
+// response is one canned response of a test server.
+type response struct {
+	retryAfter string
+	status     int
+}
+
+// newTestServer answers requests with the responses in order, then with 200 OK echoing the request body.
+func newTestServer(t *testing.T, responses ...response) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(requests.Add(1))
+		if n <= len(responses) {
+			if responses[n-1].retryAfter != "" {
+				w.Header().Set("Retry-After", responses[n-1].retryAfter)
+			}
+			w.WriteHeader(responses[n-1].status)
+			return
+		}
+		io.Copy(w, r.Body)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+// newTestTransport returns a Transport recording its delays instead of sleeping.
+func newTestTransport(opts Options) (*Transport, *[]time.Duration) {
+	var mu sync.Mutex
+	delays := &[]time.Duration{}
+	transport := NewTransport("test", nil, opts)
+	transport.sleep = func(ctx context.Context, d time.Duration) error {
+		mu.Lock()
+		defer mu.Unlock()
+		*delays = append(*delays, d)
+		return nil
+	}
+	return transport, delays
+}
+
+func TestTransport(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		wantDelays   []time.Duration
+		responses    []response
+		wantStatus   int
+		wantRequests int32
+	}{
+		{name: "ok", wantStatus: http.StatusOK, wantRequests: 1},
+		{
+			name:       "retried",
+			responses:  []response{{status: http.StatusServiceUnavailable}, {status: http.StatusBadGateway}},
+			wantStatus: http.StatusOK, wantRequests: 3,
+		},
+		{
+			name:       "retries exhausted",
+			responses:  []response{{status: 500}, {status: 500}, {status: 500}},
+			wantStatus: http.StatusInternalServerError, wantRequests: 3,
+		},
+		{
+			name:       "client errors aren't retried",
+			responses:  []response{{status: http.StatusBadRequest}},
+			wantStatus: http.StatusBadRequest, wantRequests: 1,
+		},
+		{
+			name:       "retry after seconds",
+			responses:  []response{{status: http.StatusTooManyRequests, retryAfter: "1"}},
+			wantStatus: http.StatusOK, wantRequests: 2, wantDelays: []time.Duration{time.Second},
+		},
+		{
+			name:       "retry after a past date",
+			responses:  []response{{status: http.StatusTooManyRequests, retryAfter: "Wed, 21 Oct 2015 07:28:00 GMT"}},
+			wantStatus: http.StatusOK, wantRequests: 2, wantDelays: []time.Duration{0},
+		},
+		{
+			name:       "retry after too long",
+			responses:  []response{{status: http.StatusServiceUnavailable, retryAfter: "60"}},
+			wantStatus: http.StatusServiceUnavailable, wantRequests: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv, requests := newTestServer(t, tt.responses...)
+			transport, delays := newTestTransport(testOptions)
+			client := &http.Client{Transport: transport}
+			resp, err := client.Post(srv.URL, "text/plain", strings.NewReader("body"))
+			if err != nil {
+				t.Fatalf("Post: %v", err)
+			}
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("io.ReadAll: %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if resp.StatusCode == http.StatusOK && string(body) != "body" {
+				t.Errorf("body = %q, want the request body replayed", body)
+			}
+			if got := requests.Load(); got != tt.wantRequests {
+				t.Errorf("requests = %d, want %d", got, tt.wantRequests)
+			}
+			if tt.wantDelays != nil {
+				if diff := cmp.Diff(tt.wantDelays, *delays); diff != "" {
+					t.Errorf("delays mismatch (-want +got):\n%s", diff)
+				}
+			}
+			for i, d := range *delays {
+				if d < 0 || d > testOptions.MaxDelay {
+					t.Errorf("delay %d = %v, want at most %v", i, d, testOptions.MaxDelay)
+				}
+			}
+		})
+	}
+}
+
+func TestTransport_timeout(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(release) })
+
+	opts := testOptions
+	opts.Timeout = 10 * time.Millisecond
+	transport, _ := newTestTransport(opts)
+	_, err := (&http.Client{Transport: transport}).Get(srv.URL)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Get() = %v, want context.DeadlineExceeded", err)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("requests = %d, want 3", got)
+	}
+}
+
+func TestTransport_breaker(t *testing.T) {
+	t.Parallel()
+	srv, requests := newTestServer(t, response{status: 500}, response{status: 500}, response{status: 500})
+	opts := testOptions
+	opts.Retries = 0
+	opts.BreakerThreshold = 2
+	opts.BreakerCooldown = time.Minute
+	transport, _ := newTestTransport(opts)
+	now := time.Now()
+	transport.breaker.now = func() time.Time { return now }
+	client := &http.Client{Transport: transport}
+
+	get := func() error {
+		resp, err := client.Get(srv.URL)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	}
+	for range 2 {
+		if err := get(); err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+	}
+	if err := get(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Get() = %v, want ErrCircuitOpen", err)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("requests = %d, want 2 while open", got)
+	}
+
+	// A failed trial opens the breaker for another cooldown.
+	now = now.Add(time.Minute)
+	if err := get(); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if err := get(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Get() = %v, want ErrCircuitOpen", err)
+	}
+
+	// A successful trial closes it.
+	now = now.Add(time.Minute)
+	for range 3 {
+		if err := get(); err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+	}
+	if got := requests.Load(); got != 6 {
+		t.Errorf("requests = %d, want 6 once closed", got)
+	}
+}
+
+func TestTransport_breakerCancelledTrial(t *testing.T) {
+	t.Parallel()
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch requests.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusInternalServerError)
+		case 2:
+			// The trial request, which the caller cancels.
+			<-r.Context().Done()
+		}
+	}))
+	t.Cleanup(srv.Close)
+	opts := testOptions
+	opts.Retries = 0
+	opts.BreakerThreshold = 1
+	opts.BreakerCooldown = time.Minute
+	transport, _ := newTestTransport(opts)
+	now := time.Now()
+	transport.breaker.now = func() time.Time { return now }
+	client := &http.Client{Transport: transport}
+
+	get := func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+		if err != nil {
+			t.Fatalf("http.NewRequestWithContext: %v", err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	}
+	if err := get(context.Background()); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	now = now.Add(time.Minute)
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		for requests.Load() < 2 {
+			time.Sleep(time.Millisecond)
+		}
+		cancel()
+	}()
+	if err := get(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Get() = %v, want context.Canceled", err)
+	}
+
+	// The cancelled trial neither counts as a failure nor keeps the breaker open.
+	if err := get(context.Background()); err != nil {
+		t.Fatalf("Get() after cancelled trial: %v", err)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("requests = %d, want 3", got)
+	}
+}
+
+func Test_retryAfter(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		header string
+		want   time.Duration
+		wantOK bool
+	}{
+		{header: ""},
+		{header: "soon"},
+		{header: "-1"},
+		{header: "120", want: 2 * time.Minute, wantOK: true},
+		{header: "Fri, 02 Jan 2026 03:04:35 GMT", want: 30 * time.Second, wantOK: true},
+		{header: "Fri, 02 Jan 2026 03:00:00 GMT", want: 0, wantOK: true},
+	}
+	for _, tt := range tests {
+		got, ok := retryAfter(tt.header, now)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("retryAfter(%q) = %v, %v, want %v, %v", tt.header, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package witness tracks the tree heads of a transparency log and checks they are consistent,
+// so the log can't show us a different history than it shows everyone else (a split view).
+package witness
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+
+	"github.com/transparency-dev/merkle/proof"
+	"github.com/transparency-dev/merkle/rfc6962"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+
+	"github.com/ossf/scorecard-webapp/app/server/internal/rekor"
+)
+
+const headPrefix = "heads/"
+
+// ErrInconsistent is returned when a tree head isn't consistent with the ones seen before.
+var ErrInconsistent = errors.New("tree head inconsistent with previously seen tree head")
+
+// Head is the latest tree head seen of a log.
+type Head struct {
+	Origin   string `json:"origin"`
+	RootHash []byte `json:"rootHash"`
+	Size     uint64 `json:"size"`
+}
+
+// Witness checks the checkpoints it observes are consistent with the latest one seen of their
+// log, and keeps track of the latest. If a bucket is provided, the latest tree heads are
+// shared through it across restarts and instances.
+type Witness struct {
+	log    rekor.Client
+	bucket *blob.Bucket
+	heads  map[string]Head
+	// mu guards heads. It isn't held while fetching proofs, so a slow log doesn't hold up
+	// the checkpoints which need none.
+	mu sync.Mutex
+}
+
+// New creates a Witness fetching consistency proofs from log. bucket may be nil to keep the
+// tree heads in memory only.
+func New(log rekor.Client, bucket *blob.Bucket) *Witness {
+	return &Witness{log: log, bucket: bucket, heads: map[string]Head{}}
+}
+
+// Observe checks the checkpoint, whose signature must have been verified, is consistent with
+// the latest tree head seen of its log, and records it if it's newer.
+func (w *Witness) Observe(ctx context.Context, c *rekor.Checkpoint) error {
+	head := Head{Origin: c.Origin, Size: c.Size, RootHash: c.RootHash}
+	for {
+		latest, ok, err := w.latest(ctx, c.Origin)
+		if err != nil {
+			return err
+		}
+		switch {
+		case !ok:
+		case head.Size == latest.Size:
+			if !bytes.Equal(head.RootHash, latest.RootHash) {
+				return fmt.Errorf("%w: %s has two roots for size %d", ErrInconsistent, c.Origin, c.Size)
+			}
+			return nil
+		case head.Size > latest.Size:
+			if err := w.verifyConsistency(ctx, c.TreeID(), latest, head); err != nil {
+				return err
+			}
+		default:
+			// An older checkpoint must be a prefix of the latest.
+			return w.verifyConsistency(ctx, c.TreeID(), head, latest)
+		}
+		// Another checkpoint may have been recorded while verifying this one. If so, it's
+		// checked again against that one rather than replacing it unchecked.
+		if w.advance(latest, head) {
+			return w.save(ctx, head)
+		}
+	}
+}
+
+// advance records head as the latest in memory, unless the latest changed from the one it was
+// verified against. It reports whether head was recorded.
+func (w *Witness) advance(verified, head Head) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if current, ok := w.heads[head.Origin]; ok && current.Size > verified.Size {
+		return false
+	}
+	w.heads[head.Origin] = head
+	return true
+}
+
+// verifyConsistency checks the log's tree of newer's size extends the tree of older's size.
+func (w *Witness) verifyConsistency(ctx context.Context, treeID string, older, newer Head) error {
+	if older.Size == 0 {
+		return nil
+	}
+	p, err := w.log.ConsistencyProof(ctx, older.Size, newer.Size, treeID)
+	if err != nil {
+		return fmt.Errorf("getting consistency proof from %d to %d: %w", older.Size, newer.Size, err)
+	}
+	hashes := make([][]byte, 0, len(p.Hashes))
+	for _, h := range p.Hashes {
+		b, err := hex.DecodeString(h)
+		if err != nil {
+			return fmt.Errorf("decoding consistency proof hash: %w", err)
+		}
+		hashes = append(hashes, b)
+	}
+	if err := proof.VerifyConsistency(rfc6962.DefaultHasher, older.Size, newer.Size, hashes,
+		older.RootHash, newer.RootHash); err != nil {
+		return fmt.Errorf("%w: %s from %d to %d: %w", ErrInconsistent, older.Origin, older.Size, newer.Size, err)
+	}
+	return nil
+}
+
+// latest returns the latest tree head seen of the log, preferring the bucket's, which may
+// have been updated by other instances.
+func (w *Witness) latest(ctx context.Context, origin string) (Head, bool, error) {
+	w.mu.Lock()
+	head, ok := w.heads[origin]
+	w.mu.Unlock()
+	if w.bucket == nil {
+		return head, ok, nil
+	}
+	b, err := w.bucket.ReadAll(ctx, headKey(origin))
+	if gcerrors.Code(err) == gcerrors.NotFound {
+		return head, ok, nil
+	}
+	if err != nil {
+		return Head{}, false, fmt.Errorf("bucket.ReadAll: %w", err)
+	}
+	var stored Head
+	if err := json.Unmarshal(b, &stored); err != nil {
+		return Head{}, false, fmt.Errorf("decoding tree head: %w", err)
+	}
+	if !ok || stored.Size >= head.Size {
+		return stored, true, nil
+	}
+	return head, true, nil
+}
+
+// save records head as the latest in the bucket. Another instance, or a concurrent save, may have
+// saved a newer one since we read it, which is then overwritten: that only makes the next check
+// start from an older head.
+func (w *Witness) save(ctx context.Context, head Head) error {
+	if w.bucket == nil {
+		return nil
+	}
+	b, err := json.Marshal(head)
+	if err != nil {
+		return fmt.Errorf("encoding tree head: %w", err)
+	}
+	if err := w.bucket.WriteAll(ctx, headKey(head.Origin), b, nil); err != nil {
+		return fmt.Errorf("bucket.WriteAll: %w", err)
+	}
+	return nil
+}
+
+func headKey(origin string) string {
+	return headPrefix + url.PathEscape(origin) + ".json"
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package witness
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/transparency-dev/merkle/rfc6962"
+	"github.com/transparency-dev/merkle/testonly"
+	"gocloud.dev/blob/memblob"
+
+	"github.com/ossf/scorecard-webapp/app/server/internal/rekor"
+)
+
+const testOrigin = "rekor.example - 1"
+
+// testLog serves consistency proofs of a tree of 10 leaves.
+type testLog struct {
+	rekor.Client
+	tree *testonly.Tree
+}
+
+func newTestLog(prefix string) testLog {
+	tree := testonly.New(rfc6962.DefaultHasher)
+	for i := range 10 {
+		tree.AppendData([]byte(fmt.Sprintf("%s %d", prefix, i)))
+	}
+	return testLog{tree: tree}
+}
+
+func (l testLog) ConsistencyProof(_ context.Context, firstSize, lastSize uint64, treeID string,
+) (*rekor.ConsistencyProof, error) {
+	if treeID != "1" || lastSize > l.tree.Size() {
+		return nil, rekor.ErrNoProof
+	}
+	hashes, err := l.tree.ConsistencyProof(firstSize, lastSize)
+	if err != nil {
+		return nil, fmt.Errorf("ConsistencyProof: %w", err)
+	}
+	p := &rekor.ConsistencyProof{RootHash: hex.EncodeToString(l.tree.HashAt(lastSize))}
+	for _, h := range hashes {
+		p.Hashes = append(p.Hashes, hex.EncodeToString(h))
+	}
+	return p, nil
+}
+
+func (l testLog) checkpoint(size uint64) *rekor.Checkpoint {
+	return &rekor.Checkpoint{Origin: testOrigin, Size: size, RootHash: l.tree.HashAt(size)}
+}
+
+func TestWitness_Observe(t *testing.T) {
+	t.Parallel()
+	log := newTestLog("leaf")
+	forked := newTestLog("fork")
+	tests := []struct {
+		wantErr    error
+		observe    *rekor.Checkpoint
+		name       string
+		seen       []uint64
+		wantLatest uint64
+	}{
+		{name: "first", observe: log.checkpoint(5), wantLatest: 5},
+		{name: "same", seen: []uint64{5}, observe: log.checkpoint(5), wantLatest: 5},
+		{name: "newer", seen: []uint64{3, 5}, observe: log.checkpoint(8), wantLatest: 8},
+		{name: "older", seen: []uint64{8}, observe: log.checkpoint(5), wantLatest: 8},
+		{
+			name: "another root for the same size", seen: []uint64{5}, observe: forked.checkpoint(5),
+			wantErr: ErrInconsistent, wantLatest: 5,
+		},
+		{
+			name: "newer fork", seen: []uint64{5}, observe: forked.checkpoint(8),
+			wantErr: ErrInconsistent, wantLatest: 5,
+		},
+		{
+			name: "older fork", seen: []uint64{8}, observe: forked.checkpoint(5),
+			wantErr: ErrInconsistent, wantLatest: 8,
+		},
+		{
+			name: "no proof", seen: []uint64{5},
+			observe: &rekor.Checkpoint{Origin: testOrigin, Size: 20, RootHash: log.tree.Hash()},
+			wantErr: rekor.ErrNoProof, wantLatest: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			w := New(log, nil)
+			for _, size := range tt.seen {
+				if err := w.Observe(ctx, log.checkpoint(size)); err != nil {
+					t.Fatalf("Observe(%d): %v", size, err)
+				}
+			}
+			if err := w.Observe(ctx, tt.observe); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Observe() = %v, want %v", err, tt.wantErr)
+			}
+			if got := w.heads[testOrigin].Size; got != tt.wantLatest {
+				t.Errorf("latest size = %d, want %d", got, tt.wantLatest)
+			}
+		})
+	}
+}
+
+func TestWitness_bucket(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	bucket := memblob.OpenBucket(nil)
+	log := newTestLog("leaf")
+
+	if err := New(log, bucket).Observe(ctx, log.checkpoint(5)); err != nil {
+		t.Fatalf("Observe: %v", err)
+	}
+	// Another instance sharing the bucket checks against the tree head seen by the first.
+	other := New(log, bucket)
+	if err := other.Observe(ctx, newTestLog("fork").checkpoint(3)); !errors.Is(err, ErrInconsistent) {
+		t.Errorf("Observe() = %v, want ErrInconsistent", err)
+	}
+	if err := other.Observe(ctx, log.checkpoint(7)); err != nil {
+		t.Errorf("Observe: %v", err)
+	}
+	head, ok, err := New(log, bucket).latest(ctx, testOrigin)
+	if err != nil || !ok || head.Size != 7 {
+		t.Errorf("latest() = %+v, %t, %v", head, ok, err)
+	}
+}
+
+// gatedLog blocks the proofs up to size gate until it's opened.
+type gatedLog struct {
+	testLog
+	reached chan struct{}
+	open    chan struct{}
+	gate    uint64
+}
+
+func (l gatedLog) ConsistencyProof(ctx context.Context, firstSize, lastSize uint64, treeID string,
+) (*rekor.ConsistencyProof, error) {
+	if lastSize == l.gate {
+		l.reached <- struct{}{}
+		<-l.open
+	}
+	return l.testLog.ConsistencyProof(ctx, firstSize, lastSize, treeID)
+}
+
+func TestWitness_concurrent(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	log := gatedLog{testLog: newTestLog("leaf"), gate: 8, reached: make(chan struct{}, 1), open: make(chan struct{})}
+	w := New(log, nil)
+	if err := w.Observe(ctx, log.checkpoint(3)); err != nil {
+		t.Fatalf("Observe(3): %v", err)
+	}
+
+	done := make(chan error)
+	go func() { done <- w.Observe(ctx, log.checkpoint(8)) }()
+	<-log.reached
+	// A slow proof doesn't hold up the other checkpoints.
+	if err := w.Observe(ctx, log.checkpoint(5)); err != nil {
+		t.Fatalf("Observe(5): %v", err)
+	}
+	close(log.open)
+	// The checkpoint verified against 3 is checked again against 5 before it's recorded.
+	if err := <-done; err != nil {
+		t.Fatalf("Observe(8): %v", err)
+	}
+	if got := w.heads[testOrigin].Size; got != 8 {
+		t.Errorf("latest size = %d, want 8", got)
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+	"github.com/ossf/scorecard-webapp/app/generated/restapi/operations/results"
+	"github.com/ossf/scorecard-webapp/app/server/internal/index"
+	"github.com/ossf/scorecard-webapp/app/server/internal/override"
+)
+
+const (
+	defaultOrgLimit = 100
+	// orgSummaryBatch is the number of index entries read at once when summarizing an organization.
+	orgSummaryBatch = 1000
+)
+
+// orgPage is the page of an organization's repositories to list, by name.
+type orgPage struct {
+	limit  int
+	offset int
+}
+
+func GetOrgResultsHandler(params results.GetOrgResultsParams) middleware.Responder {
+	ctx := requestContext(params.HTTPRequest)
+	surrogateKey := orgSurrogateKey(params.Platform, params.Org)
+	if _, err := sanitizeOrgInputs(params.Platform, params.Org); err != nil {
+		return results.NewGetOrgResultsBadRequest().
+			WithSurrogateControl(fastlyTTL).
+			WithCacheControl(browserCacheTTL)
+	}
+
+	page := orgPage{limit: defaultOrgLimit}
+	if params.Limit != nil {
+		page.limit = int(*params.Limit)
+	}
+	if params.Offset != nil {
+		page.offset = int(*params.Offset)
+	}
+	var ret *models.OrgResults
+	idx, err := getSearchIndex(ctx)
+	if err == nil {
+		ret, err = listOrgResults(ctx, idx, getOverrides(), params.Platform, params.Org, page)
+	}
+	if errors.Is(err, errNotFound) {
+		return results.NewGetOrgResultsNotFound().
+			WithSurrogateKey(surrogateKey).
+			WithSurrogateControl(fastlyTTL).
+			WithCacheControl(browserCacheTTL)
+	}
+	if err == nil {
+		return results.NewGetOrgResultsOK().WithPayload(ret).
+			WithSurrogateKey(surrogateKey).
+			WithSurrogateControl(fastlyTTL).
+			WithCacheControl(browserCacheTTL)
+	}
+
+	return results.NewGetOrgResultsDefault(http.StatusInternalServerError).WithPayload(&models.Error{
+		Code:    http.StatusInternalServerError,
+		Message: err.Error(),
+	})
+}
+
+// orgSurrogateKey is the Fastly surrogate key attached to an organization's listing,
+// purged whenever one of its repositories publishes a result.
+func orgSurrogateKey(host, orgName string) string {
+	return strings.ToLower(fmt.Sprintf("org:%s/%s", host, orgName))
+}
+
+func sanitizeOrgInputs(host, orgName string) (string, error) {
+	prefix := path.Clean(path.Join(host, orgName))
+	if strings.ContainsAny(prefix, "\r\n") || host == ".." || orgName == ".." {
+		return "", errInvalidInputs
+	}
+	matched, err := path.Match("*/*", prefix)
+	if err != nil || !matched {
+		return "", errInvalidInputs
+	}
+	return prefix, nil
+}
+
+// listOrgResults lists the latest result of the page's repositories under host/orgName from the index,
+// by name, with the statistics over all of the organization's repositories, so every page has the same.
+// Like searches, taken down results are left out of the page rather than the index.
+func listOrgResults(ctx context.Context, idx index.Index, store *override.Store, host, orgName string,
+	page orgPage,
+) (*models.OrgResults, error) {
+	overrides, err := store.Org(ctx, host, orgName)
+	if err != nil {
+		return nil, fmt.Errorf("listing overrides: %w", err)
+	}
+	entries, more, err := idx.Search(ctx, index.Query{
+		Platform: host,
+		Org:      orgName,
+		Sort:     index.SortName,
+		Limit:    page.limit,
+		Offset:   page.offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("index.Search: %w", err)
+	}
+	ret := &models.OrgResults{Platform: host, Org: orgName, Truncated: more}
+	for _, e := range entries {
+		if !overrides.Resolve(e.Platform, e.Org, e.Repo, e.Commit).Tombstoned {
+			ret.Repos = append(ret.Repos, summarizeEntry(e))
+		}
+	}
+	if len(ret.Repos) == 0 && page.offset == 0 && !more {
+		return nil, errNotFound
+	}
+	ret.Summary, err = summarizeOrg(ctx, idx, overrides, host, orgName)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// summarizeOrg computes the statistics over every repository of the organization in the index,
+// leaving out taken down results.
+func summarizeOrg(ctx context.Context, idx index.Index, overrides override.OrgOverrides, host, orgName string,
+) (*models.OrgSummary, error) {
+	var repos []*models.RepoSummary
+	query := index.Query{Platform: host, Org: orgName, Sort: index.SortName, Limit: orgSummaryBatch}
+	for {
+		entries, more, err := idx.Search(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("index.Search: %w", err)
+		}
+		for _, e := range entries {
+			if !overrides.Resolve(e.Platform, e.Org, e.Repo, e.Commit).Tombstoned {
+				repos = append(repos, summarizeEntry(e))
+			}
+		}
+		if !more {
+			return summarizeRepos(repos), nil
+		}
+		query.Offset += len(entries)
+	}
+}
+
+// summarizeRepos computes the aggregate and per-check statistics over the repositories.
+func summarizeRepos(repos []*models.RepoSummary) *models.OrgSummary {
+	var scores []float64
+	checkScores := map[string][]float64{}
+	for _, repo := range repos {
+		scores = append(scores, repo.Score)
+		for _, check := range repo.Checks {
+			checkScores[check.Name] = append(checkScores[check.Name], float64(check.Score))
+		}
+	}
+
+	summary := &models.OrgSummary{
+		Repos: int64(len(repos)),
+		Score: scoreStats(scores),
+	}
+	for name, s := range checkScores {
+		summary.Checks = append(summary.Checks, &models.CheckStats{Name: name, Stats: scoreStats(s)})
+	}
+	sort.Slice(summary.Checks, func(i, j int) bool { return summary.Checks[i].Name < summary.Checks[j].Name })
+	return summary
+}
+
+// scoreStats computes the mean and median of the conclusive scores, and the distribution of all scores.
+func scoreStats(scores []float64) *models.ScoreStats {
+	stats := &models.ScoreStats{Distribution: map[string]int64{}}
+	var conclusive []float64
+	for _, score := range scores {
+		stats.Distribution[strconv.Itoa(int(math.Floor(score)))]++
+		if score != inconclusiveScore {
+			conclusive = append(conclusive, score)
+		}
+	}
+	if len(conclusive) == 0 {
+		return stats
+	}
+
+	var sum float64
+	for _, score := range conclusive {
+		sum += score
+	}
+	stats.Mean = sum / float64(len(conclusive))
+
+	sort.Float64s(conclusive)
+	mid := len(conclusive) / 2
+	if len(conclusive)%2 == 1 {
+		stats.Median = conclusive[mid]
+	} else {
+		stats.Median = (conclusive[mid-1] + conclusive[mid]) / 2
+	}
+	return stats
+}
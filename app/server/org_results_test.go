@@ -0,0 +1,224 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gocloud.dev/blob"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+	"github.com/ossf/scorecard-webapp/app/server/internal/index"
+	"github.com/ossf/scorecard-webapp/app/server/internal/override"
+)
+
+func writeTestResult(t *testing.T, bucket *blob.Bucket, key string, result *models.ScorecardResult) {
+	t.Helper()
+	b, err := result.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+	if err := bucket.WriteAll(context.Background(), key, b, nil); err != nil {
+		t.Fatalf("WriteAll: %v", err)
+	}
+}
+
+func Test_listOrgResults(t *testing.T) {
+	t.Parallel()
+	const commit = "0123456789abcdef0123456789abcdef01234567"
+	ctx := context.Background()
+	idx := openTestIndex(t)
+	entry := func(repo, date string, score float64, checks ...int64) *index.Entry {
+		e := &index.Entry{
+			Platform: "github.com", Org: "org", Repo: repo, Date: date, Score: score,
+			Source: IndexSourceAction, Checks: map[string]int64{},
+		}
+		for i, s := range checks {
+			e.Checks[[]string{"A", "B"}[i]] = s
+		}
+		return e
+	}
+	other := entry("repo", "2024-01-01", 10)
+	other.Org = "other"
+	// Taken down by the commit of its latest result.
+	seven := entry("seven", "2024-01-01", 9)
+	seven.Commit = commit
+	for _, e := range []*index.Entry{
+		entry("one", "2024-02-01", 8, 10, 6),
+		entry("two", "2024-01-01", 4.5, 4, -1),
+		entry("three", "2024-02-02", 3, 2, 4),
+		entry("five", "2024-01-01", 1),
+		entry("four", "2024-01-01", 1),
+		entry("six", "2024-01-01", 9),
+		seven,
+		other,
+	} {
+		if _, err := idx.Put(ctx, e); err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+	}
+	// Every repository but one, two and three is taken down.
+	store := override.NewStore(nil)
+	for _, c := range []override.Change{
+		{Host: "github.com", Org: "org", Repo: "five"},
+		{Host: "github.com", Org: "org", Repo: "four"},
+		{Host: "github.com", Org: "org", Repo: "six"},
+		{Host: "github.com", Org: "org", Repo: "seven", Commit: commit},
+	} {
+		c.Action, c.Reason = override.ActionTombstone, "takedown"
+		if _, err := store.Apply(ctx, c); err != nil {
+			t.Fatalf("Apply: %v", err)
+		}
+	}
+
+	all := orgPage{limit: defaultOrgLimit}
+	got, err := listOrgResults(ctx, idx, store, "github.com", "org", all)
+	if err != nil {
+		t.Fatalf("listOrgResults: %v", err)
+	}
+	want := &models.OrgResults{
+		Platform: "github.com",
+		Org:      "org",
+		Repos: []*models.RepoSummary{
+			{
+				Name: "github.com/org/one", Date: "2024-02-01", Score: 8,
+				Checks: []*models.CheckScore{{Name: "A", Score: 10}, {Name: "B", Score: 6}},
+			},
+			{
+				Name: "github.com/org/three", Date: "2024-02-02", Score: 3,
+				Checks: []*models.CheckScore{{Name: "A", Score: 2}, {Name: "B", Score: 4}},
+			},
+			{
+				Name: "github.com/org/two", Date: "2024-01-01", Score: 4.5,
+				Checks: []*models.CheckScore{{Name: "A", Score: 4}, {Name: "B", Score: -1}},
+			},
+		},
+		Summary: &models.OrgSummary{
+			Repos: 3,
+			Score: &models.ScoreStats{
+				Mean:         (8 + 3 + 4.5) / 3.0,
+				Median:       4.5,
+				Distribution: map[string]int64{"8": 1, "3": 1, "4": 1},
+			},
+			Checks: []*models.CheckStats{
+				{Name: "A", Stats: &models.ScoreStats{
+					Mean: 16 / 3.0, Median: 4, Distribution: map[string]int64{"10": 1, "2": 1, "4": 1},
+				}},
+				{Name: "B", Stats: &models.ScoreStats{
+					Mean: 5, Median: 5, Distribution: map[string]int64{"6": 1, "4": 1, "-1": 1},
+				}},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("listOrgResults() mismatch (-want +got):\n%s", diff)
+	}
+
+	// Repositories are paged by name: five, four, one, seven, six, three, two.
+	// The statistics are over all of them on every page.
+	for _, tt := range []struct {
+		want      []string
+		page      orgPage
+		truncated bool
+	}{
+		{page: orgPage{limit: 2, offset: 2}, want: []string{"github.com/org/one"}, truncated: true},
+		{page: orgPage{limit: 2, offset: 6}, want: []string{"github.com/org/two"}},
+		{page: orgPage{limit: 2, offset: 10}},
+	} {
+		got, err := listOrgResults(ctx, idx, store, "github.com", "org", tt.page)
+		if err != nil {
+			t.Fatalf("listOrgResults(%+v): %v", tt.page, err)
+		}
+		var names []string
+		for _, r := range got.Repos {
+			names = append(names, r.Name)
+		}
+		if diff := cmp.Diff(tt.want, names); diff != "" || got.Truncated != tt.truncated {
+			t.Errorf("listOrgResults(%+v) = %v, truncated %v, want truncated %v (-want +got):\n%s",
+				tt.page, names, got.Truncated, tt.truncated, diff)
+		}
+		if diff := cmp.Diff(want.Summary, got.Summary); diff != "" {
+			t.Errorf("listOrgResults(%+v) summary mismatch (-want +got):\n%s", tt.page, diff)
+		}
+	}
+
+	_, err = listOrgResults(ctx, idx, store, "github.com", "missing", all)
+	if !errors.Is(err, errNotFound) {
+		t.Errorf("expected %v, got %v", errNotFound, err)
+	}
+}
+
+func Test_scoreStats(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		want   *models.ScoreStats
+		name   string
+		scores []float64
+	}{
+		{
+			name:   "empty",
+			want:   &models.ScoreStats{Distribution: map[string]int64{}},
+			scores: nil,
+		},
+		{
+			name:   "only inconclusive",
+			want:   &models.ScoreStats{Distribution: map[string]int64{"-1": 2}},
+			scores: []float64{-1, -1},
+		},
+		{
+			name:   "odd count",
+			want:   &models.ScoreStats{Mean: 4, Median: 2, Distribution: map[string]int64{"0": 1, "2": 1, "10": 1}},
+			scores: []float64{10, 0, 2},
+		},
+		{
+			name:   "rounds distribution down",
+			want:   &models.ScoreStats{Mean: 7.5, Median: 7.5, Distribution: map[string]int64{"6": 1, "8": 1}},
+			scores: []float64{6.5, 8.5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if diff := cmp.Diff(tt.want, scoreStats(tt.scores)); diff != "" {
+				t.Errorf("scoreStats() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_sanitizeOrgInputs(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		host, org string
+		want      string
+		wantErr   bool
+	}{
+		{host: "github.com", org: "ossf", want: "github.com/ossf"},
+		{host: "github.com", org: "..", wantErr: true},
+		{host: "..", org: "ossf", wantErr: true},
+		{host: "github.com", org: "ossf/scorecard", wantErr: true},
+		{host: "github.com", org: "", wantErr: true},
+		{host: "github.com", org: "os\nsf", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := sanitizeOrgInputs(tt.host, tt.org)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("sanitizeOrgInputs(%q, %q) = %q, %v", tt.host, tt.org, got, err)
+		}
+	}
+}
@@ -17,6 +17,7 @@ package server
 import (
 	"bytes"
 	"context"
+	"crypto"
 	"crypto/ecdsa"
 	"crypto/sha256"
 	"crypto/x509"
@@ -27,16 +28,16 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
-	"io"
 	"log"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/cyberphone/json-canonicalization/go/src/webpki.org/jsoncanonicalizer"
 	"github.com/go-openapi/runtime/middleware"
-	"github.com/go-openapi/strfmt"
 	"github.com/google/go-github/v65/github"
 	merkleproof "github.com/transparency-dev/merkle/proof"
 	"github.com/transparency-dev/merkle/rfc6962"
@@ -45,7 +46,9 @@ import (
 	"github.com/ossf/scorecard-webapp/app/generated/models"
 	"github.com/ossf/scorecard-webapp/app/generated/restapi/operations/results"
 	"github.com/ossf/scorecard-webapp/app/server/internal/cdn"
+	"github.com/ossf/scorecard-webapp/app/server/internal/dsse"
 	"github.com/ossf/scorecard-webapp/app/server/internal/hashedrekord"
+	"github.com/ossf/scorecard-webapp/app/server/internal/rekor"
 )
 
 const (
@@ -59,29 +62,28 @@ const (
 	resultsFile       = "results.json"
 	noTlogIndex       = 0
 	githubOIDCIssuer  = "https://token.actions.githubusercontent.com"
+	// publishTimeout bounds writing a verified result, which carries on if the client goes away.
+	publishTimeout = time.Minute
 )
 
 var (
 	errWritingBucket            = errors.New("error writing to GCS bucket")
-	errMultipleCerts            = errors.New("multiple certificates found for the entry")
 	errEmptyCertRef             = errors.New("cert has empty repository ref")
 	errEmptyCertPath            = errors.New("cert has empty repository path")
 	errCertMissingURI           = errors.New("certificate has no URIs")
 	errCertWorkflowPathEmpty    = errors.New("cert workflow path is empty")
 	errMismatchedCertAndRequest = errors.New("repository and branch of cert doesn't match that of request")
 	errNotDefaultBranch         = errors.New("branch of cert isn't the repo's default branch")
-	errNoTlogEntry              = errors.New("no transparency log entry found")
+	errNoTlogEntry              = rekor.ErrNoEntry
 	errNotRekordEntry           = errors.New("not a rekord entry")
 	errMismatchedTlogEntry      = errors.New("tlog entry does not match payload")
+	errNoCheckpoint             = errors.New("tlog entry has no checkpoint")
+	errMismatchedCheckpoint     = errors.New("inclusion proof does not match the checkpoint")
 	errNotOIDC                  = errors.New(`ensure your GitHub workflow has "id-token: write" permissions`)
 
 	// errRekorSearchUnavailable indicates the Rekor search-by-hash index could not be
-	// used to locate a tlog entry: the endpoint returned a non-OK status or a
-	// non-JSON body. Rekor v2 removed the search index (a best-effort service) and
-	// the v1 index is wound down, so this path can fail independently of whether a
-	// payload is legitimately logged. Callers that have a tlog index should prefer
-	// looking entries up directly; see extractAndVerifyCertForPayload.
-	errRekorSearchUnavailable = errors.New("rekor search index unavailable")
+	// used to locate a tlog entry; see rekor.ErrSearchUnavailable.
+	errRekorSearchUnavailable = rekor.ErrSearchUnavailable
 )
 
 type certInfo struct {
@@ -93,21 +95,8 @@ type certInfo struct {
 	issuer        string
 }
 
-type tlogEntry struct {
-	Body           string `json:"body"`
-	IntegratedTime int64  `json:"integratedTime"`
-	LogID          string `json:"logID"`
-	LogIndex       int64  `json:"logIndex"`
-	Verification   *struct {
-		InclusionProof *struct {
-			Hashes   []string `json:"hashes"`
-			RootHash string   `json:"rootHash"`
-			TreeSize uint64   `json:"treeSize"`
-			LogIndex uint64   `json:"logIndex"`
-		} `json:"inclusionProof,omitempty"`
-		SignedEntryTimestamp strfmt.Base64 `json:"signedEntryTimestamp,omitempty"`
-	} `json:"verification"`
-}
+// tlogEntry is a Rekor entry, with the helpers to verify it.
+type tlogEntry rekor.Entry
 
 //go:embed fulcio_v1.crt.pem
 var fulcioRoot []byte
@@ -118,6 +107,9 @@ var fulcioIntermediate []byte
 //go:embed rekor.pub
 var rekorPub []byte
 
+//go:embed ctfe.pub
+var ctfePub []byte
+
 func PostResultsHandler(params results.PostResultParams) middleware.Responder {
 	// Sanity check
 	host := params.Platform
@@ -125,10 +117,18 @@ func PostResultsHandler(params results.PostResultParams) middleware.Responder {
 	repoName := params.Repo
 
 	// Process
-	err := processRequest(host, orgName, repoName, params.Publish)
+	err := processRequest(requestContext(params.HTTPRequest), getUpstreams(), host, orgName, repoName, params.Publish)
 	if err == nil {
 		return results.NewPostResultCreated().WithPayload("successfully verified and published ScorecardResult")
 	}
+	var rErr *resultValidationError
+	if errors.As(err, &rErr) {
+		return results.NewPostResultBadRequest().WithPayload(&models.Error{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+			Fields:  rErr.fields,
+		})
+	}
 	var vErr verificationError
 	if errors.As(err, &vErr) || errors.Is(err, errWorkflowParse) || errors.Is(err, errNotOIDC) {
 		return results.NewPostResultBadRequest().WithPayload(&models.Error{
@@ -143,9 +143,22 @@ func PostResultsHandler(params results.PostResultParams) middleware.Responder {
 	})
 }
 
-func processRequest(host, org, repo string, scorecardResult *models.VerifiedScorecardResult) error {
-	ctx := context.Background()
-	cert, err := extractAndVerifyCertForPayload(ctx, []byte(scorecardResult.Result), scorecardResult.TlogIndex)
+// requestContext returns the request's context, or the background context if there's no request,
+// as when handlers are called directly.
+func requestContext(r *http.Request) context.Context {
+	if r == nil {
+		return context.Background()
+	}
+	return r.Context()
+}
+
+// processRequest verifies and publishes the result. ctx is the incoming request's, so the
+// verification's upstream calls are abandoned if the client goes away. Once verified, the result
+// is published regardless, so its latest and commit results, index entry and purge stay in step.
+func processRequest(ctx context.Context, u upstreams, host, org, repo string,
+	scorecardResult *models.VerifiedScorecardResult,
+) error {
+	cert, err := extractAndVerifyCertForPayload(ctx, u, []byte(scorecardResult.Result), scorecardResult.TlogIndex)
 	if err != nil {
 		return fmt.Errorf("error extracting cert: %w", err)
 	}
@@ -159,35 +172,64 @@ func processRequest(host, org, repo string, scorecardResult *models.VerifiedScor
 			info.repoBranchRef != fmt.Sprintf("refs/heads/%s", scorecardResult.Branch)) {
 		return verificationError{e: errMismatchedCertAndRequest}
 	}
+	// Results published as an attestation are the predicate of the signed statement.
+	result, err := unwrapResult([]byte(scorecardResult.Result))
+	if err != nil {
+		return err
+	}
+	if err := validateResult(result, host, org, repo, info.repoSHA); err != nil {
+		return err
+	}
 
-	if err := getAndVerifyWorkflowContent(ctx, scorecardResult, info); err != nil {
+	githubClient := u.githubClient(scorecardResult.AccessToken)
+	if err := getAndVerifyWorkflowContent(ctx, githubClient, scorecardResult, info); err != nil {
 		return fmt.Errorf("workflow verification failed: %w", err)
 	}
 
+	ctx, cancel := publishContext(ctx)
+	defer cancel()
+
 	// Save scorecard results (results.json, score.txt) to GCS
-	bucketURL := resultsBucket
+	bucketURL := u.resultsBucket
 	objectPath := fmt.Sprintf("%s/%s/%s/%s", host, org, repo, resultsFile)
-	if err := writeToBlobStore(ctx, bucketURL, objectPath, []byte(scorecardResult.Result)); err != nil {
+	if err := writeToBlobStore(ctx, bucketURL, objectPath, result); err != nil {
 		return fmt.Errorf("%w: %v", errWritingBucket, err)
 	}
-	purger := getPurger()
-	path := fmt.Sprintf("/projects/%s/%s/%s", host, org, repo)
-	if err := purger.Purge(ctx, path); err != nil {
-		log.Println("error purging CDN for " + path + ": " + err.Error())
-	}
 
 	commitObjectPath := fmt.Sprintf("%s/%s/%s/%s/%s", host, org, repo, info.repoSHA, resultsFile)
-	if err := writeToBlobStore(ctx, bucketURL, commitObjectPath, []byte(scorecardResult.Result)); err != nil {
+	if err := writeToBlobStore(ctx, bucketURL, commitObjectPath, result); err != nil {
 		return fmt.Errorf("%w: %v", errWritingBucket, err)
 	}
-	path = fmt.Sprintf("/projects/%s/%s/%s?commit=%s", host, org, repo, info.repoSHA)
-	if err := purger.Purge(ctx, path); err != nil {
-		log.Println("error purging CDN for " + path + ": " + err.Error())
-	}
 
+	published := *scorecardResult
+	published.Result = string(result)
+	updateSearchIndex(ctx, u.searchIndex, host, org, repo, &published, info)
+	purgeRepo(ctx, u.purges, host, org, repo, info.repoSHA)
 	return nil
 }
 
+// publishContext detaches ctx from the request's cancellation, bounded by publishTimeout instead.
+func publishContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), publishTimeout)
+}
+
+// purgeRepo queues invalidation of every cached response for the repository, and the organization
+// listing including it, via their surrogate keys. sha is the commit whose result changed, if any.
+// If a key purge isn't possible, the queue falls back to purging the result URLs directly.
+func purgeRepo(ctx context.Context, queue *cdn.Queue, host, org, repo, sha string) {
+	keys := []string{repoSurrogateKey(host, org, repo), orgSurrogateKey(host, org)}
+	paths := []string{
+		fmt.Sprintf("/projects/%s/%s/%s", host, org, repo),
+		fmt.Sprintf("/projects/%s/%s", host, org),
+	}
+	if sha != "" {
+		paths = append(paths, fmt.Sprintf("/projects/%s/%s/%s?commit=%s", host, org, repo, sha))
+	}
+	if err := queue.Enqueue(ctx, keys, paths...); err != nil {
+		log.Println("error queueing CDN purge for " + strings.Join(keys, " ") + ": " + err.Error())
+	}
+}
+
 func fullName(org, repo string) string {
 	return fmt.Sprintf("%s/%s", org, repo)
 }
@@ -213,17 +255,10 @@ func splitRepoName(path string) (org, repo string, ok bool) {
 // getAndVerifyWorkflowContent retrieves the workflow content from the repository and verifies it.
 // It verifies the branch is a default branch and gets the scorecard workflow from the repository
 // from the specific commit and verifies it to ensure that it hasn't been tampered with.
-func getAndVerifyWorkflowContent(ctx context.Context,
+// client must be authenticated with the request's access token, if any, see upstreams.githubClient.
+func getAndVerifyWorkflowContent(ctx context.Context, client *github.Client,
 	scorecardResult *models.VerifiedScorecardResult, info certInfo,
 ) error {
-	// Get the corresponding GitHub repository.
-	httpClient := http.DefaultClient
-	if scorecardResult.AccessToken != "" {
-		httpClient.Transport = githubTransport{
-			token: scorecardResult.AccessToken,
-		}
-	}
-	client := github.NewClient(httpClient)
 	// Organization and repo of the project being analyzed
 	org, repo, ok := splitRepoName(info.repoFullName)
 	if !ok {
@@ -290,7 +325,10 @@ func writeToBlobStore(ctx context.Context, bucketURL, filename string, data []by
 	return nil
 }
 
-func extractAndVerifyCertForPayload(ctx context.Context, payload []byte, tlogIndex int64) (*x509.Certificate, error) {
+// extractAndVerifyCertForPayload finds the Rekor entry of the payload, checks it's in the log, and
+// returns the certificate which signed the payload once verified against the configured trust.
+func extractAndVerifyCertForPayload(ctx context.Context, u upstreams, payload []byte, tlogIndex int64,
+) (*x509.Certificate, error) {
 	var entry *tlogEntry
 	var uuid string
 	var err error
@@ -298,19 +336,19 @@ func extractAndVerifyCertForPayload(ctx context.Context, payload []byte, tlogInd
 	// #135 older versions of scorecard action wont send the tlog index, but newer ones will
 	if tlogIndex == noTlogIndex {
 		// Get most recent Rekor entry uuid.
-		uuids, err := getUUIDsByPayload(ctx, payload)
+		uuids, err := getUUIDsByPayload(ctx, u.rekor, payload)
 		if err != nil || len(uuids) == 0 {
 			return nil, fmt.Errorf("error finding tlog entries corresponding to payload: %w", err)
 		}
 		uuid = uuids[len(uuids)-1] // ignore past entries.
 
 		// Get tlog entry from the UUID.
-		entry, err = getTLogEntryByUUID(ctx, uuid)
+		entry, err = getTLogEntryByUUID(ctx, u.rekor, uuid)
 		if err != nil {
 			return nil, fmt.Errorf("error fetching tlog entry: %w", err)
 		}
 	} else {
-		uuid, entry, err = getTLogEntryByIndex(ctx, tlogIndex)
+		uuid, entry, err = getTLogEntryByIndex(ctx, u.rekor, tlogIndex)
 		if err != nil {
 			return nil, fmt.Errorf("error fetching tlog entry: %w", err)
 		}
@@ -326,23 +364,29 @@ func extractAndVerifyCertForPayload(ctx context.Context, payload []byte, tlogInd
 	}
 
 	// Verify inclusion proof.
-	if err = verifyInclusionProof(uuid, entry); err != nil {
+	checkpoint, err := verifyInclusionProof(u.rekorKey, uuid, entry)
+	if err != nil {
 		return nil, fmt.Errorf("unable to verify rekor inclusion proof: %w", err)
 	}
+	// Check the log shows us the same history as before.
+	if err := u.witness.Observe(ctx, checkpoint); err != nil {
+		return nil, fmt.Errorf("witnessing rekor checkpoint: %w", err)
+	}
 
-	// Extract and verify certificate.
+	// Extract and verify certificate: the leaf, followed by any intermediates.
 	certs, err := rekordBody.Certs()
 	if err != nil || len(certs) == 0 {
 		return nil, fmt.Errorf("error extracting certificate from entry: %w", err)
 	}
-	if len(certs) > 1 {
-		return nil, errMultipleCerts
-	}
-
 	cert := certs[0]
-	if err = verifyCert(certs[0], time.Unix(entry.IntegratedTime, 0)); err != nil {
+	if err = u.fulcio.verify(certs, time.Unix(entry.IntegratedTime, 0)); err != nil {
 		return nil, fmt.Errorf("verifying cert: %w", err)
 	}
+
+	// Rekor checked the signature when logging the entry, but we don't rely on it.
+	if err := rekordBody.VerifySignature(payload, cert.PublicKey); err != nil {
+		return nil, fmt.Errorf("verifying signature: %w", err)
+	}
 	return cert, nil
 }
 
@@ -350,108 +394,56 @@ func extractAndVerifyCertForPayload(ctx context.Context, payload []byte, tlogInd
 // It takes the payload as a byte array and converts it to a SHA256 hash.
 // It then queries the Rekor server for all entries that contain the hash.
 // It returns the UUIDs of the entries that contain the payload.
-func getUUIDsByPayload(ctx context.Context, payload []byte) ([]string, error) {
+func getUUIDsByPayload(ctx context.Context, tlog rekor.Client, payload []byte) ([]string, error) {
 	payloadSHA := sha256.Sum256(payload)
-	rekorPayload := struct {
-		Hash string `json:"hash"`
-	}{
-		Hash: fmt.Sprintf("sha256:%s", hex.EncodeToString(payloadSHA[:])),
-	}
-	jsonPayload, err := json.Marshal(rekorPayload)
-	if err != nil {
-		return nil, fmt.Errorf("marshaling json payload: %w", err)
-	}
-
-	rekorReq, err := http.NewRequestWithContext(ctx,
-		http.MethodPost,
-		"https://rekor.sigstore.dev/api/v1/index/retrieve",
-		bytes.NewBuffer(jsonPayload))
-	if err != nil {
-		return nil, fmt.Errorf("creating new HTTP request: %w", err)
-	}
-	rekorReq.Header.Add("Content-Type", "application/json")
-	rekorReq.Header.Add("accept", "application/json")
-	resp, err := http.DefaultClient.Do(rekorReq)
+	uuids, err := tlog.SearchByHash(ctx, fmt.Sprintf("sha256:%s", hex.EncodeToString(payloadSHA[:])))
 	if err != nil {
-		return nil, fmt.Errorf("looking up Rekor index: %w", err)
-	}
-	defer resp.Body.Close()
-
-	// A non-OK status means the search index isn't answering usefully (e.g. it has
-	// been wound down and an intermediary returns an HTML error page). Surface that
-	// as errRekorSearchUnavailable instead of letting the JSON decoder fail with an
-	// opaque "invalid character '<'" so callers can distinguish it from a real miss.
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
-		return nil, fmt.Errorf("%w: status %d: %s", errRekorSearchUnavailable,
-			resp.StatusCode, strings.TrimSpace(string(body)))
-	}
-
-	var rekorResult []string
-	if err := json.NewDecoder(resp.Body).Decode(&rekorResult); err != nil {
-		// A 200 with a non-JSON body likewise means the index isn't usable.
-		return nil, fmt.Errorf("%w: decoding response: %w", errRekorSearchUnavailable, err)
+		return nil, fmt.Errorf("searching Rekor: %w", err)
 	}
-
-	return rekorResult, nil
+	return uuids, nil
 }
 
-// tlog entry helper function, url should be a variation of the https://rekor.sigstore.dev/api/v1/log/entries endpoint.
-func getTLogEntryFromURL(ctx context.Context, url string) (uuid string, entry *tlogEntry, err error) {
-	rekorReq, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return "", nil, fmt.Errorf("creating new HTTP request: %w", err)
-	}
-	rekorReq.Header.Add("accept", "application/json")
-	resp, err := http.DefaultClient.Do(rekorReq)
+// getTLogEntryByIndex fetches the UUID and tlog entry from Rekor by tlog index.
+func getTLogEntryByIndex(ctx context.Context, tlog rekor.Client, index int64,
+) (uuid string, entry *tlogEntry, err error) {
+	uuid, e, err := tlog.EntryByIndex(ctx, index)
 	if err != nil {
-		return "", nil, fmt.Errorf("looking up Rekor index: %w", err)
-	}
-	defer resp.Body.Close()
-
-	var rekorResult map[string]tlogEntry
-	if err := json.NewDecoder(resp.Body).Decode(&rekorResult); err != nil {
-		return "", nil, fmt.Errorf("decoding Rekor response: %w", err)
-	}
-
-	for uuid, res := range rekorResult {
-		return uuid, &res, nil
+		return "", nil, fmt.Errorf("getting Rekor entry %d: %w", index, err)
 	}
-	return "", nil, errNoTlogEntry
-}
-
-// getTLogEntryByIndex fetches the UUID and tlog entry from Rekor by tlog index.
-func getTLogEntryByIndex(ctx context.Context, index int64) (uuid string, entry *tlogEntry, err error) {
-	url := fmt.Sprintf("https://rekor.sigstore.dev/api/v1/log/entries?logIndex=%d", index)
-	return getTLogEntryFromURL(ctx, url)
+	return uuid, (*tlogEntry)(e), nil
 }
 
 // getTLogEntryByUUID fetches the tlog entry from Rekor by UUID.
-func getTLogEntryByUUID(ctx context.Context, uuid string) (*tlogEntry, error) {
-	url := fmt.Sprintf("https://rekor.sigstore.dev/api/v1/log/entries/%s", uuid)
-	_, entry, err := getTLogEntryFromURL(ctx, url)
-	return entry, err
+func getTLogEntryByUUID(ctx context.Context, tlog rekor.Client, uuid string) (*tlogEntry, error) {
+	e, err := tlog.EntryByUUID(ctx, uuid)
+	if err != nil {
+		return nil, fmt.Errorf("getting Rekor entry %s: %w", uuid, err)
+	}
+	return (*tlogEntry)(e), nil
 }
 
-// verifyInclusionProof verifies the inclusion proof of the tlog entry.
+// verifyInclusionProof verifies the inclusion proof of the tlog entry, and returns the checkpoint it
+// was proven against.
 // It hex decodes the RootHash from the tlog entry and hex decodes the uuid as the leaf hash.
 // It then verifies the merkelproof using the RootHash, LeafHash, and InclusionProof hashes from the
-// tlog entry. It also ensures that the timestamp of the tlog entry  was signed by rekor public key.
-func verifyInclusionProof(uuid string, e *tlogEntry) error {
+// tlog entry. The RootHash must be the one of the entry's checkpoint, signed by rekorKey, the PEM
+// encoded Rekor public key, as must be the timestamp of the tlog entry.
+// The checkpoint should then be checked against the ones seen before, see witness.Witness.
+func verifyInclusionProof(rekorKey []byte, uuid string, e *tlogEntry) (*rekor.Checkpoint, error) {
 	if e == nil || e.Verification == nil || e.Verification.InclusionProof == nil {
-		return fmt.Errorf("no inclusion proof provided")
+		return nil, fmt.Errorf("no inclusion proof provided")
 	}
 	rootHash, err := hex.DecodeString(e.Verification.InclusionProof.RootHash)
 	if err != nil {
-		return fmt.Errorf("error decoding hex encoded root hash: %w", err)
+		return nil, fmt.Errorf("error decoding hex encoded root hash: %w", err)
 	}
 
 	leafHash, err := hex.DecodeString(uuid)
 	if err != nil {
-		return fmt.Errorf("error decoding hex encoded leaf hash: %w", err)
+		return nil, fmt.Errorf("error decoding hex encoded leaf hash: %w", err)
 	}
 	if len(leafHash) < 32 {
-		return fmt.Errorf("leafHash has unexpected size %d, want 32", len(leafHash))
+		return nil, fmt.Errorf("leafHash has unexpected size %d, want 32", len(leafHash))
 	}
 	if len(leafHash) > 32 {
 		leafHash = leafHash[len(leafHash)-32:]
@@ -461,7 +453,7 @@ func verifyInclusionProof(uuid string, e *tlogEntry) error {
 	for _, h := range e.Verification.InclusionProof.Hashes {
 		hb, err := hex.DecodeString(h)
 		if err != nil {
-			return fmt.Errorf("error decoding inclusion proof hashes: %w", err)
+			return nil, fmt.Errorf("error decoding inclusion proof hashes: %w", err)
 		}
 		hashes = append(hashes, hb)
 	}
@@ -469,23 +461,38 @@ func verifyInclusionProof(uuid string, e *tlogEntry) error {
 	if err := merkleproof.VerifyInclusion(rfc6962.DefaultHasher,
 		e.Verification.InclusionProof.LogIndex,
 		e.Verification.InclusionProof.TreeSize, leafHash, hashes, rootHash); err != nil {
-		return fmt.Errorf("%w: %s", err, "verifying inclusion proof")
+		return nil, fmt.Errorf("%w: %s", err, "verifying inclusion proof")
 	}
 
-	// Verify the SignedEntryTimestamp against Rekor's pub key.
-	derBytes, _ := pem.Decode(rekorPub)
+	derBytes, _ := pem.Decode(rekorKey)
 	if derBytes == nil {
-		return errors.New("PEM decoding failed")
+		return nil, errors.New("PEM decoding failed")
 	}
 	rekorPubKey, err := x509.ParsePKIXPublicKey(derBytes.Bytes)
 	if err != nil {
-		return fmt.Errorf("parsing Rekor pub key: %w", err)
+		return nil, fmt.Errorf("parsing Rekor pub key: %w", err)
 	}
 	rekorECDSA, ok := rekorPubKey.(*ecdsa.PublicKey)
 	if !ok {
-		return errors.New("public key retrieved from Rekor is not an ECDSA key")
+		return nil, errors.New("public key retrieved from Rekor is not an ECDSA key")
 	}
 
+	// Verify the root hash is the one Rekor signed for the tree size.
+	if e.Verification.InclusionProof.Checkpoint == "" {
+		return nil, errNoCheckpoint
+	}
+	checkpoint, err := rekor.ParseCheckpoint(e.Verification.InclusionProof.Checkpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing checkpoint: %w", err)
+	}
+	if err := checkpoint.Verify(rekorECDSA); err != nil {
+		return nil, fmt.Errorf("verifying checkpoint: %w", err)
+	}
+	if checkpoint.Size != e.Verification.InclusionProof.TreeSize || !bytes.Equal(checkpoint.RootHash, rootHash) {
+		return nil, errMismatchedCheckpoint
+	}
+
+	// Verify the SignedEntryTimestamp against Rekor's pub key.
 	payload := struct {
 		Body           string `json:"body"`
 		IntegratedTime int64  `json:"integratedTime"`
@@ -499,54 +506,17 @@ func verifyInclusionProof(uuid string, e *tlogEntry) error {
 	}
 	jsonPayload, err := json.Marshal(payload)
 	if err != nil {
-		return fmt.Errorf("json marshalling Rekor payload: %w", err)
+		return nil, fmt.Errorf("json marshalling Rekor payload: %w", err)
 	}
 	jsonCanonicalized, err := jsoncanonicalizer.Transform(jsonPayload)
 	if err != nil {
-		return fmt.Errorf("json canonicalizer: %w", err)
+		return nil, fmt.Errorf("json canonicalizer: %w", err)
 	}
 	hash := sha256.Sum256(jsonCanonicalized)
 	if !ecdsa.VerifyASN1(rekorECDSA, hash[:], e.Verification.SignedEntryTimestamp) {
-		return fmt.Errorf("unable to verify")
+		return nil, fmt.Errorf("unable to verify")
 	}
-	return nil
-}
-
-// verifyCert verifies the certificate from the tlog entry against the fulcio root cert and
-// fulcio intermediate cert.
-// It also verifies the certs are not expired by checking the notBefore and notAfter fields based
-// on the integratedTime from the tlog entry.
-func verifyCert(cert *x509.Certificate, integratedTime time.Time) error {
-	// Verify the certificate against Fulcio Root CA
-	roots, err := getCertPool(fulcioRoot)
-	if err != nil {
-		return fmt.Errorf("retrieving Fulcio root: %w", err)
-	}
-	intermediates, err := getCertPool(fulcioIntermediate)
-	if err != nil {
-		return fmt.Errorf("retrieving Fulcio root: %w", err)
-	}
-	if _, err := cert.Verify(x509.VerifyOptions{
-		CurrentTime:   cert.NotBefore,
-		Roots:         roots,
-		Intermediates: intermediates,
-		KeyUsages: []x509.ExtKeyUsage{
-			x509.ExtKeyUsageCodeSigning,
-		},
-	}); err != nil {
-		return fmt.Errorf("verifying Fulcio issued certificate: %w", err)
-	}
-
-	// Verify that cert isn't expired.
-	if cert.NotAfter.Before(integratedTime) {
-		return fmt.Errorf("certificate expired before signatures were entered in log: %s is before %s",
-			cert.NotAfter, integratedTime)
-	}
-	if cert.NotBefore.After(integratedTime) {
-		return fmt.Errorf("certificate was issued after signatures were entered in log: %s is after %s",
-			cert.NotAfter, integratedTime)
-	}
-	return nil
+	return checkpoint, nil
 }
 
 // extractCertInfo extracts the repository information from the certificate.
@@ -606,43 +576,190 @@ func getCertPool(cert []byte) (*x509.CertPool, error) {
 	return pool, nil
 }
 
-func (t tlogEntry) rekord() (hashedrekord.Body, error) {
+// rekordBody is the body of a Rekor entry kind we accept: hashedrekord, or dsse or intoto
+// for results published as an in-toto attestation.
+type rekordBody interface {
+	// Matches checks if the entry is for the payload.
+	Matches(payload []byte) bool
+	// Certs extracts the entry's certificates.
+	Certs() ([]*x509.Certificate, error)
+	// VerifySignature verifies the entry's signature of the payload with the public key.
+	VerifySignature(payload []byte, pub crypto.PublicKey) error
+}
+
+func (t tlogEntry) rekord() (rekordBody, error) {
 	b, err := base64.StdEncoding.DecodeString(t.Body)
 	if err != nil {
-		return hashedrekord.Body{}, fmt.Errorf("decode rekord body: %w", err)
+		return nil, fmt.Errorf("decode rekord body: %w", err)
+	}
+	var header struct {
+		Kind string `json:"kind"`
 	}
-	var body hashedrekord.Body
-	if err := json.Unmarshal(b, &body); err != nil {
-		return hashedrekord.Body{}, fmt.Errorf("unmarshal rekord body: %w", err)
+	if err := json.Unmarshal(b, &header); err != nil {
+		return nil, fmt.Errorf("unmarshal rekord body: %w", err)
 	}
-	if body.Kind != hashedrekord.Kind {
-		return hashedrekord.Body{}, errNotRekordEntry
+	var body rekordBody
+	switch header.Kind {
+	case hashedrekord.Kind:
+		body, err = unmarshalBody[hashedrekord.Body](b)
+	case dsse.Kind:
+		body, err = unmarshalBody[dsse.Body](b)
+	case dsse.IntotoKind:
+		body, err = unmarshalBody[dsse.IntotoBody](b)
+	default:
+		return nil, errNotRekordEntry
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal rekord body: %w", err)
 	}
 	return body, nil
 }
 
+func unmarshalBody[T rekordBody](b []byte) (T, error) {
+	var body T
+	err := json.Unmarshal(b, &body)
+	return body, err
+}
+
 // Gets the relevant purger depending on if this is a local dev environment
 // or a hosted environmen (staging or prod).
 func getPurger() cdn.Purger {
+	return newPurger(os.Getenv)
+}
+
+// newPurger builds the purgers listed in CDN_PURGERS (comma separated, default "fastly").
+// Purgers missing their configuration are skipped, and several purgers are fanned out to.
+func newPurger(getenv func(string) string) cdn.Purger {
 	// STORAGE_EMULATOR_HOST is set locally, so we don't want to purge the CDN.
-	if os.Getenv("STORAGE_EMULATOR_HOST") != "" {
+	if getenv("STORAGE_EMULATOR_HOST") != "" {
 		log.Println("API result CDN purging disabled, STORAGE_EMULATOR_HOST is set")
 		return cdn.NewNoOpClient()
 	}
 
+	names := getenv("CDN_PURGERS")
+	if names == "" {
+		names = "fastly"
+	}
+	var purgers []cdn.Purger
+	for _, name := range strings.Split(names, ",") {
+		var purger cdn.Purger
+		switch name = strings.TrimSpace(strings.ToLower(name)); name {
+		case "fastly":
+			purger = newFastlyPurger(getenv)
+		case "cloudflare":
+			purger = newCloudflarePurger(getenv)
+		case "varnish":
+			purger = newVarnishPurger(getenv)
+		default:
+			log.Println("ignoring unknown CDN purger " + name)
+		}
+		if purger != nil {
+			purgers = append(purgers, purger)
+		}
+	}
+
+	switch len(purgers) {
+	case 0:
+		return cdn.NewNoOpClient()
+	case 1:
+		return purgers[0]
+	default:
+		return cdn.NewMultiPurger(purgers...)
+	}
+}
+
+func newFastlyPurger(getenv func(string) string) cdn.Purger {
 	// the URL should have the scheme, e.g. API_BASE_URL=https://api.scorecard.dev
-	apiBaseURL := os.Getenv("API_BASE_URL")
+	apiBaseURL := getenv("API_BASE_URL")
 	if apiBaseURL == "" {
 		log.Println("API result CDN purging disabled, API_BASE_URL not set")
-		return cdn.NewNoOpClient()
+		return nil
 	}
 
-	purgeToken := os.Getenv("FASTLY_PURGE_TOKEN")
+	purgeToken := getenv("FASTLY_PURGE_TOKEN")
 	if purgeToken == "" {
 		log.Println("API result CDN purging disabled, FASTLY_PURGE_TOKEN not set")
-		return cdn.NewNoOpClient()
+		return nil
+	}
+
+	// Surrogate key purges go through the Fastly API and need the service ID.
+	// Without it, only the result URLs are purged.
+	serviceID := getenv("FASTLY_SERVICE_ID")
+	if serviceID == "" {
+		log.Println("API result CDN surrogate key purging disabled, FASTLY_SERVICE_ID not set")
 	}
 
 	log.Println("API result CDN purging enabled for " + apiBaseURL)
-	return cdn.NewFastlyClient(purgeToken, apiBaseURL)
+	return cdn.NewFastlyClient(purgeToken, apiBaseURL, serviceID).WithHTTPClient(newUpstreamClient(getenv, "fastly"))
+}
+
+func newCloudflarePurger(getenv func(string) string) cdn.Purger {
+	apiBaseURL := getenv("API_BASE_URL")
+	token := getenv("CLOUDFLARE_API_TOKEN")
+	zoneID := getenv("CLOUDFLARE_ZONE_ID")
+	if apiBaseURL == "" || token == "" || zoneID == "" {
+		log.Println("Cloudflare purging disabled, API_BASE_URL, CLOUDFLARE_API_TOKEN and CLOUDFLARE_ZONE_ID must be set")
+		return nil
+	}
+	log.Println("Cloudflare purging enabled for " + apiBaseURL)
+	client := cdn.NewCloudflareClient(token, zoneID, apiBaseURL).
+		WithHTTPClient(newUpstreamClient(getenv, "cloudflare"))
+
+	// Tag purges match Cache-Tag, which only an edge rule mirroring Surrogate-Key sets.
+	// Without one, only the result URLs are purged.
+	tags, err := strconv.ParseBool(getenv("CLOUDFLARE_PURGE_TAGS"))
+	if err != nil || !tags {
+		log.Println("Cloudflare tag purging disabled, CLOUDFLARE_PURGE_TAGS not true")
+		return client
+	}
+	return client.WithTagPurging()
+}
+
+func newVarnishPurger(getenv func(string) string) cdn.Purger {
+	// the URL of the cache itself, which may differ from API_BASE_URL, e.g. VARNISH_URL=http://varnish:6081
+	varnishURL := getenv("VARNISH_URL")
+	if varnishURL == "" {
+		log.Println("Varnish purging disabled, VARNISH_URL not set")
+		return nil
+	}
+	method := cdn.VarnishPurge
+	if strings.EqualFold(getenv("VARNISH_METHOD"), string(cdn.VarnishBan)) {
+		method = cdn.VarnishBan
+	}
+	log.Println("Varnish purging enabled for " + varnishURL)
+	return cdn.NewVarnishClient(varnishURL, method).WithHTTPClient(newUpstreamClient(getenv, "varnish"))
+}
+
+var (
+	purgeQueue     *cdn.Queue
+	purgeQueueOnce sync.Once
+)
+
+// StartPurgeQueue starts the queue which purges the CDN at startup, rather than on the first
+// publish, so purges pending from before a restart resume and its metrics are served right away.
+func StartPurgeQueue() {
+	getPurgeQueue()
+}
+
+// getPurgeQueue returns the process wide queue which purges the CDN in the background,
+// so a slow or failing CDN doesn't fail or delay publishing.
+// Set PURGE_QUEUE_BUCKET_URL to persist pending and dead-lettered purges across restarts.
+func getPurgeQueue() *cdn.Queue {
+	purgeQueueOnce.Do(func() {
+		ctx := context.Background()
+		var bucket *blob.Bucket
+		if bucketURL := os.Getenv("PURGE_QUEUE_BUCKET_URL"); bucketURL != "" {
+			var err error
+			bucket, err = blob.OpenBucket(ctx, bucketURL)
+			if err != nil {
+				log.Println("error opening purge queue bucket, pending purges won't be persisted: " + err.Error())
+				bucket = nil
+			}
+		}
+		purgeQueue = cdn.NewQueue(getPurger(), bucket, cdn.DefaultQueueOptions)
+		if err := purgeQueue.Start(ctx); err != nil {
+			log.Println("error resuming pending CDN purges: " + err.Error())
+		}
+	})
+	return purgeQueue
 }
@@ -18,10 +18,8 @@ import (
 	"context"
 	"errors"
 	"io"
-	"net/http"
 	"os"
 
-	"github.com/google/go-github/v65/github"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -49,7 +47,8 @@ var _ = Describe("E2E Test: extractAndVerifyCertForPayload", func() {
 			payload, err := io.ReadAll(testFile)
 			Expect(err).Should(BeNil())
 
-			_, errCertExtract := extractAndVerifyCertForPayload(context.Background(), payload, noTlogIndex)
+			_, errCertExtract := extractAndVerifyCertForPayload(context.Background(), getUpstreams(), payload,
+				noTlogIndex)
 			skipIfRekorSearchUnavailable(errCertExtract)
 			Expect(errCertExtract).Should(BeNil())
 		})
@@ -84,7 +83,7 @@ var _ = Describe("E2E Test: getAndVerifyWorkflowContent", func() {
 		return payload
 	}
 	extractCertInfo := func(payload []byte) certInfo {
-		cert, errCertExtract := extractAndVerifyCertForPayload(ctx, payload, noTlogIndex)
+		cert, errCertExtract := extractAndVerifyCertForPayload(ctx, getUpstreams(), payload, noTlogIndex)
 		skipIfRekorSearchUnavailable(errCertExtract)
 		Expect(errCertExtract).Should(BeNil())
 		info, errCertExtractInfo := extractCertInfo(cert)
@@ -102,7 +101,8 @@ var _ = Describe("E2E Test: getAndVerifyWorkflowContent", func() {
 				Result:      string(payload),
 				TlogIndex:   noTlogIndex,
 			}
-			Expect(getAndVerifyWorkflowContent(ctx, scorecardResult, info)).Should(BeNil())
+			client := upstreams{github: getGitHubUpstream()}.githubClient(token)
+			Expect(getAndVerifyWorkflowContent(ctx, client, scorecardResult, info)).Should(BeNil())
 		})
 	}
 	AssertInvalidWorkflowContent := func(filename string, errSubstr string) {
@@ -115,7 +115,8 @@ var _ = Describe("E2E Test: getAndVerifyWorkflowContent", func() {
 				Branch:      "main",
 				Result:      string(payload),
 			}
-			err := getAndVerifyWorkflowContent(ctx, scorecardResult, info)
+			client := upstreams{github: getGitHubUpstream()}.githubClient(token)
+			err := getAndVerifyWorkflowContent(ctx, client, scorecardResult, info)
 			Expect(err).Should(MatchError(ContainSubstring(errSubstr)))
 		})
 	}
@@ -132,14 +133,9 @@ var _ = Describe("E2E Test: getAndVerifyWorkflowContent", func() {
 
 // helper function to setup a github verifier with an appropriately set token.
 func getGithubVerifier() *githubVerifier {
-	httpClient := http.DefaultClient
 	token, _ := readGitHubTokens()
-	if token != "" {
-		httpClient.Transport = githubTransport{
-			token: token,
-		}
-	}
-	return newGitHubVerifier(context.Background(), github.NewClient(httpClient))
+	client := upstreams{github: getGitHubUpstream()}.githubClient(token)
+	return newGitHubVerifier(context.Background(), client)
 }
 
 var _ = Describe("E2E Test: githubVerifier_contains", func() {
@@ -16,23 +16,47 @@ package server
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"io"
+	"math/big"
 	"net/http"
-	"net/http/httptest"
 	"net/url"
 	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 	"unicode/utf8"
 
 	fuzz "github.com/AdaLogics/go-fuzz-headers"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/assert"
+	"github.com/transparency-dev/merkle/rfc6962"
+	"github.com/transparency-dev/merkle/testonly"
+	"gocloud.dev/blob/memblob"
 
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+	"github.com/ossf/scorecard-webapp/app/server/internal/cdn"
+	"github.com/ossf/scorecard-webapp/app/server/internal/dsse"
 	"github.com/ossf/scorecard-webapp/app/server/internal/hashedrekord"
+	"github.com/ossf/scorecard-webapp/app/server/internal/index"
+	"github.com/ossf/scorecard-webapp/app/server/internal/rekor"
+	"github.com/ossf/scorecard-webapp/app/server/internal/witness"
 )
 
 func Test_extractCertInfo(t *testing.T) {
@@ -387,19 +411,19 @@ MYSKu39B6Q==
 	}
 }
 
-func Test_getTLogEntryFromURL(t *testing.T) {
+func Test_getTLogEntryByIndex(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name         string
-		responsePath string
-		uuid         string
-		entry        *tlogEntry
-		wantErr      bool
+		name    string
+		uuid    string
+		entry   *tlogEntry
+		index   int64
+		wantErr bool
 	}{
 		{
-			name:         "valid entry",
-			responsePath: "/rekor/log-entries-response.json",
-			uuid:         "24296fb24b8ad77abaa457505061c4a0ef34197534bdd8b474acfafdc4d76c437726e98153c7b253",
+			name:  "valid entry",
+			index: 23652179,
+			uuid:  "24296fb24b8ad77abaa457505061c4a0ef34197534bdd8b474acfafdc4d76c437726e98153c7b253",
 			entry: &tlogEntry{
 				//nolint:lll
 				Body:           "eyJhcGlWZXJzaW9uIjoiMC4wLjEiLCJraW5kIjoiaGFzaGVkcmVrb3JkIiwic3BlYyI6eyJkYXRhIjp7Imhhc2giOnsiYWxnb3JpdGhtIjoic2hhMjU2IiwidmFsdWUiOiJjZDgzMjdkODY3ZmNlMDRiYzk3ZTE0OWRhNTBjMzc0NjM0MDg2OTU3NWY3YmY5NTlhNjcyODRlMzRiZmQ0NmJjIn19LCJzaWduYXR1cmUiOnsiY29udGVudCI6Ik1FWUNJUURDM0hOdUtYOGttLzdUT28rSFExV0dyL0ZJVFJvWUQ5Znc1UkNScWM3V0lnSWhBTkFuNit5ejB6aUdHSEgvNTJwRG01dkN1T1FnSG5RMEFrR3FpNlBPa0VVQiIsInB1YmxpY0tleSI6eyJjb250ZW50IjoiTFMwdExTMUNSVWRKVGlCRFJWSlVTVVpKUTBGVVJTMHRMUzB0Q2sxSlNVTXdWRU5EUVd4cFowRjNTVUpCWjBsVlpGaDRXSFJEYTJOQ2RtdDRVR2hJYzIweVR6ZHhjekppZG5Jd2QwTm5XVWxMYjFwSmVtb3dSVUYzVFhjS1RucEZWazFDVFVkQk1WVkZRMmhOVFdNeWJHNWpNMUoyWTIxVmRWcEhWakpOVWpSM1NFRlpSRlpSVVVSRmVGWjZZVmRrZW1SSE9YbGFVekZ3WW01U2JBcGpiVEZzV2tkc2FHUkhWWGRJYUdOT1RXcE5kMDVxUlhwTlZHTjZUMVJSZVZkb1kwNU5hazEzVG1wRmVrMVVZekJQVkZGNVYycEJRVTFHYTNkRmQxbElDa3R2V2tsNmFqQkRRVkZaU1V0dldrbDZhakJFUVZGalJGRm5RVVZyVUV4c1ZraEdPRlJVUldNNVl6TXpibXhDV0hGRWVsTnFSM1JQWlVKb2EwVlhRMUFLTkV3NWMxaFlkVFF2TlhkbFl6TjNlVlk0TmtvemF6ZzVMeTlGVlRRdlN5c3JlRkk0Y2twa1pWRmlhbHBEVWtGUWFHRlBRMEZZWTNkblowWjZUVUUwUndwQk1WVmtSSGRGUWk5M1VVVkJkMGxJWjBSQlZFSm5UbFpJVTFWRlJFUkJTMEpuWjNKQ1owVkdRbEZqUkVGNlFXUkNaMDVXU0ZFMFJVWm5VVlZXVWsxTUNrbE9VbnBRWmpCVlRsRjBUMVJtVlZwTk1WTlVVVE5qZDBoM1dVUldVakJxUWtKbmQwWnZRVlV6T1ZCd2VqRlphMFZhWWpWeFRtcHdTMFpYYVhocE5Ga0tXa1E0ZDBsUldVUldVakJTUVZGSUwwSkNZM2RHV1VWVVl6Tk9hbUZJU25aWk1uUkJXakk1ZGxveWVHeE1iVTUyWWxSQmMwSm5iM0pDWjBWRlFWbFBMd3BOUVVWQ1FrSTFiMlJJVW5kamVtOTJUREprY0dSSGFERlphVFZxWWpJd2RtSkhPVzVoVnpSMllqSkdNV1JIWjNkTVoxbExTM2RaUWtKQlIwUjJla0ZDQ2tOQlVXZEVRalZ2WkVoU2QyTjZiM1pNTW1Sd1pFZG9NVmxwTldwaU1qQjJZa2M1Ym1GWE5IWmlNa1l4WkVkbmQyZFpiMGREYVhOSFFWRlJRakZ1YTBNS1FrRkpSV1pCVWpaQlNHZEJaR2RFWkZCVVFuRjRjMk5TVFcxTldraG9lVnBhZW1ORGIydHdaWFZPTkRoeVppdElhVzVMUVV4NWJuVnFaMEZCUVZscE1Rb3hORE5VUVVGQlJVRjNRa2hOUlZWRFNVaDNlWGgxYkhGSk1tMUpkVTQ0YWk5NFRsY3JVbUp4YkdkMFMyWXpjVmx4TnpGalNEWTRNV1pGYUhOQmFVVkJDbWhsY1ZZM1NWUXpTek5rZUVReFR6TlhjRWxGY3poc1kxUmhTMVU0SzJvMVIyZzNUMUU0T1ZwT2FUQjNRMmRaU1V0dldrbDZhakJGUVhkTlJGcDNRWGNLV2tGSmQwSlRUMFIzWVZKMVExaG5ZMFZDUmsxS1FtaDRPVllyU1c5MlpIZE9PWHA1U1ZKNlZXbHZUbFpyVEhSUU0yODFTSFZ2TlZaamNFeE1jRUk1YndwU1VYTlNRV3BCVlM5eVJtRnJVMmRKYW14NGMyY3daV0pKUzI4ME5WSndkR3REU2toNmRVWldTM1l6VERkTVFpdExjemxSY25oV2IyZzRXbWhoTVZOaENqUk9lbWxsTmtVOUNpMHRMUzB0UlU1RUlFTkZVbFJKUmtsRFFWUkZMUzB0TFMwSyJ9fX19",
@@ -411,16 +435,19 @@ func Test_getTLogEntryFromURL(t *testing.T) {
 		},
 	}
 	ctx := context.Background()
+	tlog, err := rekor.NewFileClient("testdata/rekor")
+	if err != nil {
+		t.Fatalf("rekor.NewFileClient: %v", err)
+	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			url := setupServer(t) + tt.responsePath
-			uuid, entry, err := getTLogEntryFromURL(ctx, url)
+			uuid, entry, err := getTLogEntryByIndex(ctx, tlog, tt.index)
 			if (err != nil) != tt.wantErr {
-				t.Fatalf("getTLogEntryFromURL() error = %v, wantErr %v", err, tt.wantErr)
+				t.Fatalf("getTLogEntryByIndex() error = %v, wantErr %v", err, tt.wantErr)
 			}
 			if uuid != tt.uuid {
-				t.Errorf("getTLogEntryFromURL() uuid: %s, wanted %s", uuid, tt.uuid)
+				t.Errorf("getTLogEntryByIndex() uuid: %s, wanted %s", uuid, tt.uuid)
 			}
 			ignoreVerification := cmpopts.IgnoreFields(tlogEntry{}, "Verification")
 			if !cmp.Equal(entry, tt.entry, ignoreVerification) {
@@ -430,28 +457,12 @@ func Test_getTLogEntryFromURL(t *testing.T) {
 	}
 }
 
-func setupServer(t *testing.T) string {
-	t.Helper()
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		b, err := os.ReadFile("./testdata" + r.URL.Path)
-		if err != nil {
-			t.Logf("os.ReadFile: %v", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
-		w.WriteHeader(http.StatusOK)
-		w.Write(b)
-	}))
-	t.Cleanup(server.Close)
-	return server.URL
-}
-
 func Test_tlogEntry_rekord(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
 		name    string
 		entry   tlogEntry
-		want    hashedrekord.Body
+		want    rekordBody
 		wantErr bool
 	}{
 		{
@@ -484,6 +495,45 @@ func Test_tlogEntry_rekord(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "dsse",
+			entry: tlogEntry{Body: base64.StdEncoding.EncodeToString([]byte(`{"apiVersion":"0.0.1","kind":"dsse",` +
+				`"spec":{"envelopeHash":{"algorithm":"sha256","value":"aa"},` +
+				`"payloadHash":{"algorithm":"sha384","value":"bb"},"signatures":[{"signature":"c2ln","verifier":"cGVt"}]}}`))},
+			want: dsse.Body{
+				APIVersion: "0.0.1",
+				Kind:       dsse.Kind,
+				Spec: dsse.Spec{
+					EnvelopeHash: hashedrekord.Hash{Algorithm: "sha256", Value: "aa"},
+					PayloadHash:  hashedrekord.Hash{Algorithm: "sha384", Value: "bb"},
+					Signatures:   []dsse.Signature{{Signature: "c2ln", Verifier: "cGVt"}},
+				},
+			},
+		},
+		{
+			name: "intoto",
+			entry: tlogEntry{Body: base64.StdEncoding.EncodeToString([]byte(`{"apiVersion":"0.0.2","kind":"intoto",` +
+				`"spec":{"content":{"envelope":{"payloadType":"application/vnd.in-toto+json",` +
+				`"signatures":[{"sig":"c2ln","publicKey":"cGVt"}]},` +
+				`"hash":{"algorithm":"sha256","value":"aa"},"payloadHash":{"algorithm":"sha512","value":"bb"}}}}`))},
+			want: dsse.IntotoBody{
+				APIVersion: "0.0.2",
+				Kind:       dsse.IntotoKind,
+				Spec: dsse.IntotoSpec{Content: dsse.IntotoContent{
+					Envelope: dsse.Envelope{
+						PayloadType: "application/vnd.in-toto+json",
+						Signatures:  []dsse.EnvelopeSignature{{Sig: "c2ln", PublicKey: "cGVt"}},
+					},
+					Hash:        hashedrekord.Hash{Algorithm: "sha256", Value: "aa"},
+					PayloadHash: hashedrekord.Hash{Algorithm: "sha512", Value: "bb"},
+				}},
+			},
+		},
+		{
+			name:    "unsupported kind",
+			entry:   tlogEntry{Body: base64.StdEncoding.EncodeToString([]byte(`{"apiVersion":"0.0.1","kind":"rekord"}`))},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -498,3 +548,421 @@ func Test_tlogEntry_rekord(t *testing.T) {
 		})
 	}
 }
+
+type fakePurger struct {
+	keyErr error
+	keys   []string
+	urls   []string
+	mu     sync.Mutex
+}
+
+func (f *fakePurger) Purge(ctx context.Context, url string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.urls = append(f.urls, url)
+	return nil
+}
+
+func (f *fakePurger) PurgeKey(ctx context.Context, key string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.keys = append(f.keys, key)
+	return f.keyErr
+}
+
+func Test_publishContext(t *testing.T) {
+	t.Parallel()
+	parent, cancelParent := context.WithCancel(context.Background())
+	ctx, cancel := publishContext(parent)
+	defer cancel()
+	cancelParent()
+	if err := ctx.Err(); err != nil {
+		t.Errorf("ctx.Err() = %v after the request was cancelled, want nil", err)
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok || time.Until(deadline) > publishTimeout {
+		t.Errorf("ctx.Deadline() = %v, %t, want within %v", deadline, ok, publishTimeout)
+	}
+}
+
+func Test_purgeRepo(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		sha      string
+		keyErr   error
+		wantKeys []string
+		wantURLs []string
+	}{
+		{
+			name:     "surrogate key purge",
+			sha:      "sha",
+			wantKeys: []string{"repo:github.com/org/repo", "org:github.com/org"},
+		},
+		{
+			name:     "falls back to url purge",
+			sha:      "sha",
+			keyErr:   cdn.ErrKeysUnsupported,
+			wantKeys: []string{"repo:github.com/org/repo", "org:github.com/org"},
+			wantURLs: []string{
+				"/projects/github.com/Org/Repo",
+				"/projects/github.com/Org",
+				"/projects/github.com/Org/Repo?commit=sha",
+			},
+		},
+		{
+			name:     "falls back to url purge without commit",
+			keyErr:   cdn.ErrKeysUnsupported,
+			wantKeys: []string{"repo:github.com/org/repo", "org:github.com/org"},
+			wantURLs: []string{
+				"/projects/github.com/Org/Repo",
+				"/projects/github.com/Org",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			purger := &fakePurger{keyErr: tt.keyErr}
+			queue := cdn.NewQueue(purger, nil, cdn.DefaultQueueOptions)
+			if err := queue.Start(context.Background()); err != nil {
+				t.Fatalf("Start: %v", err)
+			}
+			defer queue.Close()
+			purgeRepo(context.Background(), queue, "github.com", "Org", "Repo", tt.sha)
+			assert.Eventually(t, func() bool { return queue.Backlog() == 0 }, 5*time.Second, 10*time.Millisecond)
+			purger.mu.Lock()
+			defer purger.mu.Unlock()
+			assert.Equal(t, tt.wantKeys, purger.keys)
+			assert.Equal(t, tt.wantURLs, purger.urls)
+		})
+	}
+}
+
+func Test_newPurger(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		env  map[string]string
+		want cdn.Purger
+		name string
+	}{
+		{
+			name: "local development",
+			env:  map[string]string{"STORAGE_EMULATOR_HOST": "localhost:4443", "FASTLY_PURGE_TOKEN": "token"},
+			want: cdn.NewNoOpClient(),
+		},
+		{
+			name: "fastly by default",
+			env:  map[string]string{"API_BASE_URL": "https://api.example.com", "FASTLY_PURGE_TOKEN": "token"},
+			want: cdn.NewFastlyClient("token", "https://api.example.com", ""),
+		},
+		{
+			name: "missing configuration",
+			env:  map[string]string{"CDN_PURGERS": "fastly,cloudflare"},
+			want: cdn.NewNoOpClient(),
+		},
+		{
+			name: "varnish ban",
+			env:  map[string]string{"CDN_PURGERS": "Varnish", "VARNISH_URL": "http://varnish", "VARNISH_METHOD": "ban"},
+			want: cdn.NewVarnishClient("http://varnish", cdn.VarnishBan),
+		},
+		{
+			name: "cloudflare tag purging",
+			env: map[string]string{
+				"CDN_PURGERS":           "cloudflare",
+				"API_BASE_URL":          "https://api.example.com",
+				"CLOUDFLARE_API_TOKEN":  "cf-token",
+				"CLOUDFLARE_ZONE_ID":    "zone",
+				"CLOUDFLARE_PURGE_TAGS": "true",
+			},
+			want: cdn.NewCloudflareClient("cf-token", "zone", "https://api.example.com").WithTagPurging(),
+		},
+		{
+			name: "fan out",
+			env: map[string]string{
+				"CDN_PURGERS":          "cloudflare, varnish, unknown",
+				"API_BASE_URL":         "https://api.example.com",
+				"CLOUDFLARE_API_TOKEN": "cf-token",
+				"CLOUDFLARE_ZONE_ID":   "zone",
+				"VARNISH_URL":          "http://varnish",
+			},
+			want: cdn.NewMultiPurger(
+				cdn.NewCloudflareClient("cf-token", "zone", "https://api.example.com"),
+				cdn.NewVarnishClient("http://varnish", cdn.VarnishPurge),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := newPurger(func(key string) string { return tt.env[key] })
+			// Each purger gets its own upstream client, which can't be compared.
+			opts := []cmp.Option{cmp.Exporter(func(reflect.Type) bool { return true }), cmpopts.IgnoreTypes(&http.Client{})}
+			if diff := cmp.Diff(tt.want, got, opts...); diff != "" {
+				t.Errorf("newPurger() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// tokenRecorder stubs the GitHub API for getAndVerifyWorkflowContent, recording the tokens
+// each repository's requests were sent with.
+type tokenRecorder struct {
+	tokens   map[string]map[string]bool
+	workflow []byte
+	mu       sync.Mutex
+}
+
+func (r *tokenRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	// /repos/{org}/{repo} or /repos/{org}/{repo}/contents/{path}
+	parts := strings.SplitN(strings.TrimPrefix(req.URL.Path, "/repos/"), "/", 3)
+	r.mu.Lock()
+	if r.tokens[parts[0]] == nil {
+		r.tokens[parts[0]] = map[string]bool{}
+	}
+	r.tokens[parts[0]][req.Header.Get("Authorization")] = true
+	r.mu.Unlock()
+
+	body := `{"default_branch": "main"}`
+	if len(parts) == 3 {
+		content, err := json.Marshal(map[string]string{
+			"type": "file", "encoding": "base64", "content": base64.StdEncoding.EncodeToString(r.workflow),
+		})
+		if err != nil {
+			return nil, err
+		}
+		body = string(content)
+	}
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+// testLog is a Rekor log of publishes signed with certificates from a test Fulcio, all included
+// in the same signed tree head.
+type testLog struct {
+	entries map[int64]*rekor.Entry
+	uuids   map[int64]string
+}
+
+func (l *testLog) SearchByHash(context.Context, string) ([]string, error) {
+	return nil, rekor.ErrSearchUnavailable
+}
+
+func (l *testLog) EntryByIndex(_ context.Context, index int64) (string, *rekor.Entry, error) {
+	e, ok := l.entries[index]
+	if !ok {
+		return "", nil, errNoTlogEntry
+	}
+	return l.uuids[index], e, nil
+}
+
+func (l *testLog) EntryByUUID(context.Context, string) (*rekor.Entry, error) {
+	return nil, errNoTlogEntry
+}
+
+func (l *testLog) ConsistencyProof(context.Context, uint64, uint64, string) (*rekor.ConsistencyProof, error) {
+	return nil, rekor.ErrNoProof
+}
+
+// testPublish is a result signed for a repository, ready to be published.
+type testPublish struct {
+	result *models.VerifiedScorecardResult
+	org    string
+}
+
+// newTestPublishes signs a result for org/repo for each org, logs them in a new testLog, and
+// returns the upstreams trusting the log and the certificates' Fulcio.
+func newTestPublishes(t *testing.T, orgs ...string) (upstreams, []testPublish) {
+	t.Helper()
+	ca := newTestCA(t)
+	rekorKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("ecdsa.GenerateKey: %v", err)
+	}
+	rekorDER, err := x509.MarshalPKIXPublicKey(&rekorKey.PublicKey)
+	if err != nil {
+		t.Fatalf("x509.MarshalPKIXPublicKey: %v", err)
+	}
+	sign := func(b []byte) []byte {
+		digest := sha256.Sum256(b)
+		sig, err := ecdsa.SignASN1(rand.Reader, rekorKey, digest[:])
+		if err != nil {
+			t.Fatalf("ecdsa.SignASN1: %v", err)
+		}
+		return sig
+	}
+
+	tlog := &testLog{entries: map[int64]*rekor.Entry{}, uuids: map[int64]string{}}
+	tree := testonly.New(rfc6962.DefaultHasher)
+	// Log index 0 means no index was sent, so the publishes are logged from 1.
+	tree.AppendData([]byte("genesis"))
+	var publishes []testPublish
+	for i, org := range orgs {
+		sha := strings.Repeat(strconv.Itoa(i), 40)
+		now := time.Now().Truncate(time.Second)
+		uri, err := url.Parse("https://github.com/" + org + "/repo/.github/workflows/scorecard.yml@refs/heads/main")
+		if err != nil {
+			t.Fatalf("url.Parse: %v", err)
+		}
+		ext := func(oid, value string) pkix.Extension {
+			return pkix.Extension{Id: mustOID(t, oid), Value: []byte(value)}
+		}
+		leaf, leafKey := newTestCert(t, &x509.Certificate{
+			SerialNumber: big.NewInt(int64(i) + 2),
+			NotBefore:    now,
+			NotAfter:     now.Add(10 * time.Minute),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+			URIs:         []*url.URL{uri},
+			ExtraExtensions: []pkix.Extension{
+				ext(fulcioIssuerKey, githubOIDCIssuer),
+				ext(fulcioRepoSHAKey, sha),
+				ext(fulcioRepoPathKey, org+"/repo"),
+				ext(fulcioRepoRefKey, "refs/heads/main"),
+			},
+		}, ca.intermediate, ca.intermediateKey)
+
+		result, err := json.Marshal(models.ScorecardResult{
+			Date:      "2024-01-01",
+			Repo:      &models.Repo{Name: "github.com/" + org + "/repo", Commit: sha},
+			Scorecard: &models.ScorecardVersion{Version: "v5.0.0", Commit: sha},
+			Score:     5,
+			Checks:    []*models.ScorecardCheck{{Name: "Code-Review", Score: 5, Reason: "reviewed"}},
+		})
+		if err != nil {
+			t.Fatalf("json.Marshal: %v", err)
+		}
+		digest := sha256.Sum256(result)
+		sig, err := ecdsa.SignASN1(rand.Reader, leafKey, digest[:])
+		if err != nil {
+			t.Fatalf("ecdsa.SignASN1: %v", err)
+		}
+		var body hashedrekord.Body
+		body.APIVersion, body.Kind = "0.0.1", hashedrekord.Kind
+		body.Spec.Data.Hash = hashedrekord.Hash{Algorithm: "sha256", Value: hex.EncodeToString(digest[:])}
+		body.Spec.Signature.Content = base64.StdEncoding.EncodeToString(sig)
+		body.Spec.Signature.PublicKey.Content = base64.StdEncoding.EncodeToString(
+			pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf.Raw}))
+		bodyJSON, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("json.Marshal: %v", err)
+		}
+		tree.AppendData(bodyJSON)
+		logIndex := int64(i) + 1
+		tlog.entries[logIndex] = &rekor.Entry{
+			Body: base64.StdEncoding.EncodeToString(bodyJSON), IntegratedTime: now.Unix(),
+			LogID: "test", LogIndex: logIndex,
+		}
+		publishes = append(publishes, testPublish{org: org, result: &models.VerifiedScorecardResult{
+			Result: string(result), Branch: "main", TlogIndex: logIndex, AccessToken: "token-" + org,
+		}})
+	}
+
+	// One checkpoint for the whole tree, which every entry's inclusion proof is against.
+	keyHash := sha256.Sum256(rekorDER)
+	text := fmt.Sprintf("test log\n%d\n%s\n", tree.Size(), base64.StdEncoding.EncodeToString(tree.Hash()))
+	signature := base64.StdEncoding.EncodeToString(append(keyHash[:4], sign([]byte(text))...))
+	checkpoint := text + "\n\u2014 test " + signature + "\n"
+	for index, e := range tlog.entries {
+		hashes, err := tree.InclusionProof(uint64(index), tree.Size())
+		if err != nil {
+			t.Fatalf("InclusionProof: %v", err)
+		}
+		proof := &rekor.InclusionProof{
+			RootHash: hex.EncodeToString(tree.Hash()), Checkpoint: checkpoint,
+			TreeSize: tree.Size(), LogIndex: uint64(index),
+		}
+		for _, h := range hashes {
+			proof.Hashes = append(proof.Hashes, hex.EncodeToString(h))
+		}
+		set, err := json.Marshal(struct {
+			Body           string `json:"body"`
+			IntegratedTime int64  `json:"integratedTime"`
+			LogID          string `json:"logID"`
+			LogIndex       int64  `json:"logIndex"`
+		}{e.Body, e.IntegratedTime, e.LogID, e.LogIndex})
+		if err != nil {
+			t.Fatalf("json.Marshal: %v", err)
+		}
+		e.Verification = &rekor.Verification{InclusionProof: proof, SignedEntryTimestamp: sign(set)}
+		tlog.uuids[index] = hex.EncodeToString(tree.LeafHash(uint64(index)))
+	}
+
+	results := t.TempDir()
+	u := upstreams{
+		rekor:   tlog,
+		witness: witness.New(tlog, nil),
+		searchIndex: func(context.Context) (index.Index, error) {
+			return index.NewBucketIndex(memblob.OpenBucket(nil)), nil
+		},
+		resultsBucket: "file://" + filepath.ToSlash(results),
+		rekorKey:      pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: rekorDER}),
+		fulcio:        fulcioTrust{roots: certPool(ca.root), intermediates: certPool(ca.intermediate)},
+	}
+	return u, publishes
+}
+
+func mustOID(t *testing.T, s string) asn1.ObjectIdentifier {
+	t.Helper()
+	var oid asn1.ObjectIdentifier
+	for _, part := range strings.Split(s, ".") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			t.Fatalf("strconv.Atoi: %v", err)
+		}
+		oid = append(oid, n)
+	}
+	return oid
+}
+
+// Test_processRequest_tokenIsolation publishes for several repositories with different tokens
+// concurrently, which must never send one's token with another's requests.
+func Test_processRequest_tokenIsolation(t *testing.T) {
+	t.Parallel()
+	workflow, err := os.ReadFile("testdata/workflow-valid-tagged-action.yml")
+	if err != nil {
+		t.Fatalf("os.ReadFile: %v", err)
+	}
+	u, publishes := newTestPublishes(t, "org-a", "org-b", "org-public")
+	// A repository published without a token, e.g. a public one.
+	publishes[2].result.AccessToken = ""
+	recorder := &tokenRecorder{tokens: map[string]map[string]bool{}, workflow: workflow}
+	u.github = recorder
+	u.purges = cdn.NewQueue(&fakePurger{}, nil, cdn.DefaultQueueOptions)
+	if err := u.purges.Start(context.Background()); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	defer u.purges.Close()
+
+	var wg sync.WaitGroup
+	for _, p := range publishes {
+		for range 20 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				result := *p.result
+				if err := processRequest(context.Background(), u, "github.com", p.org, "repo", &result); err != nil {
+					t.Errorf("processRequest(%s): %v", p.org, err)
+				}
+			}()
+		}
+	}
+	wg.Wait()
+
+	want := map[string]map[string]bool{
+		"org-a":      {"Bearer token-org-a": true},
+		"org-b":      {"Bearer token-org-b": true},
+		"org-public": {"": true},
+	}
+	if diff := cmp.Diff(want, recorder.tokens); diff != "" {
+		t.Errorf("tokens sent per repository mismatch (-want +got):\n%s", diff)
+	}
+	if http.DefaultClient.Transport != nil {
+		t.Errorf("http.DefaultClient.Transport = %T, want it untouched", http.DefaultClient.Transport)
+	}
+}
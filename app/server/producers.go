@@ -0,0 +1,155 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"go.yaml.in/yaml/v3"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+// errUnsupportedPayload is returned by the CSV and Markdown producers for anything but
+// getResult's payloads: it's the only operation producing YAML, CSV and Markdown, the others
+// only produce JSON.
+var errUnsupportedPayload = errors.New("payload cannot be rendered in the requested format")
+
+// YAMLProducer renders payloads as YAML using the same field names and order as the JSON output.
+func YAMLProducer() runtime.Producer {
+	return runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+		b, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("json.Marshal: %w", err)
+		}
+		// JSON is valid YAML, so decoding into a yaml.Node keeps the key order intact.
+		var node yaml.Node
+		if err := yaml.Unmarshal(b, &node); err != nil {
+			return fmt.Errorf("yaml.Unmarshal: %w", err)
+		}
+		clearStyle(&node)
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(&node); err != nil {
+			return fmt.Errorf("yaml.Encode: %w", err)
+		}
+		return enc.Close()
+	})
+}
+
+// clearStyle drops the flow style inherited from JSON so the output uses block style.
+func clearStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, n := range node.Content {
+		clearStyle(n)
+	}
+}
+
+// CSVProducer renders a ScorecardResult as one check,score,reason row per check.
+func CSVProducer() runtime.Producer {
+	return runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+		cw := csv.NewWriter(w)
+		switch v := data.(type) {
+		case *models.ScorecardResult:
+			if err := cw.Write([]string{"check", "score", "reason"}); err != nil {
+				return fmt.Errorf("csv.Write: %w", err)
+			}
+			for _, check := range v.Checks {
+				if check == nil {
+					continue
+				}
+				row := []string{check.Name, strconv.FormatInt(check.Score, 10), check.Reason}
+				if err := cw.Write(row); err != nil {
+					return fmt.Errorf("csv.Write: %w", err)
+				}
+			}
+		case *models.Error:
+			if err := cw.Write([]string{"code", "message"}); err != nil {
+				return fmt.Errorf("csv.Write: %w", err)
+			}
+			if err := cw.Write([]string{strconv.FormatInt(v.Code, 10), v.Message}); err != nil {
+				return fmt.Errorf("csv.Write: %w", err)
+			}
+		default:
+			return fmt.Errorf("%w: %T", errUnsupportedPayload, data)
+		}
+		cw.Flush()
+		return cw.Error()
+	})
+}
+
+// MarkdownProducer renders a ScorecardResult as a summary table.
+func MarkdownProducer() runtime.Producer {
+	return runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+		var sb strings.Builder
+		switch v := data.(type) {
+		case *models.ScorecardResult:
+			writeMarkdownResult(&sb, v)
+		case *models.Error:
+			fmt.Fprintf(&sb, "**Error %d**: %s\n", v.Code, escapeMarkdown(v.Message))
+		default:
+			return fmt.Errorf("%w: %T", errUnsupportedPayload, data)
+		}
+		_, err := io.WriteString(w, sb.String())
+		return err
+	})
+}
+
+func writeMarkdownResult(sb *strings.Builder, result *models.ScorecardResult) {
+	repoName, commit, version := "unknown", "unknown", "unknown"
+	if result.Repo != nil {
+		repoName, commit = result.Repo.Name, result.Repo.Commit
+	}
+	if result.Scorecard != nil {
+		version = result.Scorecard.Version
+	}
+	fmt.Fprintf(sb, "# Scorecard result for %s\n\n", escapeMarkdown(repoName))
+	fmt.Fprintf(sb, "- Aggregate score: %.1f / 10\n", result.Score)
+	fmt.Fprintf(sb, "- Date: %s\n", escapeMarkdown(result.Date))
+	fmt.Fprintf(sb, "- Commit: %s\n", escapeMarkdown(commit))
+	fmt.Fprintf(sb, "- Scorecard version: %s\n\n", escapeMarkdown(version))
+	sb.WriteString("| Check | Score | Reason |\n")
+	sb.WriteString("|---|---|---|\n")
+	for _, check := range result.Checks {
+		if check == nil {
+			continue
+		}
+		score := strconv.FormatInt(check.Score, 10)
+		if check.Score == inconclusiveScore {
+			score = "?"
+		}
+		fmt.Fprintf(sb, "| %s | %s | %s |\n", escapeMarkdown(check.Name), score, escapeMarkdown(check.Reason))
+	}
+}
+
+// markdownEscaper backslash escapes the characters which start Markdown emphasis, code, links
+// and HTML, or end a table cell, and joins lines so they don't start a block.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "~", `\~`, "[", `\[`, "]", `\]`,
+	"<", `\<`, ">", `\>`, "&", `\&`, "|", `\|`, "\r", "", "\n", " ",
+)
+
+// escapeMarkdown keeps stored strings from being rendered as Markdown or breaking out of the
+// table layout.
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
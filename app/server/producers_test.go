@@ -0,0 +1,159 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+func testResult() *models.ScorecardResult {
+	return &models.ScorecardResult{
+		Date: "2022-04-11",
+		Repo: &models.Repo{
+			Name:   "github.com/org/repo",
+			Commit: "f4dfcefa9063c99d52e3b4d68375a0a7d8cdf5da",
+		},
+		Scorecard: &models.ScorecardVersion{Version: "v5.0.0"},
+		Score:     5.1,
+		Checks: []*models.ScorecardCheck{
+			{Name: "Binary-Artifacts", Score: 10, Reason: "no binaries found in the repo"},
+			{Name: "CI-Tests", Score: -1, Reason: "no pull request found"},
+			{Name: "Code-Review", Score: 0, Reason: "found 0/2 approved changesets, with | and \"quotes\""},
+		},
+	}
+}
+
+func TestProducers(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		producer runtime.Producer
+		data     interface{}
+		want     string
+		wantErr  error
+	}{
+		{
+			name:     "csv result",
+			producer: CSVProducer(),
+			data:     testResult(),
+			want: `check,score,reason
+Binary-Artifacts,10,no binaries found in the repo
+CI-Tests,-1,no pull request found
+Code-Review,0,"found 0/2 approved changesets, with | and ""quotes"""
+`,
+		},
+		{
+			name:     "csv error",
+			producer: CSVProducer(),
+			data:     &models.Error{Code: 500, Message: "oops"},
+			want:     "code,message\n500,oops\n",
+		},
+		{
+			name:     "csv unsupported",
+			producer: CSVProducer(),
+			data:     "some string",
+			wantErr:  errUnsupportedPayload,
+		},
+		{
+			name:     "markdown result",
+			producer: MarkdownProducer(),
+			data:     testResult(),
+			want: "# Scorecard result for github.com/org/repo\n\n" +
+				"- Aggregate score: 5.1 / 10\n" +
+				"- Date: 2022-04-11\n" +
+				"- Commit: f4dfcefa9063c99d52e3b4d68375a0a7d8cdf5da\n" +
+				"- Scorecard version: v5.0.0\n\n" +
+				"| Check | Score | Reason |\n" +
+				"|---|---|---|\n" +
+				"| Binary-Artifacts | 10 | no binaries found in the repo |\n" +
+				"| CI-Tests | ? | no pull request found |\n" +
+				"| Code-Review | 0 | found 0/2 approved changesets, with \\| and \"quotes\" |\n",
+		},
+		{
+			name:     "markdown escapes stored fields",
+			producer: MarkdownProducer(),
+			data: &models.ScorecardResult{
+				Repo: &models.Repo{Name: "github.com/org/repo", Commit: "abc` <img src=x>"},
+				Checks: []*models.ScorecardCheck{
+					{Name: "**Bold**", Score: 1, Reason: "[link](https://example.com)\n# heading & _more_"},
+				},
+			},
+			want: "# Scorecard result for github.com/org/repo\n\n" +
+				"- Aggregate score: 0.0 / 10\n" +
+				"- Date: \n" +
+				"- Commit: abc\\` \\<img src=x\\>\n" +
+				"- Scorecard version: unknown\n\n" +
+				"| Check | Score | Reason |\n" +
+				"|---|---|---|\n" +
+				"| \\*\\*Bold\\*\\* | 1 | \\[link\\](https://example.com) # heading \\& \\_more\\_ |\n",
+		},
+		{
+			name:     "markdown unsupported",
+			producer: MarkdownProducer(),
+			data:     42,
+			wantErr:  errUnsupportedPayload,
+		},
+		{
+			name:     "yaml keeps json field names and order",
+			producer: YAMLProducer(),
+			data: &models.ScorecardResult{
+				Date:  "2022-04-11",
+				Repo:  &models.Repo{Name: "github.com/org/repo"},
+				Score: 10,
+				Checks: []*models.ScorecardCheck{
+					{
+						Name:          "License",
+						Score:         10,
+						Documentation: &models.ScorecardCheckDocumentation{URL: "https://example.com"},
+					},
+				},
+			},
+			want: `date: "2022-04-11"
+repo:
+  name: github.com/org/repo
+score: 10
+checks:
+  - name: License
+    score: 10
+    details: null
+    documentation:
+      url: https://example.com
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var buf bytes.Buffer
+			err := tt.producer.Produce(&buf, tt.data)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Produce() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, buf.String()); diff != "" {
+				t.Errorf("Produce() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
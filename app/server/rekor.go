@@ -0,0 +1,120 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+
+	"gocloud.dev/blob"
+
+	"github.com/ossf/scorecard-webapp/app/server/internal/rekor"
+	"github.com/ossf/scorecard-webapp/app/server/internal/witness"
+)
+
+var (
+	rekorOnce      sync.Once
+	rekorClient    rekor.Client
+	rekorPublicKey []byte
+	errRekor       error
+)
+
+// OpenRekor configures the process wide Rekor client, see newRekor. It's called at startup, so
+// results are never verified against the public good instance when another one is configured.
+func OpenRekor() error {
+	rekorOnce.Do(func() {
+		rekorClient, rekorPublicKey, errRekor = newRekor(os.Getenv)
+	})
+	return errRekor
+}
+
+// getRekor returns the client configured by OpenRekor.
+func getRekor() rekor.Client {
+	if err := OpenRekor(); err != nil {
+		// The server doesn't start when this fails, so it can't be serving.
+		log.Fatal(err)
+	}
+	return rekorClient
+}
+
+var (
+	witnessOnce  sync.Once
+	rekorWitness *witness.Witness
+)
+
+// getWitness returns the process wide witness of the Rekor instance's checkpoints.
+// REKOR_WITNESS_BUCKET_URL is required to persist the latest checkpoint: without it, the
+// latest checkpoint is only kept in memory, so it's lost on restart and each instance only
+// checks the checkpoints it sees itself are consistent.
+func getWitness() *witness.Witness {
+	witnessOnce.Do(func() {
+		var bucket *blob.Bucket
+		if bucketURL := os.Getenv("REKOR_WITNESS_BUCKET_URL"); bucketURL != "" {
+			var err error
+			bucket, err = blob.OpenBucket(context.Background(), bucketURL)
+			if err != nil {
+				log.Println("error opening Rekor witness bucket, tree heads won't be persisted: " + err.Error())
+				bucket = nil
+			}
+		}
+		rekorWitness = witness.New(getRekor(), bucket)
+	})
+	return rekorWitness
+}
+
+// getRekorPublicKey returns the PEM encoded key the Rekor instance signs entry timestamps with.
+func getRekorPublicKey() []byte {
+	getRekor()
+	return rekorPublicKey
+}
+
+// newRekor configures the Rekor client from the environment:
+//   - REKOR_URL is the base URL of the Rekor instance, https://rekor.sigstore.dev by default, or
+//     a file:// URL of a directory of saved entries (see rekor.NewFileClient).
+//   - REKOR_PUBLIC_KEY is the path to the instance's PEM encoded public key, needed for any
+//     instance but the public good one.
+//   - REKOR_TIMEOUT (e.g. 5s), REKOR_RETRIES, REKOR_RETRY_DELAY and the other upstreamOptions
+//     settings configure the calls to the instance.
+//
+// The instance and its key are an error if they're set but can't be loaded, rather than
+// falling back to the public good instance. Invalid upstreamOptions settings are logged and
+// ignored.
+func newRekor(getenv func(string) string) (rekor.Client, []byte, error) {
+	publicKey := rekorPub
+	if path := getenv("REKOR_PUBLIC_KEY"); path != "" {
+		var err error
+		if publicKey, err = os.ReadFile(path); err != nil {
+			return nil, nil, fmt.Errorf("reading REKOR_PUBLIC_KEY: %w", err)
+		}
+	}
+
+	rekorURL := getenv("REKOR_URL")
+	if dir, ok := strings.CutPrefix(rekorURL, "file://"); ok {
+		client, err := rekor.NewFileClient(dir)
+		if err != nil {
+			return nil, nil, fmt.Errorf("loading REKOR_URL entries: %w", err)
+		}
+		return client, publicKey, nil
+	}
+	if rekorURL == "" {
+		rekorURL = rekor.DefaultURL
+	}
+
+	return rekor.NewHTTPClient(rekorURL, newUpstreamClient(getenv, "rekor")), publicKey, nil
+}
@@ -0,0 +1,200 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ossf/scorecard-webapp/app/server/internal/hashedrekord"
+	"github.com/ossf/scorecard-webapp/app/server/internal/rekor"
+	"github.com/ossf/scorecard-webapp/app/server/internal/witness"
+)
+
+func Test_newRekor(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	keyPath := filepath.Join(dir, "rekor.pub")
+	if err := os.WriteFile(keyPath, []byte("private instance key"), 0o600); err != nil {
+		t.Fatalf("os.WriteFile: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "invalid.json"), []byte("{"), 0o600); err != nil {
+		t.Fatalf("os.WriteFile: %v", err)
+	}
+	tests := []struct {
+		env     map[string]string
+		name    string
+		wantKey []byte
+		wantFS  bool
+		wantErr bool
+	}{
+		{name: "default", wantKey: rekorPub},
+		{
+			name:    "private instance",
+			env:     map[string]string{"REKOR_URL": "https://rekor.example", "REKOR_PUBLIC_KEY": keyPath},
+			wantKey: []byte("private instance key"),
+		},
+		{
+			name:    "invalid upstream settings",
+			env:     map[string]string{"REKOR_TIMEOUT": "soon", "REKOR_RETRIES": "-1"},
+			wantKey: rekorPub,
+		},
+		{
+			name:    "invalid public key path",
+			env:     map[string]string{"REKOR_URL": "https://rekor.example", "REKOR_PUBLIC_KEY": "missing.pub"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid saved entries",
+			env:     map[string]string{"REKOR_URL": "file://" + dir},
+			wantErr: true,
+		},
+		{
+			name:    "saved entries",
+			env:     map[string]string{"REKOR_URL": "file://testdata/rekor"},
+			wantKey: rekorPub,
+			wantFS:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			client, key, err := newRekor(func(name string) string { return tt.env[name] })
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newRekor() error = %v, want error %t", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if _, ok := client.(*rekor.FileClient); ok != tt.wantFS {
+				t.Errorf("newRekor() = %T", client)
+			}
+			if !bytes.Equal(key, tt.wantKey) {
+				t.Errorf("newRekor() key = %q, want %q", key, tt.wantKey)
+			}
+		})
+	}
+}
+
+func Test_extractAndVerifyCertForPayload(t *testing.T) {
+	t.Parallel()
+	tlog, err := rekor.NewFileClient("testdata/rekor")
+	if err != nil {
+		t.Fatalf("rekor.NewFileClient: %v", err)
+	}
+	payload, err := os.ReadFile("testdata/results/valid-payload.json")
+	if err != nil {
+		t.Fatalf("os.ReadFile: %v", err)
+	}
+	ctx := context.Background()
+	u := upstreams{rekor: tlog, witness: witness.New(tlog, nil), rekorKey: rekorPub, fulcio: getFulcio()}
+
+	// The saved entry is for another payload.
+	_, err = extractAndVerifyCertForPayload(ctx, u, payload, 23652179)
+	if !errors.Is(err, errMismatchedTlogEntry) {
+		t.Errorf("extractAndVerifyCertForPayload() = %v, want errMismatchedTlogEntry", err)
+	}
+	if _, err := extractAndVerifyCertForPayload(ctx, u, payload, 1); !errors.Is(err, errNoTlogEntry) {
+		t.Errorf("extractAndVerifyCertForPayload() = %v, want errNoTlogEntry", err)
+	}
+	if _, err := extractAndVerifyCertForPayload(ctx, u, payload, noTlogIndex); err == nil {
+		t.Error("extractAndVerifyCertForPayload() without entries for the payload succeeded")
+	}
+
+	// The saved entry's certificate chains to the public good Fulcio, and signed the blob.
+	blob, err := os.ReadFile("internal/hashedrekord/testdata/uploaded-blob.md")
+	if err != nil {
+		t.Fatalf("os.ReadFile: %v", err)
+	}
+	cert, err := extractAndVerifyCertForPayload(ctx, u, blob, 23652179)
+	if err != nil || cert == nil {
+		t.Errorf("extractAndVerifyCertForPayload() = %v, %v", cert, err)
+	}
+
+	// A witness which saw another root for the entry's tree size.
+	splitView := witness.New(tlog, nil)
+	if err := splitView.Observe(ctx, &rekor.Checkpoint{
+		Origin: "rekor.sigstore.dev - 2605736670972794746", Size: 19488771, RootHash: make([]byte, 32),
+	}); err != nil {
+		t.Fatalf("Observe: %v", err)
+	}
+	u.witness = splitView
+	_, err = extractAndVerifyCertForPayload(ctx, u, blob, 23652179)
+	if !errors.Is(err, witness.ErrInconsistent) {
+		t.Errorf("extractAndVerifyCertForPayload() = %v, want witness.ErrInconsistent", err)
+	}
+}
+
+func Test_verifyInclusionProof(t *testing.T) {
+	t.Parallel()
+	tlog, err := rekor.NewFileClient("testdata/rekor")
+	if err != nil {
+		t.Fatalf("rekor.NewFileClient: %v", err)
+	}
+	uuid, saved, err := tlog.EntryByIndex(context.Background(), 23652179)
+	if err != nil {
+		t.Fatalf("EntryByIndex: %v", err)
+	}
+	withCheckpoint := func(checkpoint string) *tlogEntry {
+		entry := *saved
+		verification := *entry.Verification
+		proof := *verification.InclusionProof
+		proof.Checkpoint = checkpoint
+		verification.InclusionProof = &proof
+		entry.Verification = &verification
+		return (*tlogEntry)(&entry)
+	}
+	checkpoint := saved.Verification.InclusionProof.Checkpoint
+	text, _, _ := strings.Cut(checkpoint, "\n\n")
+
+	tests := []struct {
+		wantErr error
+		entry   *tlogEntry
+		name    string
+		invalid bool
+	}{
+		{name: "valid", entry: (*tlogEntry)(saved)},
+		{name: "no checkpoint", entry: withCheckpoint(""), wantErr: errNoCheckpoint},
+		{
+			name:    "tampered checkpoint",
+			entry:   withCheckpoint(strings.Replace(checkpoint, "Timestamp: 1", "Timestamp: 2", 1)),
+			wantErr: hashedrekord.ErrInvalidSignature,
+		},
+		{name: "unsigned checkpoint", entry: withCheckpoint(text + "\n"), invalid: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := verifyInclusionProof(rekorPub, uuid, tt.entry)
+			if tt.invalid {
+				if err == nil {
+					t.Error("verifyInclusionProof() succeeded")
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("verifyInclusionProof() = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && (got.Size != 19488771 || got.TreeID() != "2605736670972794746") {
+				t.Errorf("verifyInclusionProof() = %+v", got)
+			}
+		})
+	}
+}
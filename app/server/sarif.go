@@ -0,0 +1,200 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+const (
+	sarifSchema      = "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json"
+	sarifVersion     = "2.1.0"
+	sarifContentType = "application/sarif+json"
+	sarifToolName    = "Scorecard"
+	sarifToolURI     = "https://github.com/ossf/scorecard"
+	// Matches scorecard's own SARIF output when no policy is given: any check
+	// scoring below the maximum is reported.
+	sarifMinScore = 10
+	// Checks which could not be evaluated are reported with a score of -1.
+	inconclusiveScore = -1
+	// scorecard results aren't tied to a file, so mirror the placeholder
+	// location scorecard itself uses for repository level findings.
+	sarifNoFileURI = "no file associated with this alert"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	AutomationDetails sarifAutomationDetails `json:"automationDetails"`
+	Tool              sarifTool              `json:"tool"`
+	Results           []sarifResult          `json:"results"`
+}
+
+type sarifAutomationDetails struct {
+	ID string `json:"id"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name            string      `json:"name"`
+	InformationURI  string      `json:"informationUri"`
+	SemanticVersion string      `json:"semanticVersion"`
+	Rules           []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string                 `json:"id"`
+	Name                 string                 `json:"name"`
+	HelpURI              string                 `json:"helpUri,omitempty"`
+	ShortDescription     sarifText              `json:"shortDescription"`
+	FullDescription      sarifText              `json:"fullDescription"`
+	DefaultConfiguration sarifRuleConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifRuleConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifText struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifText       `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+// toSARIF converts a stored ScorecardResult into a SARIF 2.1.0 log.
+// Every check becomes a rule, and checks scoring below sarifMinScore become results.
+// Inconclusive checks (score -1) are described as rules but never reported.
+func toSARIF(result *models.ScorecardResult) *sarifLog {
+	version := "unknown"
+	if result.Scorecard != nil && result.Scorecard.Version != "" {
+		version = result.Scorecard.Version
+	}
+	repoName := "unknown"
+	if result.Repo != nil && result.Repo.Name != "" {
+		repoName = result.Repo.Name
+	}
+
+	run := sarifRun{
+		AutomationDetails: sarifAutomationDetails{
+			ID: fmt.Sprintf("supply-chain/%s/%s-%s", repoName, version, result.Date),
+		},
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:            sarifToolName,
+				InformationURI:  sarifToolURI,
+				SemanticVersion: version,
+				Rules:           []sarifRule{},
+			},
+		},
+		Results: []sarifResult{},
+	}
+
+	for _, check := range result.Checks {
+		if check == nil {
+			continue
+		}
+		rule := sarifRule{
+			ID:                   sarifRuleID(check.Name),
+			Name:                 check.Name,
+			ShortDescription:     sarifText{Text: check.Name},
+			FullDescription:      sarifText{Text: check.Name},
+			DefaultConfiguration: sarifRuleConfiguration{Level: "error"},
+		}
+		if check.Documentation != nil {
+			rule.HelpURI = check.Documentation.URL
+			if check.Documentation.Short != "" {
+				rule.FullDescription.Text = check.Documentation.Short
+			}
+		}
+		ruleIndex := len(run.Tool.Driver.Rules)
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, rule)
+
+		if check.Score == inconclusiveScore || check.Score >= sarifMinScore {
+			continue
+		}
+		run.Results = append(run.Results, sarifResult{
+			RuleID:    rule.ID,
+			RuleIndex: ruleIndex,
+			Level:     "error",
+			Message:   sarifText{Text: sarifMessage(check)},
+			Locations: []sarifLocation{
+				{
+					PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{
+							URI:       sarifNoFileURI,
+							URIBaseID: "%SRCROOT%",
+						},
+						Region: sarifRegion{StartLine: 1},
+					},
+				},
+			},
+		})
+	}
+
+	return &sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRun{run},
+	}
+}
+
+// sarifRuleID mirrors the rule IDs used by scorecard, e.g. Branch-Protection -> BranchProtectionID.
+func sarifRuleID(checkName string) string {
+	return strings.ReplaceAll(checkName, "-", "") + "ID"
+}
+
+func sarifMessage(check *models.ScorecardCheck) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "score is %d: %s", check.Score, check.Reason)
+	for _, d := range check.Details {
+		sb.WriteString("\n")
+		sb.WriteString(d)
+	}
+	return sb.String()
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+func Test_toSARIF(t *testing.T) {
+	t.Parallel()
+	b, err := os.ReadFile("testdata/results/results.json")
+	if err != nil {
+		t.Fatalf("os.ReadFile: %v", err)
+	}
+	var result models.ScorecardResult
+	if err := result.UnmarshalBinary(b); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+
+	got := toSARIF(&result)
+	assert.Equal(t, sarifVersion, got.Version)
+	assert.Len(t, got.Runs, 1)
+
+	run := got.Runs[0]
+	assert.Equal(t, "unknown", run.Tool.Driver.SemanticVersion)
+	assert.Len(t, run.Tool.Driver.Rules, len(result.Checks))
+	assert.Equal(t, "BranchProtectionID", run.Tool.Driver.Rules[1].ID)
+	assert.Equal(t,
+		"https://github.com/ossf/scorecard/blob/main/docs/checks.md#branch-protection",
+		run.Tool.Driver.Rules[1].HelpURI)
+
+	// Checks scoring 10 or -1 (inconclusive) don't produce results.
+	var ruleIDs []string
+	for _, r := range run.Results {
+		ruleIDs = append(ruleIDs, r.RuleID)
+		assert.Equal(t, run.Tool.Driver.Rules[r.RuleIndex].ID, r.RuleID)
+	}
+	assert.Equal(t, []string{
+		"BranchProtectionID",
+		"CIIBestPracticesID",
+		"CodeReviewID",
+		"ContributorsID",
+		"DependencyUpdateToolID",
+		"FuzzingID",
+		"LicenseID",
+		"SASTID",
+		"SecurityPolicyID",
+	}, ruleIDs)
+	assert.Equal(t, "score is 0: branch protection not enabled on development/release branches",
+		run.Results[0].Message.Text)
+}
+
+func Test_toSARIF_empty(t *testing.T) {
+	t.Parallel()
+	got := toSARIF(&models.ScorecardResult{})
+	assert.Len(t, got.Runs, 1)
+	assert.Empty(t, got.Runs[0].Tool.Driver.Rules)
+	assert.Empty(t, got.Runs[0].Results)
+}
@@ -0,0 +1,244 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"go.yaml.in/yaml/v3"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+	"github.com/ossf/scorecard-webapp/app/generated/restapi/operations/results"
+)
+
+const (
+	defaultWeightProfile = "default"
+
+	// Weight profiles can change with a deploy, which doesn't purge the CDN, so recomputed
+	// scores are cached for less time than results.
+	scoreTTL = "max-age=86400" // 1 day
+)
+
+// Scorecard's risk level weights, see https://github.com/ossf/scorecard/blob/main/docs/checks/internal/checks.yaml
+const (
+	criticalRisk = 10
+	highRisk     = 7.5
+	mediumRisk   = 5
+	lowRisk      = 2.5
+)
+
+// defaultWeights weighs each check by its Scorecard risk level, like the aggregate score
+// computed by Scorecard itself.
+var defaultWeights = weightProfile{
+	"Binary-Artifacts":       highRisk,
+	"Branch-Protection":      highRisk,
+	"CI-Tests":               lowRisk,
+	"CII-Best-Practices":     lowRisk,
+	"Code-Review":            highRisk,
+	"Contributors":           lowRisk,
+	"Dangerous-Workflow":     criticalRisk,
+	"Dependency-Update-Tool": highRisk,
+	"Fuzzing":                mediumRisk,
+	"License":                lowRisk,
+	"Maintained":             highRisk,
+	"Packaging":              mediumRisk,
+	"Pinned-Dependencies":    mediumRisk,
+	"SAST":                   mediumRisk,
+	"SBOM":                   mediumRisk,
+	"Security-Policy":        mediumRisk,
+	"Signed-Releases":        highRisk,
+	"Token-Permissions":      highRisk,
+	"Vulnerabilities":        highRisk,
+	"Webhooks":               criticalRisk,
+}
+
+var (
+	errUnknownProfile = errors.New("unknown weight profile")
+	errInvalidWeight  = errors.New("weights must be finite and not negative")
+
+	weightProfilesOnce sync.Once
+	weightProfiles     map[string]weightProfile
+	errWeightProfiles  error
+)
+
+// weightProfile maps check names to their weight. Checks without a weight don't count.
+type weightProfile map[string]float64
+
+func GetScoreHandler(params results.GetScoreParams) middleware.Responder {
+	ctx := requestContext(params.HTTPRequest)
+	surrogateKey := repoSurrogateKey(params.Platform, params.Org, params.Repo)
+	profileName := defaultWeightProfile
+	if params.Weights != nil && *params.Weights != "" {
+		profileName = *params.Weights
+	}
+	profile, ok := getWeightProfiles()[profileName]
+	if !ok {
+		return results.NewGetScoreBadRequest().
+			WithSurrogateControl(scoreTTL).
+			WithCacheControl(browserCacheTTL).
+			WithPayload(&models.Error{
+				Code:    http.StatusBadRequest,
+				Message: fmt.Sprintf("%v: %q", errUnknownProfile, profileName),
+			})
+	}
+
+	res, err := lookupResult(ctx, params.Platform, params.Org, params.Repo, params.Commit, "")
+	if errors.Is(err, errInvalidInputs) {
+		return results.NewGetScoreBadRequest().
+			WithSurrogateControl(scoreTTL).
+			WithCacheControl(browserCacheTTL)
+	}
+	var served *storedResult
+	if err == nil {
+		defer res.Close()
+		served = res
+	}
+	override, overrideErr := resolveOverride(ctx, getOverrides(), served, params.Platform, params.Org, params.Repo,
+		swag.StringValue(params.Commit))
+	if overrideErr != nil {
+		err = overrideErr
+	} else if override.Tombstoned {
+		// Restoring the result purges this response.
+		return results.NewGetScoreGone().
+			WithSurrogateKey(surrogateKey).
+			WithSurrogateControl(scoreTTL).
+			WithCacheControl(browserCacheTTL).
+			WithPayload(&models.Error{
+				Code:    http.StatusGone,
+				Message: override.TombstoneReason,
+			})
+	}
+	if errors.Is(err, errNotFound) {
+		return results.NewGetScoreNotFound().
+			WithSurrogateKey(surrogateKey).
+			WithSurrogateControl(scoreTTL).
+			WithCacheControl(browserCacheTTL)
+	}
+	if err == nil {
+		var b []byte
+		if b, err = res.read(ctx); err == nil {
+			var result models.ScorecardResult
+			if err = result.UnmarshalBinary(b); err == nil {
+				ret := weighScore(&result, profileName, profile)
+				ret.Name = fmt.Sprintf("%s/%s/%s", params.Platform, params.Org, params.Repo)
+				return results.NewGetScoreOK().WithPayload(ret).
+					WithSurrogateKey(surrogateKey).
+					WithSurrogateControl(scoreTTL).
+					WithCacheControl(browserCacheTTL)
+			}
+		}
+	}
+
+	return results.NewGetScoreDefault(http.StatusInternalServerError).WithPayload(&models.Error{
+		Code:    http.StatusInternalServerError,
+		Message: err.Error(),
+	})
+}
+
+// weighScore computes the weighted mean of the conclusive check scores, rounded to one decimal
+// like Scorecard's own aggregate score. It's -1 if no check counts.
+func weighScore(result *models.ScorecardResult, profileName string, profile weightProfile) *models.WeightedScore {
+	ret := &models.WeightedScore{
+		Date:           result.Date,
+		Profile:        profileName,
+		PublishedScore: result.Score,
+		Score:          inconclusiveScore,
+	}
+	if result.Repo != nil {
+		ret.Commit = result.Repo.Commit
+	}
+	var total, totalWeight float64
+	for _, check := range result.Checks {
+		if check == nil {
+			continue
+		}
+		weight := profile[check.Name]
+		ret.Checks = append(ret.Checks, &models.WeightedCheck{Name: check.Name, Score: check.Score, Weight: weight})
+		if check.Score == inconclusiveScore || weight == 0 {
+			continue
+		}
+		total += weight * float64(check.Score)
+		totalWeight += weight
+	}
+	if totalWeight > 0 {
+		ret.Score = math.Round(total/totalWeight*10) / 10
+	}
+	return ret
+}
+
+// LoadWeightProfiles loads the process wide weight profiles, see loadWeightProfiles. It's called
+// at startup, so a broken SCORE_WEIGHT_PROFILES doesn't silently leave only the default profile.
+func LoadWeightProfiles() error {
+	weightProfilesOnce.Do(func() {
+		weightProfiles, errWeightProfiles = loadWeightProfiles(os.Getenv("SCORE_WEIGHT_PROFILES"))
+	})
+	return errWeightProfiles
+}
+
+// getWeightProfiles returns the profiles loaded by LoadWeightProfiles.
+func getWeightProfiles() map[string]weightProfile {
+	if err := LoadWeightProfiles(); err != nil {
+		// The server doesn't start when this fails, so it can't be serving.
+		log.Fatal(err)
+	}
+	return weightProfiles
+}
+
+// loadWeightProfiles returns the default profile, plus the profiles configured in the YAML file
+// at path, if set. Configured profiles override the default weights of the checks they list,
+// e.g. to ignore a check or to weigh it more:
+//
+//	strict:
+//	  Vulnerabilities: 10
+//	  CII-Best-Practices: 0
+func loadWeightProfiles(path string) (map[string]weightProfile, error) {
+	profiles := map[string]weightProfile{defaultWeightProfile: defaultWeights}
+	if path == "" {
+		return profiles, nil
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("os.ReadFile: %w", err)
+	}
+	var overrides map[string]weightProfile
+	if err := yaml.Unmarshal(b, &overrides); err != nil {
+		return nil, fmt.Errorf("yaml.Unmarshal: %w", err)
+	}
+	for name, override := range overrides {
+		if name == defaultWeightProfile {
+			return nil, fmt.Errorf("profile %q can't be redefined", defaultWeightProfile)
+		}
+		profile := weightProfile{}
+		for check, weight := range defaultWeights {
+			profile[check] = weight
+		}
+		for check, weight := range override {
+			if weight < 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+				return nil, fmt.Errorf("profile %q, check %q: %w", name, check, errInvalidWeight)
+			}
+			profile[check] = weight
+		}
+		profiles[name] = profile
+	}
+	return profiles, nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+func Test_weighScore(t *testing.T) {
+	t.Parallel()
+	result := func(checks map[string]int64) *models.ScorecardResult {
+		ret := &models.ScorecardResult{Date: "2024-01-01", Score: 5, Repo: &models.Repo{Commit: "abc"}}
+		for name, score := range checks {
+			ret.Checks = append(ret.Checks, &models.ScorecardCheck{Name: name, Score: score})
+		}
+		return ret
+	}
+	tests := []struct {
+		profile weightProfile
+		checks  map[string]int64
+		name    string
+		want    float64
+	}{
+		{
+			name:    "risk weights",
+			profile: defaultWeights,
+			// (10*10 + 7.5*4 + 2.5*0) / 20 = 6.5
+			checks: map[string]int64{"Dangerous-Workflow": 10, "Code-Review": 4, "License": 0},
+			want:   6.5,
+		},
+		{
+			name:    "rounded to one decimal",
+			profile: defaultWeights,
+			// (7.5*10 + 5*3) / 12.5 = 7.2
+			checks: map[string]int64{"Maintained": 10, "Fuzzing": 3},
+			want:   7.2,
+		},
+		{
+			name:    "inconclusive and unweighted checks don't count",
+			profile: defaultWeights,
+			checks:  map[string]int64{"Maintained": 8, "Fuzzing": -1, "Experimental-Check": 0},
+			want:    8,
+		},
+		{
+			name:    "nothing counts",
+			profile: defaultWeights,
+			checks:  map[string]int64{"Fuzzing": -1},
+			want:    -1,
+		},
+		{
+			name:    "custom weights",
+			profile: weightProfile{"Maintained": 1, "Fuzzing": 3},
+			checks:  map[string]int64{"Maintained": 10, "Fuzzing": 2},
+			want:    4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := weighScore(result(tt.checks), "test", tt.profile)
+			if got.Score != tt.want {
+				t.Errorf("Score = %v, want %v", got.Score, tt.want)
+			}
+			if got.PublishedScore != 5 || got.Profile != "test" || got.Commit != "abc" || len(got.Checks) != len(tt.checks) {
+				t.Errorf("unexpected result metadata: %+v", got)
+			}
+		})
+	}
+}
+
+func Test_loadWeightProfiles(t *testing.T) {
+	t.Parallel()
+	write := func(t *testing.T, content string) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "profiles.yaml")
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("os.WriteFile: %v", err)
+		}
+		return path
+	}
+
+	t.Run("no file", func(t *testing.T) {
+		t.Parallel()
+		got, err := loadWeightProfiles("")
+		if err != nil {
+			t.Fatalf("loadWeightProfiles: %v", err)
+		}
+		if diff := cmp.Diff(map[string]weightProfile{"default": defaultWeights}, got); diff != "" {
+			t.Errorf("profiles mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("overrides", func(t *testing.T) {
+		t.Parallel()
+		got, err := loadWeightProfiles(write(t, "strict:\n  Vulnerabilities: 10\n  License: 0\n  Custom: 1\n"))
+		if err != nil {
+			t.Fatalf("loadWeightProfiles: %v", err)
+		}
+		strict := got["strict"]
+		for check, want := range map[string]float64{
+			"Vulnerabilities": 10, "License": 0, "Custom": 1, "Dangerous-Workflow": criticalRisk,
+		} {
+			if strict[check] != want {
+				t.Errorf("strict[%q] = %v, want %v", check, strict[check], want)
+			}
+		}
+		if defaultWeights["Vulnerabilities"] != highRisk {
+			t.Errorf("default weights were modified")
+		}
+	})
+
+	for _, tt := range []struct {
+		wantErr error
+		name    string
+		content string
+	}{
+		{name: "negative weight", content: "strict:\n  License: -1\n", wantErr: errInvalidWeight},
+		{name: "infinite weight", content: "strict:\n  License: .inf\n", wantErr: errInvalidWeight},
+		{name: "redefined default", content: "default:\n  License: 1\n"},
+		{name: "malformed", content: "strict: ["},
+		{name: "non-numeric weight", content: "strict:\n  License: high\n"},
+		{name: "missing file"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			path := filepath.Join(t.TempDir(), "missing.yaml")
+			if tt.content != "" {
+				path = write(t, tt.content)
+			}
+			if _, err := loadWeightProfiles(path); err == nil {
+				t.Error("loadWeightProfiles() expected error")
+			} else if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("loadWeightProfiles() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
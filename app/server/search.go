@@ -0,0 +1,140 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+	"github.com/ossf/scorecard-webapp/app/generated/restapi/operations/results"
+	"github.com/ossf/scorecard-webapp/app/server/internal/index"
+	"github.com/ossf/scorecard-webapp/app/server/internal/override"
+)
+
+const (
+	// Search results aren't purged on publish, so they're cached for less time than results.
+	searchTTL          = "max-age=3600" // 1 hour
+	defaultSearchLimit = 100
+)
+
+func SearchResultsHandler(params results.SearchResultsParams) middleware.Responder {
+	ctx := requestContext(params.HTTPRequest)
+	query, err := newSearchQuery(params)
+	if err != nil {
+		return results.NewSearchResultsBadRequest().
+			WithSurrogateControl(searchTTL).
+			WithCacheControl(browserCacheTTL).
+			WithPayload(&models.Error{Code: http.StatusBadRequest, Message: err.Error()})
+	}
+
+	var ret *models.SearchResults
+	idx, err := getSearchIndex(ctx)
+	if err == nil {
+		ret, err = searchResults(ctx, idx, getOverrides(), query)
+	}
+	if err == nil {
+		return results.NewSearchResultsOK().WithPayload(ret).
+			WithSurrogateControl(searchTTL).
+			WithCacheControl(browserCacheTTL)
+	}
+	if errors.Is(err, index.ErrTooManyEntries) {
+		return results.NewSearchResultsBadRequest().
+			WithSurrogateControl(searchTTL).
+			WithCacheControl(browserCacheTTL).
+			WithPayload(&models.Error{Code: http.StatusBadRequest, Message: index.ErrTooManyEntries.Error()})
+	}
+
+	return results.NewSearchResultsDefault(http.StatusInternalServerError).WithPayload(&models.Error{
+		Code:    http.StatusInternalServerError,
+		Message: err.Error(),
+	})
+}
+
+func newSearchQuery(params results.SearchResultsParams) (*index.Query, error) {
+	query := &index.Query{
+		MinScore: params.MinScore,
+		MaxScore: params.MaxScore,
+		Limit:    defaultSearchLimit,
+	}
+	for _, p := range []struct {
+		dst *string
+		src *string
+	}{
+		{&query.Platform, params.Platform},
+		{&query.Org, params.Org},
+		{&query.Check, params.Check},
+		{&query.ScorecardVersion, params.ScorecardVersion},
+	} {
+		if p.src != nil {
+			*p.dst = strings.TrimSpace(*p.src)
+		}
+	}
+	if strings.ContainsAny(query.Platform+query.Org, "/\r\n") {
+		return nil, fmt.Errorf("%w: platform and org can't contain slashes", errInvalidInputs)
+	}
+	if params.UpdatedSince != nil && *params.UpdatedSince != "" {
+		since, err := index.ParseDate(*params.UpdatedSince)
+		if err != nil {
+			return nil, fmt.Errorf("%w: updatedSince: %v", errInvalidInputs, err)
+		}
+		query.UpdatedSince = since
+	}
+	if params.Limit != nil {
+		query.Limit = int(*params.Limit)
+	}
+	if params.Offset != nil {
+		query.Offset = int(*params.Offset)
+	}
+	if params.Sort != nil {
+		query.Descending = strings.HasPrefix(*params.Sort, "-")
+		query.Sort = index.Sort(strings.TrimPrefix(*params.Sort, "-"))
+	}
+	if err := query.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidInputs, err)
+	}
+	return query, nil
+}
+
+// searchResults runs the query against the index. Taken down results are left out of the page
+// rather than the index, so pages stay aligned on offsets but can have fewer than limit results.
+func searchResults(ctx context.Context, idx index.Index, store *override.Store, query *index.Query,
+) (*models.SearchResults, error) {
+	entries, more, err := idx.Search(ctx, *query)
+	if err != nil {
+		return nil, fmt.Errorf("index.Search: %w", err)
+	}
+	ret := &models.SearchResults{Results: []*models.RepoSummary{}, Truncated: more}
+	for _, e := range entries {
+		o, err := store.Resolve(ctx, e.Platform, e.Org, e.Repo, e.Commit)
+		if err != nil {
+			return nil, fmt.Errorf("resolving override: %w", err)
+		}
+		if !o.Tombstoned {
+			ret.Results = append(ret.Results, summarizeEntry(e))
+		}
+	}
+	return ret, nil
+}
+
+func sortCheckScores(checks []*models.CheckScore) {
+	sort.Slice(checks, func(i, j int) bool { return checks[i].Name < checks[j].Name })
+}
@@ -0,0 +1,277 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gocloud.dev/blob"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+	"github.com/ossf/scorecard-webapp/app/server/internal/index"
+)
+
+const (
+	// IndexSourceAction marks entries published by the Scorecard action.
+	IndexSourceAction = index.SourceAction
+	// IndexSourceCron marks entries backfilled from the weekly cron scan.
+	IndexSourceCron = index.SourceCron
+
+	backfillConcurrency = 32
+
+	// localSearchIndexURL is the search index used for local development.
+	localSearchIndexURL = "sqlite://scorecard-index.db"
+)
+
+var (
+	errNoSearchIndex      = errors.New("SEARCH_INDEX_URL isn't set")
+	errResultsBucketIndex = errors.New("the search index can't be kept in a results bucket")
+)
+
+var searchIndex = &lazyIndex{open: func(ctx context.Context) (index.Index, error) {
+	indexURL, err := searchIndexURL(os.Getenv)
+	if err != nil {
+		return nil, err
+	}
+	return OpenSearchIndex(ctx, indexURL)
+}}
+
+// lazyIndex opens an index on first use, and shares it from then on. Failing to open it isn't
+// remembered, so the next use tries again, e.g. once the database is reachable.
+type lazyIndex struct {
+	idx  index.Index
+	open func(context.Context) (index.Index, error)
+	mu   sync.Mutex
+}
+
+func (l *lazyIndex) get(ctx context.Context) (index.Index, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.idx != nil {
+		return l.idx, nil
+	}
+	idx, err := l.open(ctx)
+	if err != nil {
+		return nil, err
+	}
+	l.idx = idx
+	return idx, nil
+}
+
+// summarizeEntry converts the entry into the API representation, with checks sorted by name
+// to keep responses stable.
+func summarizeEntry(e *index.Entry) *models.RepoSummary {
+	ret := &models.RepoSummary{
+		Name:             e.Name(),
+		Date:             e.Date,
+		Score:            e.Score,
+		ScorecardVersion: e.ScorecardVersion,
+	}
+	for name, score := range e.Checks {
+		ret.Checks = append(ret.Checks, &models.CheckScore{Name: name, Score: score})
+	}
+	sortCheckScores(ret.Checks)
+	return ret
+}
+
+func newIndexEntry(host, orgName, repoName, source string, data []byte) (*index.Entry, error) {
+	var result models.ScorecardResult
+	if err := result.UnmarshalBinary(data); err != nil {
+		return nil, fmt.Errorf("result.UnmarshalBinary: %w", err)
+	}
+	entry := &index.Entry{
+		Platform: host,
+		Org:      orgName,
+		Repo:     repoName,
+		Date:     result.Date,
+		Score:    result.Score,
+		Source:   source,
+		Updated:  time.Now().UTC(),
+		Checks:   map[string]int64{},
+	}
+	if result.Repo != nil {
+		entry.Commit = result.Repo.Commit
+	}
+	if result.Scorecard != nil {
+		entry.ScorecardVersion = result.Scorecard.Version
+	}
+	for _, check := range result.Checks {
+		if check != nil {
+			entry.Checks[check.Name] = check.Score
+		}
+	}
+	return entry, nil
+}
+
+// CheckSearchIndex checks the search index is configured. It's called at startup, as the
+// search and organization listings are served from the index. The index itself is only opened
+// on first use, so the server starts while the database is unreachable.
+func CheckSearchIndex() error {
+	_, err := searchIndexURL(os.Getenv)
+	return err
+}
+
+// searchIndexURL is where the search index is kept, SEARCH_INDEX_URL: a postgres:// or
+// sqlite:// URL, or the URL of a bucket other than the results buckets. It's required, except
+// for local development (STORAGE_EMULATOR_HOST is set), which uses a SQLite file by default.
+func searchIndexURL(getenv func(string) string) (string, error) {
+	indexURL := getenv("SEARCH_INDEX_URL")
+	switch {
+	case indexURL != "":
+		return indexURL, checkIndexURL(indexURL)
+	case getenv("STORAGE_EMULATOR_HOST") != "":
+		return localSearchIndexURL, nil
+	default:
+		return "", errNoSearchIndex
+	}
+}
+
+// checkIndexURL keeps the index's objects out of the results buckets, whose keys are results.
+func checkIndexURL(indexURL string) error {
+	base, _, _ := strings.Cut(indexURL, "?")
+	base = strings.TrimSuffix(base, "/")
+	for _, s := range resultSources {
+		if base == s.bucketURL {
+			return fmt.Errorf("%w: %s", errResultsBucketIndex, indexURL)
+		}
+	}
+	return nil
+}
+
+// getSearchIndex opens the search index on first use. It's shared by every request.
+func getSearchIndex(ctx context.Context) (index.Index, error) {
+	return searchIndex.get(ctx)
+}
+
+// OpenSearchIndex opens the search index at the given URL, see index.Open.
+func OpenSearchIndex(ctx context.Context, indexURL string) (index.Index, error) {
+	if err := checkIndexURL(indexURL); err != nil {
+		return nil, err
+	}
+	idx, err := index.Open(ctx, indexURL)
+	if err != nil {
+		return nil, fmt.Errorf("index.Open: %w", err)
+	}
+	return idx, nil
+}
+
+// updateSearchIndex records a freshly published result, and how it was verified, in the search index
+// opened by openIndex. The index is secondary to the results themselves, so failures are only logged.
+func updateSearchIndex(ctx context.Context, openIndex func(context.Context) (index.Index, error),
+	host, orgName, repoName string,
+	scorecardResult *models.VerifiedScorecardResult, info certInfo,
+) {
+	entry, err := newIndexEntry(host, orgName, repoName, IndexSourceAction, []byte(scorecardResult.Result))
+	if err != nil {
+		log.Printf("error indexing result for %s/%s/%s: %v", host, orgName, repoName, err)
+		return
+	}
+	// The certificate's commit is the one verified, whatever the result claims.
+	entry.Commit = info.repoSHA
+	entry.Provenance = &index.Provenance{
+		Branch:       scorecardResult.Branch,
+		WorkflowPath: info.workflowPath,
+		WorkflowRef:  info.workflowRef,
+		TlogIndex:    scorecardResult.TlogIndex,
+	}
+
+	idx, err := openIndex(ctx)
+	if err != nil {
+		log.Printf("error opening search index: %v", err)
+		return
+	}
+	if _, err := idx.Put(ctx, entry); err != nil {
+		log.Printf("error indexing result for %s: %v", entry.Name(), err)
+	}
+}
+
+// BackfillIndex indexes the latest result of every repository under prefix in the results bucket,
+// e.g. to index the weekly cron results, which aren't published through the API.
+// Entries of newer results are left alone, as are entries published by the Scorecard action at
+// the same time, like when serving. It returns the number of entries written.
+func BackfillIndex(ctx context.Context, results *blob.Bucket, idx index.Index, prefix, source string) (int, error) {
+	var (
+		written  atomic.Int64
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	sem := make(chan struct{}, backfillConcurrency)
+	iter := results.List(&blob.ListOptions{Prefix: prefix})
+	for {
+		obj, err := iter.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return int(written.Load()), fmt.Errorf("bucket.List: %w", err)
+		}
+		// Only the latest results, host/org/repo/results.json, not the per-commit ones.
+		parts := strings.Split(obj.Key, "/")
+		if len(parts) != 4 || parts[3] != resultsFile {
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			ok, err := backfillEntry(ctx, results, idx, obj.Key, parts[0], parts[1], parts[2], source)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = fmt.Errorf("%s: %w", obj.Key, err)
+				}
+				mu.Unlock()
+				return
+			}
+			if ok {
+				written.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	return int(written.Load()), firstErr
+}
+
+func backfillEntry(ctx context.Context, results *blob.Bucket, idx index.Index,
+	key, host, orgName, repoName, source string,
+) (bool, error) {
+	data, err := results.ReadAll(ctx, key)
+	if err != nil {
+		return false, fmt.Errorf("bucket.ReadAll: %w", err)
+	}
+	entry, err := newIndexEntry(host, orgName, repoName, source, data)
+	if err != nil {
+		// Skip malformed results rather than failing the whole backfill.
+		log.Printf("skipping %s: %v", key, err)
+		return false, nil
+	}
+	ok, err := idx.Put(ctx, entry)
+	if err != nil {
+		return false, fmt.Errorf("index.Put: %w", err)
+	}
+	return ok, nil
+}
@@ -0,0 +1,357 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"gocloud.dev/blob/memblob"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+	"github.com/ossf/scorecard-webapp/app/generated/restapi/operations/results"
+	"github.com/ossf/scorecard-webapp/app/server/internal/index"
+	"github.com/ossf/scorecard-webapp/app/server/internal/override"
+)
+
+func testIndexEntries() []*index.Entry {
+	return []*index.Entry{
+		{
+			Platform: "github.com", Org: "ossf", Repo: "scorecard", Date: "2024-03-01",
+			Score: 8.2, ScorecardVersion: "v5.0.0", Source: IndexSourceAction,
+			Checks: map[string]int64{"Branch-Protection": 8, "Dangerous-Workflow": 10},
+		},
+		{
+			Platform: "github.com", Org: "OSSF", Repo: "scorecard-webapp", Date: "2024-01-01T12:00:00Z",
+			Score: 6.5, ScorecardVersion: "v4.13.1", Source: IndexSourceCron,
+			Checks: map[string]int64{"Branch-Protection": 3, "Dangerous-Workflow": 0},
+		},
+		{
+			Platform: "github.com", Org: "other", Repo: "repo", Date: "2023-06-01",
+			Score: 2, ScorecardVersion: "v5.1.0", Source: IndexSourceCron,
+			Checks: map[string]int64{"Branch-Protection": -1, "Dangerous-Workflow": 0},
+		},
+	}
+}
+
+func openTestIndex(t *testing.T) index.Index {
+	t.Helper()
+	idx, err := index.OpenSQLite(context.Background(), ":memory:")
+	if err != nil {
+		t.Fatalf("index.OpenSQLite: %v", err)
+	}
+	t.Cleanup(func() { idx.Close() })
+	return idx
+}
+
+func Test_searchResults(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	idx := openTestIndex(t)
+	for _, e := range testIndexEntries() {
+		if _, err := idx.Put(ctx, e); err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+	}
+
+	tests := []struct {
+		params        results.SearchResultsParams
+		name          string
+		want          []string
+		wantTruncated bool
+	}{
+		{
+			name: "no filters",
+			want: []string{"github.com/ossf/scorecard", "github.com/OSSF/scorecard-webapp", "github.com/other/repo"},
+		},
+		{
+			name:   "check below threshold skips inconclusive",
+			params: results.SearchResultsParams{Check: swag.String("Branch-Protection"), MaxScore: swag.Float64(3)},
+			want:   []string{"github.com/OSSF/scorecard-webapp"},
+		},
+		{
+			name:   "check below 10",
+			params: results.SearchResultsParams{Check: swag.String("Dangerous-Workflow"), MaxScore: swag.Float64(9)},
+			want:   []string{"github.com/OSSF/scorecard-webapp", "github.com/other/repo"},
+		},
+		{
+			name:   "aggregate score range",
+			params: results.SearchResultsParams{MinScore: swag.Float64(6), MaxScore: swag.Float64(7)},
+			want:   []string{"github.com/OSSF/scorecard-webapp"},
+		},
+		{
+			name:   "org is case-insensitive",
+			params: results.SearchResultsParams{Platform: swag.String("github.com"), Org: swag.String("ossf")},
+			want:   []string{"github.com/ossf/scorecard", "github.com/OSSF/scorecard-webapp"},
+		},
+		{
+			name:   "scorecard version wildcard",
+			params: results.SearchResultsParams{ScorecardVersion: swag.String("v5.*")},
+			want:   []string{"github.com/ossf/scorecard", "github.com/other/repo"},
+		},
+		{
+			name:   "updated since",
+			params: results.SearchResultsParams{UpdatedSince: swag.String("2024-01-01")},
+			want:   []string{"github.com/ossf/scorecard", "github.com/OSSF/scorecard-webapp"},
+		},
+		{
+			name:   "highest score first",
+			params: results.SearchResultsParams{Sort: swag.String("-score")},
+			want:   []string{"github.com/ossf/scorecard", "github.com/OSSF/scorecard-webapp", "github.com/other/repo"},
+		},
+		{
+			name:   "oldest first",
+			params: results.SearchResultsParams{Sort: swag.String("date")},
+			want:   []string{"github.com/other/repo", "github.com/OSSF/scorecard-webapp", "github.com/ossf/scorecard"},
+		},
+		{
+			name:          "limit",
+			params:        results.SearchResultsParams{Limit: swag.Int64(1)},
+			want:          []string{"github.com/ossf/scorecard"},
+			wantTruncated: true,
+		},
+		{
+			name:          "second page",
+			params:        results.SearchResultsParams{Limit: swag.Int64(1), Offset: swag.Int64(1)},
+			want:          []string{"github.com/OSSF/scorecard-webapp"},
+			wantTruncated: true,
+		},
+		{
+			name:   "last page",
+			params: results.SearchResultsParams{Limit: swag.Int64(2), Offset: swag.Int64(2)},
+			want:   []string{"github.com/other/repo"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			query, err := newSearchQuery(tt.params)
+			if err != nil {
+				t.Fatalf("newSearchQuery: %v", err)
+			}
+			got, err := searchResults(ctx, idx, override.NewStore(nil), query)
+			if err != nil {
+				t.Fatalf("searchResults: %v", err)
+			}
+			var names []string
+			for _, r := range got.Results {
+				names = append(names, r.Name)
+			}
+			if diff := cmp.Diff(tt.want, names); diff != "" {
+				t.Errorf("searchResults() mismatch (-want +got):\n%s", diff)
+			}
+			if got.Truncated != tt.wantTruncated {
+				t.Errorf("Truncated = %v, want %v", got.Truncated, tt.wantTruncated)
+			}
+		})
+	}
+}
+
+func Test_searchResults_tombstoned(t *testing.T) {
+	t.Parallel()
+	const commit = "0123456789abcdef0123456789abcdef01234567"
+	ctx := context.Background()
+	idx := openTestIndex(t)
+	entries := testIndexEntries()
+	entries[2].Commit = commit
+	for _, e := range entries {
+		if _, err := idx.Put(ctx, e); err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+	}
+	store := override.NewStore(nil)
+	for _, c := range []override.Change{
+		{Host: "github.com", Org: "ossf", Repo: "scorecard"},
+		// The commit of other/repo's latest result.
+		{Host: "github.com", Org: "other", Repo: "repo", Commit: commit},
+		// Not the commit of scorecard-webapp's latest result.
+		{Host: "github.com", Org: "ossf", Repo: "scorecard-webapp", Commit: commit},
+	} {
+		c.Action, c.Reason = override.ActionTombstone, "takedown"
+		if _, err := store.Apply(ctx, c); err != nil {
+			t.Fatalf("Apply: %v", err)
+		}
+	}
+
+	query, err := newSearchQuery(results.SearchResultsParams{Limit: swag.Int64(2)})
+	if err != nil {
+		t.Fatalf("newSearchQuery: %v", err)
+	}
+	got, err := searchResults(ctx, idx, store, query)
+	if err != nil {
+		t.Fatalf("searchResults: %v", err)
+	}
+	var names []string
+	for _, r := range got.Results {
+		names = append(names, r.Name)
+	}
+	if diff := cmp.Diff([]string{"github.com/OSSF/scorecard-webapp"}, names); diff != "" {
+		t.Errorf("searchResults() mismatch (-want +got):\n%s", diff)
+	}
+	if !got.Truncated {
+		t.Error("Truncated = false, want the next page to be left")
+	}
+}
+
+func Test_newSearchQuery_invalid(t *testing.T) {
+	t.Parallel()
+	tests := []results.SearchResultsParams{
+		{UpdatedSince: swag.String("last week")},
+		{ScorecardVersion: swag.String("v5.[")},
+		{Org: swag.String("ossf/scorecard")},
+		{Sort: swag.String("stars")},
+	}
+	for _, params := range tests {
+		if _, err := newSearchQuery(params); err == nil {
+			t.Errorf("newSearchQuery(%+v) expected error", params)
+		}
+	}
+}
+
+func Test_lazyIndex(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	errUnreachable := errors.New("database unreachable")
+	var opens int
+	l := &lazyIndex{open: func(context.Context) (index.Index, error) {
+		opens++
+		if opens == 1 {
+			return nil, errUnreachable
+		}
+		return index.NewBucketIndex(memblob.OpenBucket(nil)), nil
+	}}
+
+	if _, err := l.get(ctx); !errors.Is(err, errUnreachable) {
+		t.Fatalf("get() = %v, want %v", err, errUnreachable)
+	}
+	first, err := l.get(ctx)
+	if err != nil {
+		t.Fatalf("get() after a failure: %v", err)
+	}
+	second, err := l.get(ctx)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if first != second || opens != 2 {
+		t.Errorf("opened the index %d times, want it opened once it succeeded", opens)
+	}
+}
+
+func TestBackfillIndex(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	cron := memblob.OpenBucket(nil)
+	defer cron.Close()
+	idx := openTestIndex(t)
+
+	result := &models.ScorecardResult{
+		Date:      "2024-01-01",
+		Score:     5,
+		Scorecard: &models.ScorecardVersion{Version: "v5.0.0"},
+		Checks:    []*models.ScorecardCheck{{Name: "License", Score: 10}},
+	}
+	writeTestResult(t, cron, "github.com/org/cron-only/results.json", result)
+	writeTestResult(t, cron, "github.com/org/cron-only/abc/results.json", result)
+	writeTestResult(t, cron, "github.com/org/published/results.json", result)
+	writeTestResult(t, cron, "github.com/org/stale/results.json", result)
+	published := &index.Entry{
+		Platform: "github.com", Org: "org", Repo: "published", Date: "2024-01-01", Source: IndexSourceAction, Score: 9,
+	}
+	stale := &index.Entry{
+		Platform: "github.com", Org: "org", Repo: "stale", Date: "2023-06-01", Source: IndexSourceAction, Score: 9,
+	}
+	for _, e := range []*index.Entry{published, stale} {
+		if _, err := idx.Put(ctx, e); err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+	}
+
+	n, err := BackfillIndex(ctx, cron, idx, "github.com/", IndexSourceCron)
+	if err != nil {
+		t.Fatalf("BackfillIndex: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("BackfillIndex() = %d, want 2", n)
+	}
+
+	got, _, err := idx.Search(ctx, index.Query{Org: "org"})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	want := []*index.Entry{
+		{
+			Platform: "github.com", Org: "org", Repo: "cron-only", Date: "2024-01-01", Score: 5,
+			ScorecardVersion: "v5.0.0", Source: IndexSourceCron, Checks: map[string]int64{"License": 10},
+		},
+		// The entry published by the action on the same date is kept, the older one isn't.
+		published,
+		{
+			Platform: "github.com", Org: "org", Repo: "stale", Date: "2024-01-01", Score: 5,
+			ScorecardVersion: "v5.0.0", Source: IndexSourceCron, Checks: map[string]int64{"License": 10},
+		},
+	}
+	if diff := cmp.Diff(want, got, ignoreUpdated, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("entries mismatch (-want +got):\n%s", diff)
+	}
+}
+
+var ignoreUpdated = cmp.FilterPath(func(p cmp.Path) bool {
+	return p.Last().String() == ".Updated"
+}, cmp.Ignore())
+
+func Test_searchIndexURL(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		env     map[string]string
+		name    string
+		want    string
+		wantErr error
+	}{
+		{name: "unset", wantErr: errNoSearchIndex},
+		{
+			name: "local development",
+			env:  map[string]string{"STORAGE_EMULATOR_HOST": "localhost:4443"},
+			want: localSearchIndexURL,
+		},
+		{name: "postgres", env: map[string]string{"SEARCH_INDEX_URL": "postgres://db/index"}, want: "postgres://db/index"},
+		{name: "index bucket", env: map[string]string{"SEARCH_INDEX_URL": "gs://index"}, want: "gs://index"},
+		{
+			name:    "results bucket",
+			env:     map[string]string{"SEARCH_INDEX_URL": scorecardResultBucketURL + "/"},
+			wantErr: errResultsBucketIndex,
+		},
+		{
+			name:    "cron results bucket",
+			env:     map[string]string{"SEARCH_INDEX_URL": scorecardCronResultBucketURL + "?prefix=_index/"},
+			wantErr: errResultsBucketIndex,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := searchIndexURL(func(name string) string { return tt.env[name] })
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("searchIndexURL() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("searchIndexURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v65/github"
+
+	"github.com/ossf/scorecard-webapp/app/server/internal/cdn"
+	"github.com/ossf/scorecard-webapp/app/server/internal/index"
+	"github.com/ossf/scorecard-webapp/app/server/internal/rekor"
+	"github.com/ossf/scorecard-webapp/app/server/internal/upstream"
+	"github.com/ossf/scorecard-webapp/app/server/internal/witness"
+)
+
+// upstreamDefaults overrides upstream.DefaultOptions for some upstreams.
+var upstreamDefaults = map[string]upstream.Options{
+	// The purge queue already retries failed purges with backoff.
+	"fastly":     withRetries(upstream.DefaultOptions, 0),
+	"cloudflare": withRetries(upstream.DefaultOptions, 0),
+	"varnish":    withRetries(upstream.DefaultOptions, 0),
+}
+
+func withRetries(opts upstream.Options, retries int) upstream.Options {
+	opts.Retries = retries
+	return opts
+}
+
+// upstreamOptions returns the options of the named upstream, overridden by the environment
+// variables <NAME>_TIMEOUT (e.g. 5s), <NAME>_RETRIES, <NAME>_RETRY_DELAY, <NAME>_MAX_RETRY_DELAY,
+// <NAME>_BREAKER_THRESHOLD and <NAME>_BREAKER_COOLDOWN, e.g. REKOR_TIMEOUT.
+// Invalid settings are logged and ignored.
+func upstreamOptions(getenv func(string) string, name string) upstream.Options {
+	opts, ok := upstreamDefaults[name]
+	if !ok {
+		opts = upstream.DefaultOptions
+	}
+	prefix := strings.ToUpper(name) + "_"
+	durations := map[string]*time.Duration{
+		"TIMEOUT":          &opts.Timeout,
+		"RETRY_DELAY":      &opts.BaseDelay,
+		"MAX_RETRY_DELAY":  &opts.MaxDelay,
+		"BREAKER_COOLDOWN": &opts.BreakerCooldown,
+	}
+	for key, dst := range durations {
+		if v := getenv(prefix + key); v != "" {
+			if d, err := time.ParseDuration(v); err != nil || d < 0 {
+				log.Printf("ignoring invalid %s %q", prefix+key, v)
+			} else {
+				*dst = d
+			}
+		}
+	}
+	counts := map[string]*int{"RETRIES": &opts.Retries, "BREAKER_THRESHOLD": &opts.BreakerThreshold}
+	for key, dst := range counts {
+		if v := getenv(prefix + key); v != "" {
+			if n, err := strconv.Atoi(v); err != nil || n < 0 {
+				log.Printf("ignoring invalid %s %q", prefix+key, v)
+			} else {
+				*dst = n
+			}
+		}
+	}
+	return opts
+}
+
+// newUpstreamClient creates an http.Client for the named upstream, configured by upstreamOptions.
+func newUpstreamClient(getenv func(string) string, name string) *http.Client {
+	return upstream.NewClient(name, upstreamOptions(getenv, name))
+}
+
+var (
+	githubUpstreamOnce sync.Once
+	githubUpstream     http.RoundTripper
+)
+
+// getGitHubUpstream returns the process wide transport for GitHub API calls, so they share
+// a circuit breaker.
+func getGitHubUpstream() http.RoundTripper {
+	githubUpstreamOnce.Do(func() {
+		githubUpstream = upstream.NewTransport("github", nil, upstreamOptions(os.Getenv, "github"))
+	})
+	return githubUpstream
+}
+
+// upstreams are the services a publish request calls, and what it trusts their answers with.
+// What they share between requests carries no credentials: clients authenticated with a request's
+// credentials are built for that request, see githubClient, and never stored.
+type upstreams struct {
+	rekor   rekor.Client
+	witness *witness.Witness
+	github  http.RoundTripper
+	purges  *cdn.Queue
+	// searchIndex returns the index published results are recorded in.
+	searchIndex func(context.Context) (index.Index, error)
+	// resultsBucket is the URL of the bucket results are published to.
+	resultsBucket string
+	// rekorKey is the PEM encoded key the Rekor instance signs with.
+	rekorKey []byte
+	fulcio   fulcioTrust
+}
+
+// getUpstreams returns the process wide upstreams.
+func getUpstreams() upstreams {
+	return upstreams{
+		rekor:         getRekor(),
+		witness:       getWitness(),
+		github:        getGitHubUpstream(),
+		purges:        getPurgeQueue(),
+		searchIndex:   getSearchIndex,
+		resultsBucket: resultsBucket,
+		rekorKey:      getRekorPublicKey(),
+		fulcio:        getFulcio(),
+	}
+}
+
+// githubClient creates a GitHub client for a single request, authenticated with token if set.
+func (u upstreams) githubClient(token string) *github.Client {
+	transport := u.github
+	if token != "" {
+		transport = githubTransport{base: transport, token: token}
+	}
+	return github.NewClient(&http.Client{Transport: transport})
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard-webapp/app/server/internal/upstream"
+)
+
+func Test_upstreamOptions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		env      map[string]string
+		name     string
+		upstream string
+		want     upstream.Options
+	}{
+		{name: "default", upstream: "rekor", want: upstream.DefaultOptions},
+		{name: "purges aren't retried", upstream: "fastly", want: withRetries(upstream.DefaultOptions, 0)},
+		{
+			name:     "overridden",
+			upstream: "github",
+			env: map[string]string{
+				"GITHUB_TIMEOUT": "3s", "GITHUB_RETRIES": "4", "GITHUB_RETRY_DELAY": "100ms",
+				"GITHUB_MAX_RETRY_DELAY": "1s", "GITHUB_BREAKER_THRESHOLD": "0", "GITHUB_BREAKER_COOLDOWN": "1m",
+				"REKOR_TIMEOUT": "1s",
+			},
+			want: upstream.Options{
+				Timeout: 3 * time.Second, Retries: 4, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second,
+				BreakerCooldown: time.Minute,
+			},
+		},
+		{
+			name:     "invalid settings",
+			upstream: "rekor",
+			env:      map[string]string{"REKOR_TIMEOUT": "soon", "REKOR_RETRIES": "-1", "REKOR_BREAKER_COOLDOWN": "-1s"},
+			want:     upstream.DefaultOptions,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := upstreamOptions(func(name string) string { return tt.env[name] }, tt.upstream)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("upstreamOptions() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	oaerrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+	"github.com/ossf/scorecard-webapp/app/server/internal/index"
+)
+
+// resultField is the request body field holding the published result.
+const resultField = "result"
+
+// Results published as a signed in-toto attestation are the predicate of a statement.
+const (
+	intotoStatementV01     = "https://in-toto.io/Statement/v0.1"
+	intotoStatementV1      = "https://in-toto.io/Statement/v1"
+	scorecardPredicateType = "https://scorecard.dev/result/v0.1"
+)
+
+var errInvalidResult = errors.New("invalid scorecard result")
+
+// resultValidationError lists every invalid field of a published result.
+type resultValidationError struct {
+	fields []*models.FieldError
+}
+
+func (e *resultValidationError) Error() string {
+	msgs := make([]string, len(e.fields))
+	for i, f := range e.fields {
+		msgs[i] = f.Field + ": " + f.Message
+	}
+	return fmt.Sprintf("%v: %s", errInvalidResult, strings.Join(msgs, "; "))
+}
+
+func (e *resultValidationError) Unwrap() error {
+	return errInvalidResult
+}
+
+func (e *resultValidationError) add(field, format string, args ...any) {
+	if field == "" {
+		field = resultField
+	} else {
+		field = resultField + "." + field
+	}
+	e.fields = append(e.fields, &models.FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// addSchemaErrors flattens the errors returned by the generated models' Validate.
+func (e *resultValidationError) addSchemaErrors(err error) {
+	var composite *oaerrors.CompositeError
+	if errors.As(err, &composite) {
+		for _, err := range composite.Errors {
+			e.addSchemaErrors(err)
+		}
+		return
+	}
+	var validation *oaerrors.Validation
+	if errors.As(err, &validation) {
+		e.add(validation.Name, "%s", validation.Error())
+		return
+	}
+	e.add("", "%v", err)
+}
+
+// unwrapResult returns the result published in data: the predicate if data is an in-toto statement,
+// as signed in the DSSE envelope of an attestation, or else data itself.
+func unwrapResult(data []byte) ([]byte, error) {
+	var statement struct {
+		Type          string          `json:"_type"`
+		PredicateType string          `json:"predicateType"`
+		Predicate     json.RawMessage `json:"predicate"`
+	}
+	// Anything but a statement is validated as a result.
+	if err := json.Unmarshal(data, &statement); err != nil ||
+		(statement.Type != intotoStatementV01 && statement.Type != intotoStatementV1) {
+		return data, nil
+	}
+	verr := &resultValidationError{}
+	switch {
+	case statement.PredicateType != scorecardPredicateType:
+		verr.add("predicateType", "expected %q, got %q", scorecardPredicateType, statement.PredicateType)
+	case len(statement.Predicate) == 0:
+		verr.add("predicate", "is required")
+	default:
+		return statement.Predicate, nil
+	}
+	return nil, verr
+}
+
+// validateResult checks the published result is a well-formed ScorecardResult of host/org/repo
+// at the commit the certificate was issued for, so it can't be published under another project.
+// It returns a *resultValidationError listing every problem found.
+func validateResult(data []byte, host, org, repo, sha string) error {
+	verr := &resultValidationError{}
+	var result models.ScorecardResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			verr.add(typeErr.Field, "expected %s, got %s", typeErr.Type, typeErr.Value)
+		} else {
+			verr.add("", "invalid JSON: %v", err)
+		}
+		return verr
+	}
+	if err := result.Validate(strfmt.Default); err != nil {
+		verr.addSchemaErrors(err)
+	}
+
+	if _, err := index.ParseDate(result.Date); err != nil {
+		verr.add("date", "expected YYYY-MM-DD or RFC 3339, got %q", result.Date)
+	}
+	wantName := fmt.Sprintf("%s/%s/%s", host, org, repo)
+	switch {
+	case result.Repo == nil:
+		verr.add("repo", "is required")
+	default:
+		// Repository names are case-insensitive, and so are hex digests.
+		if !strings.EqualFold(result.Repo.Name, wantName) {
+			verr.add("repo.name", "expected %q, got %q", wantName, result.Repo.Name)
+		}
+		if !strings.EqualFold(result.Repo.Commit, sha) {
+			verr.add("repo.commit", "expected the certificate's commit %q, got %q", sha, result.Repo.Commit)
+		}
+	}
+	seen := map[string]bool{}
+	for i, check := range result.Checks {
+		field := fmt.Sprintf("checks.%d", i)
+		switch {
+		case check == nil:
+			verr.add(field, "is null")
+		case check.Name == "":
+			verr.add(field+".name", "is required")
+		case seen[check.Name]:
+			verr.add(field+".name", "duplicate check %q", check.Name)
+		default:
+			seen[check.Name] = true
+		}
+	}
+	for i, finding := range result.Findings {
+		if finding == nil || finding.Probe == "" {
+			verr.add(fmt.Sprintf("findings.%d.probe", i), "is required")
+		}
+	}
+
+	if len(verr.fields) > 0 {
+		return verr
+	}
+	return nil
+}
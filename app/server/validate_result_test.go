@@ -0,0 +1,206 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_validateResult(t *testing.T) {
+	t.Parallel()
+	const (
+		sha   = "a9711caf8ecbe3c035c6bbe3f3bdab8ccc78093b"
+		valid = `{"date":"2022-06-29","repo":{"name":"github.com/ossf-tests/scorecard-action","commit":"` + sha + `"},` +
+			`"score":5.8,"checks":[{"name":"Binary-Artifacts","score":10},{"name":"Fuzzing","score":-1}]}`
+	)
+	payload, err := os.ReadFile("testdata/results/valid-payload.json")
+	if err != nil {
+		t.Fatalf("os.ReadFile: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		data       string
+		org        string
+		repo       string
+		sha        string
+		wantFields []string
+	}{
+		{
+			name: "valid",
+			data: valid,
+		},
+		{
+			name: "published by the action",
+			data: string(payload),
+		},
+		{
+			name: "names are case-insensitive",
+			data: valid,
+			org:  "OSSF-Tests",
+			sha:  strings.ToUpper(sha),
+		},
+		{
+			name:       "not JSON",
+			data:       "results",
+			wantFields: []string{"result"},
+		},
+		{
+			name:       "wrong type",
+			data:       `{"score":"10"}`,
+			wantFields: []string{"result.score"},
+		},
+		{
+			name:       "other repository",
+			data:       valid,
+			repo:       "scorecard",
+			wantFields: []string{"result.repo.name"},
+		},
+		{
+			name:       "other commit",
+			data:       valid,
+			sha:        "70d045b9ef00e7171ce3950aca38eef6ea4d7308",
+			wantFields: []string{"result.repo.commit"},
+		},
+		{
+			name:       "missing repo and date",
+			data:       `{"score":5,"checks":[]}`,
+			wantFields: []string{"result.date", "result.repo"},
+		},
+		{
+			name: "scores out of range",
+			data: strings.NewReplacer(`"score":5.8`, `"score":11`, `"score":-1`, `"score":-2`).Replace(valid),
+			wantFields: []string{
+				"result.score", "result.checks.1.score",
+			},
+		},
+		{
+			name: "malformed commit and checks",
+			data: `{"date":"2022-06-29","repo":{"name":"github.com/ossf-tests/scorecard-action","commit":"main"},` +
+				`"score":5,"checks":[{"name":"License","score":1},{"name":"License","score":1},{"score":1},null]}`,
+			wantFields: []string{
+				"result.repo.commit", "result.repo.commit",
+				"result.checks.1.name", "result.checks.2.name", "result.checks.3",
+			},
+		},
+		{
+			name: "probe findings",
+			data: `{"date":"2024-06-01","repo":{"name":"github.com/ossf-tests/scorecard-action","commit":"` + sha + `"},` +
+				`"findings":[{"probe":"hasLicenseFile","outcome":"True"},{"probe":"","outcome":"Positive"}]}`,
+			wantFields: []string{"result.findings.1.outcome", "result.findings.1.probe"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			org, repo, wantSHA := "ossf-tests", "scorecard-action", sha
+			if tt.org != "" {
+				org = tt.org
+			}
+			if tt.repo != "" {
+				repo = tt.repo
+			}
+			if tt.sha != "" {
+				wantSHA = tt.sha
+			}
+			err := validateResult([]byte(tt.data), "github.com", org, repo, wantSHA)
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Fatalf("validateResult() = %v", err)
+				}
+				return
+			}
+			var verr *resultValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("validateResult() = %v, want *resultValidationError", err)
+			}
+			if !errors.Is(err, errInvalidResult) {
+				t.Errorf("errors.Is(%v, errInvalidResult) = false", err)
+			}
+			var fields []string
+			for _, f := range verr.fields {
+				fields = append(fields, f.Field)
+			}
+			if diff := cmp.Diff(tt.wantFields, fields); diff != "" {
+				t.Errorf("fields mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_unwrapResult(t *testing.T) {
+	t.Parallel()
+	const result = `{"date":"2022-06-29","score":5.8}`
+	tests := []struct {
+		name       string
+		data       string
+		want       string
+		wantFields []string
+	}{
+		{name: "result", data: result, want: result},
+		{name: "invalid JSON is left to validateResult", data: "{", want: "{"},
+		{
+			name: "statement v1",
+			data: `{"_type":"https://in-toto.io/Statement/v1","subject":[],` +
+				`"predicateType":"https://scorecard.dev/result/v0.1","predicate":` + result + `}`,
+			want: result,
+		},
+		{
+			name: "statement v0.1",
+			data: `{"_type":"https://in-toto.io/Statement/v0.1",` +
+				`"predicateType":"https://scorecard.dev/result/v0.1","predicate":` + result + `}`,
+			want: result,
+		},
+		{
+			name: "other predicate",
+			data: `{"_type":"https://in-toto.io/Statement/v1",` +
+				`"predicateType":"https://slsa.dev/provenance/v1","predicate":` + result + `}`,
+			wantFields: []string{"result.predicateType"},
+		},
+		{
+			name:       "no predicate",
+			data:       `{"_type":"https://in-toto.io/Statement/v1","predicateType":"https://scorecard.dev/result/v0.1"}`,
+			wantFields: []string{"result.predicate"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := unwrapResult([]byte(tt.data))
+			var verr *resultValidationError
+			if tt.wantFields == nil {
+				if err != nil || string(got) != tt.want {
+					t.Errorf("unwrapResult() = %s, %v, want %s", got, err, tt.want)
+				}
+				return
+			}
+			if !errors.As(err, &verr) {
+				t.Fatalf("unwrapResult() = %v, want a resultValidationError", err)
+			}
+			var fields []string
+			for _, f := range verr.fields {
+				fields = append(fields, f.Field)
+			}
+			if diff := cmp.Diff(tt.wantFields, fields); diff != "" {
+				t.Errorf("fields mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ossf/scorecard-webapp/app/server/internal/rekor"
+)
+
+// Steps of the publish verification, in the order they're run.
+const (
+	StepTlogEntry      = "tlog entry"
+	StepPayloadMatch   = "payload matches entry"
+	StepInclusionProof = "inclusion proof"
+	StepCertificate    = "certificate"
+	StepSignature      = "signature"
+	StepCertClaims     = "certificate claims"
+	StepRequest        = "request matches certificate"
+	StepResult         = "result content"
+	StepWorkflow       = "workflow"
+)
+
+const githubHost = "github.com"
+
+const (
+	StepPassed  = "pass"
+	StepFailed  = "fail"
+	StepSkipped = "skip"
+)
+
+var errPreviousStep = errors.New("an earlier step failed")
+
+// OfflinePublish holds the inputs of a publish request, as saved for later verification.
+type OfflinePublish struct {
+	// Repo and Branch are the repository (e.g. github.com/org/repo) and branch the results were
+	// published for. The request step is skipped if they're empty.
+	Repo   string
+	Branch string
+	// Result is the results file signed by the Scorecard action.
+	Result []byte
+	// RekorEntry is Rekor's response for the entry, mapping its UUID to the entry.
+	RekorEntry []byte
+	// Workflow is the Scorecard workflow file which produced the results.
+	Workflow []byte
+}
+
+// VerificationStep is the outcome of one step of the publish verification.
+type VerificationStep struct {
+	Err    error
+	Name   string
+	Status string
+	// Note explains what the step checked, or couldn't check.
+	Note string
+}
+
+// VerifyPublishOffline runs the checks a publish request goes through without network access,
+// and reports the outcome of every step. Steps which don't depend on a failed step still run,
+// so every problem with the request shows up at once.
+// The workflow's pinned actions can't be checked against GitHub, so imposter commits aren't
+// detected offline.
+func VerifyPublishOffline(req *OfflinePublish) []VerificationStep {
+	var steps []VerificationStep
+	report := func(name string, err error, note string) bool {
+		step := VerificationStep{Name: name, Status: StepPassed, Err: err, Note: note}
+		switch {
+		case errors.Is(err, errPreviousStep):
+			step.Status = StepSkipped
+		case err != nil:
+			step.Status = StepFailed
+		}
+		steps = append(steps, step)
+		return err == nil
+	}
+
+	uuid, entry, err := rekor.ParseEntry(bytes.NewReader(req.RekorEntry))
+	var cert *x509.Certificate
+	if err == nil {
+		report(StepTlogEntry, nil, fmt.Sprintf("log index %d, UUID %s", entry.LogIndex, uuid))
+		cert = verifyOfflineEntry(req.Result, uuid, (*tlogEntry)(entry), report)
+	} else {
+		report(StepTlogEntry, err, "")
+		report(StepPayloadMatch, errPreviousStep, "")
+		report(StepInclusionProof, errPreviousStep, "")
+		report(StepCertificate, errPreviousStep, "")
+		report(StepSignature, errPreviousStep, "")
+	}
+
+	var info certInfo
+	err, note := errPreviousStep, ""
+	if cert != nil {
+		if info, err = extractCertInfo(cert); err == nil {
+			note = fmt.Sprintf("repository %s at %s, workflow %s", info.repoFullName, info.repoSHA, info.workflowPath)
+		}
+	}
+	claimsOK := report(StepCertClaims, err, note)
+
+	switch {
+	case req.Repo == "":
+		steps = append(steps, VerificationStep{Name: StepRequest, Status: StepSkipped, Note: "no repository given"})
+	case !claimsOK:
+		report(StepRequest, errPreviousStep, "")
+	default:
+		report(StepRequest, verifyOfflineRequest(req.Repo, req.Branch, info), "")
+	}
+
+	if claimsOK {
+		org, repo, _ := splitRepoName(info.repoFullName)
+		result, err := unwrapResult(req.Result)
+		if err == nil {
+			err = validateResult(result, githubHost, org, repo, info.repoSHA)
+		}
+		report(StepResult, err, "")
+	} else {
+		report(StepResult, errPreviousStep, "")
+	}
+
+	report(StepWorkflow, verifyScorecardWorkflow(string(req.Workflow), offlineVerifier{}),
+		"pinned action commits aren't checked offline")
+	return steps
+}
+
+// verifyOfflineEntry runs the checks of extractAndVerifyCertForPayload on a saved entry, and
+// returns its certificate if it could be extracted.
+func verifyOfflineEntry(payload []byte, uuid string, entry *tlogEntry,
+	report func(name string, err error, note string) bool,
+) *x509.Certificate {
+	rekordBody, err := entry.rekord()
+	if err != nil {
+		report(StepPayloadMatch, err, "")
+	} else if !rekordBody.Matches(payload) {
+		report(StepPayloadMatch, errMismatchedTlogEntry, "")
+	} else {
+		report(StepPayloadMatch, nil, "")
+	}
+	if checkpoint, err := verifyInclusionProof(getRekorPublicKey(), uuid, entry); err != nil {
+		report(StepInclusionProof, err, "")
+	} else {
+		report(StepInclusionProof, nil, fmt.Sprintf("checkpoint of %s at tree size %d", checkpoint.Origin, checkpoint.Size))
+	}
+
+	if err != nil {
+		report(StepCertificate, errPreviousStep, "")
+		report(StepSignature, errPreviousStep, "")
+		return nil
+	}
+	certs, err := rekordBody.Certs()
+	if err != nil || len(certs) == 0 {
+		report(StepCertificate, fmt.Errorf("error extracting certificate from entry: %w", err), "")
+		report(StepSignature, errPreviousStep, "")
+		return nil
+	}
+	integratedTime := time.Unix(entry.IntegratedTime, 0).UTC()
+	note := fmt.Sprintf("logged at %s", integratedTime.Format(time.RFC3339))
+	if len(certs) > 1 {
+		note += fmt.Sprintf(", with %d intermediates", len(certs)-1)
+	}
+	report(StepCertificate, getFulcio().verify(certs, integratedTime), note)
+	report(StepSignature, rekordBody.VerifySignature(payload, certs[0].PublicKey), "")
+	return certs[0]
+}
+
+func verifyOfflineRequest(repo, branch string, info certInfo) error {
+	host, name, _ := strings.Cut(repo, "/")
+	if host != githubHost || !strings.EqualFold(name, info.repoFullName) {
+		return verificationError{e: fmt.Errorf("%w: certificate is for %s/%s",
+			errMismatchedCertAndRequest, githubHost, info.repoFullName)}
+	}
+	if branch != "" && info.repoBranchRef != branch && info.repoBranchRef != "refs/heads/"+branch {
+		return verificationError{e: fmt.Errorf("%w: certificate is for %s",
+			errMismatchedCertAndRequest, info.repoBranchRef)}
+	}
+	return nil
+}
+
+// offlineVerifier accepts every commit, since checking them needs GitHub.
+type offlineVerifier struct{}
+
+func (offlineVerifier) contains(commit) (bool, error) {
+	return true, nil
+}
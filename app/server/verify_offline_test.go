@@ -0,0 +1,115 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard-webapp/app/server/internal/hashedrekord"
+)
+
+func TestVerifyPublishOffline(t *testing.T) {
+	t.Parallel()
+	read := func(path string) []byte {
+		t.Helper()
+		b, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("os.ReadFile: %v", err)
+		}
+		return b
+	}
+	result := read("testdata/results/valid-payload.json")
+	entry := read("testdata/rekor/log-entries-response.json")
+	workflow := read("testdata/workflow-valid.yml")
+	blob := read("internal/hashedrekord/testdata/uploaded-blob.md")
+	// The saved entry's certificate was issued for a user's email rather than a workflow, so its
+	// claims are rejected, like in Test_extractCertInfo.
+	tests := []struct {
+		wantErrs map[string]error
+		req      OfflinePublish
+		name     string
+		want     []string
+	}{
+		{
+			name: "saved entry",
+			req:  OfflinePublish{Result: result, RekorEntry: entry, Workflow: workflow},
+			want: []string{
+				StepPassed, StepFailed, StepPassed, StepPassed, StepFailed, StepFailed, StepSkipped, StepSkipped, StepPassed,
+			},
+			wantErrs: map[string]error{
+				StepPayloadMatch: errMismatchedTlogEntry, StepSignature: hashedrekord.ErrInvalidSignature,
+				StepCertClaims: errNotOIDC,
+			},
+		},
+		{
+			name: "blob signed by the saved entry",
+			req:  OfflinePublish{Result: blob, RekorEntry: entry, Workflow: workflow},
+			want: []string{
+				StepPassed, StepPassed, StepPassed, StepPassed, StepPassed, StepFailed, StepSkipped, StepSkipped, StepPassed,
+			},
+			wantErrs: map[string]error{StepCertClaims: errNotOIDC},
+		},
+		{
+			name: "malformed entry",
+			req:  OfflinePublish{Result: result, RekorEntry: []byte("{}"), Workflow: workflow, Repo: "github.com/org/repo"},
+			want: []string{
+				StepFailed, StepSkipped, StepSkipped, StepSkipped, StepSkipped, StepSkipped, StepSkipped, StepSkipped,
+				StepPassed,
+			},
+			wantErrs: map[string]error{StepTlogEntry: errNoTlogEntry},
+		},
+		{
+			name: "tampered inclusion proof and invalid workflow",
+			req: OfflinePublish{
+				Result:     result,
+				RekorEntry: []byte(strings.Replace(string(entry), `"treeSize": `, `"treeSize": 1`, 1)),
+				Workflow:   read("testdata/workflow-invalid-global-env.yml"),
+			},
+			want: []string{
+				StepPassed, StepFailed, StepFailed, StepPassed, StepFailed, StepFailed, StepSkipped, StepSkipped, StepFailed,
+			},
+			wantErrs: map[string]error{StepWorkflow: errGlobalVarsOrDefaults},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			steps := VerifyPublishOffline(&tt.req)
+			var names, statuses []string
+			for _, step := range steps {
+				names = append(names, step.Name)
+				statuses = append(statuses, step.Status)
+				if want, ok := tt.wantErrs[step.Name]; ok && !errors.Is(step.Err, want) {
+					t.Errorf("step %q: error = %v, want %v", step.Name, step.Err, want)
+				}
+			}
+			wantNames := []string{
+				StepTlogEntry, StepPayloadMatch, StepInclusionProof, StepCertificate, StepSignature,
+				StepCertClaims, StepRequest, StepResult, StepWorkflow,
+			}
+			if diff := cmp.Diff(wantNames, names); diff != "" {
+				t.Errorf("steps mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.want, statuses); diff != "" {
+				t.Errorf("statuses mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
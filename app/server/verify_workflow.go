@@ -15,11 +15,13 @@
 package server
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
 	"strings"
 
+	"github.com/google/go-github/v65/github"
 	"github.com/rhysd/actionlint"
 )
 
@@ -87,6 +89,12 @@ func (i imposterCommitError) Error() string {
 	return fmt.Sprintf("imposter commit: %s does not belong to %s", i.ref, i.action)
 }
 
+// VerifyWorkflow checks a Scorecard workflow against the restrictions results are published
+// with, using client to check that pinned actions' commits belong to their repositories.
+func VerifyWorkflow(ctx context.Context, workflowContent string, client *github.Client) error {
+	return verifyScorecardWorkflow(workflowContent, newGitHubVerifier(ctx, client))
+}
+
 func verifyScorecardWorkflow(workflowContent string, verifier commitVerifier) error {
 	// Verify workflow contents using actionlint.
 	workflow, lintErrs := actionlint.Parse([]byte(workflowContent))
@@ -0,0 +1,73 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command scorecard-index-backfill adds stored results to the search index.
+// Results published through the API are indexed as they're published, so this is mainly
+// for the weekly cron results, e.g. as a step after each scan:
+//
+//	scorecard-index-backfill --results gs://ossf-scorecard-cron-results --index postgres://index.example/scorecard
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	flag "github.com/spf13/pflag"
+	"gocloud.dev/blob"
+	_ "gocloud.dev/blob/fileblob" // Needed for local buckets.
+	_ "gocloud.dev/blob/gcsblob"  // Needed to link in GCP drivers.
+
+	"github.com/ossf/scorecard-webapp/app/server"
+)
+
+func main() {
+	resultsURL := flag.String("results", "gs://ossf-scorecard-cron-results", "bucket URL of the results to index")
+	indexURL := flag.String("index", "",
+		"URL of the search index: postgres://, sqlite:// or a bucket URL, as SEARCH_INDEX_URL (required)")
+	prefix := flag.String("prefix", "", "only index results under this prefix, e.g. github.com/ossf/")
+	source := flag.String("source", server.IndexSourceCron,
+		fmt.Sprintf("source recorded for the entries, %q or %q", server.IndexSourceCron, server.IndexSourceAction))
+	flag.Parse()
+
+	if *indexURL == "" {
+		fmt.Fprintln(os.Stderr, "--index is required")
+		os.Exit(2)
+	}
+	if *source != server.IndexSourceCron && *source != server.IndexSourceAction {
+		fmt.Fprintf(os.Stderr, "invalid --source %q\n", *source)
+		os.Exit(2)
+	}
+
+	n, err := backfill(context.Background(), *resultsURL, *indexURL, *prefix, *source)
+	log.Printf("indexed %d results", n)
+	if err != nil {
+		log.Fatalf("backfill failed: %v", err)
+	}
+}
+
+func backfill(ctx context.Context, resultsURL, indexURL, prefix, source string) (int, error) {
+	results, err := blob.OpenBucket(ctx, resultsURL)
+	if err != nil {
+		return 0, fmt.Errorf("opening results bucket: %w", err)
+	}
+	defer results.Close()
+	idx, err := server.OpenSearchIndex(ctx, indexURL)
+	if err != nil {
+		return 0, fmt.Errorf("opening search index: %w", err)
+	}
+	defer idx.Close()
+	return server.BackfillIndex(ctx, results, idx, prefix, source)
+}
@@ -0,0 +1,387 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+
+	"github.com/google/go-github/v65/github"
+	flag "github.com/spf13/pflag"
+
+	"github.com/ossf/scorecard-webapp/app/generated/client/results"
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+	"github.com/ossf/scorecard-webapp/app/server"
+)
+
+const githubPlatform = "github.com"
+
+var (
+	errNoResult           = errors.New("no result found")
+	errWorkflowNotAllowed = errors.New("workflow can't publish results")
+	errVerificationFailed = errors.New("publish verification failed")
+)
+
+var getCommand = &command{
+	usage: "[flags] <platform/org/repo>",
+	short: "Print a repository's published result",
+	setup: func(fs *flag.FlagSet) runFunc {
+		commit := fs.String("commit", "", "commit the result was published for, instead of the latest")
+		source := fs.String("source", "", "only return results from this source: action or cron")
+		return func(ctx context.Context, c *cli, args []string) error {
+			return runGet(ctx, c, args, *commit, *source)
+		}
+	},
+}
+
+var badgeURLCommand = &command{
+	usage: "[flags] <platform/org/repo>",
+	short: "Print a repository's badge URL and Markdown",
+	setup: func(fs *flag.FlagSet) runFunc {
+		style := fs.String("style", "", "badge style, e.g. flat-square")
+		return func(_ context.Context, c *cli, args []string) error {
+			return runBadgeURL(c, args, *style)
+		}
+	},
+}
+
+var publishCommand = &command{
+	usage: "[flags] <platform/org/repo> <results.json>",
+	short: "Publish a results file signed by the Scorecard action",
+	setup: func(fs *flag.FlagSet) runFunc {
+		var publish models.VerifiedScorecardResult
+		fs.StringVar(&publish.Branch, "branch", "", "branch the results were computed on, e.g. main (required)")
+		fs.Int64Var(&publish.TlogIndex, "tlog-index", 0, "Rekor log index of the signed results (required)")
+		fs.StringVar(&publish.AccessToken, "access-token", os.Getenv("GITHUB_TOKEN"),
+			"GitHub token the server reads the workflow with, defaults to $GITHUB_TOKEN")
+		return func(ctx context.Context, c *cli, args []string) error {
+			return runPublish(ctx, c, args, &publish)
+		}
+	},
+}
+
+var historyCommand = &command{
+	usage: "[flags] <github.com/org/repo>",
+	short: "List the results published for a branch's recent commits",
+	setup: func(fs *flag.FlagSet) runFunc {
+		branch := fs.String("branch", "", "branch to list the commits of, defaults to the default branch")
+		limit := fs.Int("limit", 20, "number of commits to look up, at most 100")
+		return func(ctx context.Context, c *cli, args []string) error {
+			return runHistory(ctx, c, args, *branch, *limit)
+		}
+	},
+}
+
+var verifyPublishCommand = &command{
+	usage: "[flags] --rekor-entry <entry.json> --workflow <workflow.yml> <results.json>",
+	short: "Check a publish request step by step, without network access",
+	setup: func(fs *flag.FlagSet) runFunc {
+		var files publishFiles
+		fs.StringVar(&files.rekorEntry, "rekor-entry", "", "Rekor's log entry response for the results (required)")
+		fs.StringVar(&files.workflow, "workflow", "", "Scorecard workflow which produced the results (required)")
+		fs.StringVar(&files.repo, "repo", "", "repository the results were published for, e.g. github.com/org/repo")
+		fs.StringVar(&files.branch, "branch", "", "branch the results were published for")
+		return func(_ context.Context, c *cli, args []string) error {
+			return runVerifyPublish(c, args, &files)
+		}
+	},
+}
+
+var verifyWorkflowCommand = &command{
+	usage: "<workflow.yml>",
+	short: "Check a Scorecard workflow is allowed to publish results",
+	setup: func(*flag.FlagSet) runFunc {
+		return runVerifyWorkflow
+	},
+}
+
+func runGet(ctx context.Context, c *cli, args []string, commit, source string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%w: get takes one project", errUsage)
+	}
+	platform, org, repo, err := parseProject(args[0])
+	if err != nil {
+		return err
+	}
+	params := results.NewGetResultParamsWithContext(ctx).
+		WithPlatform(platform).WithOrg(org).WithRepo(repo)
+	if commit != "" {
+		params.SetCommit(&commit)
+	}
+	if source != "" {
+		params.SetSource(&source)
+	}
+	resp, err := c.api.Results.GetResult(params)
+	if isNotFound(err) {
+		return fmt.Errorf("%w for %s", errNoResult, args[0])
+	}
+	if err != nil {
+		return apiError(err)
+	}
+
+	result := resp.GetPayload()
+	if c.output == outputJSON {
+		return writeJSON(c.out, result)
+	}
+	fmt.Fprintf(c.out, "Repository: %s\n", args[0])
+	if result.Repo != nil {
+		fmt.Fprintf(c.out, "Commit:     %s\n", result.Repo.Commit)
+	}
+	fmt.Fprintf(c.out, "Date:       %s\n", result.Date)
+	fmt.Fprintf(c.out, "Score:      %s\n", formatScore(result.Score))
+	if resp.XScorecardSource != "" {
+		fmt.Fprintf(c.out, "Source:     %s\n", resp.XScorecardSource)
+	}
+	fmt.Fprintln(c.out)
+	t := newTable(c.out, "CHECK", "SCORE", "REASON")
+	for _, check := range result.Checks {
+		if check != nil {
+			t.row(check.Name, formatScore(check.Score), check.Reason)
+		}
+	}
+	return t.flush()
+}
+
+type badgeURL struct {
+	URL      string `json:"url"`
+	Markdown string `json:"markdown"`
+}
+
+func runBadgeURL(c *cli, args []string, style string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%w: badge-url takes one project", errUsage)
+	}
+	platform, org, repo, err := parseProject(args[0])
+	if err != nil {
+		return err
+	}
+	badge := c.apiURL.JoinPath("projects", platform, org, repo, "badge")
+	if style != "" {
+		badge.RawQuery = url.Values{"style": {style}}.Encode()
+	}
+	viewer := fmt.Sprintf("https://scorecard.dev/viewer/?uri=%s/%s/%s", platform, org, repo)
+	ret := badgeURL{
+		URL:      badge.String(),
+		Markdown: fmt.Sprintf("[![OpenSSF Scorecard](%s)](%s)", badge, viewer),
+	}
+	if c.output == outputJSON {
+		return writeJSON(c.out, ret)
+	}
+	fmt.Fprintf(c.out, "%s\n\n%s\n", ret.URL, ret.Markdown)
+	return nil
+}
+
+func runPublish(ctx context.Context, c *cli, args []string, publish *models.VerifiedScorecardResult) error {
+	if len(args) != 2 {
+		return fmt.Errorf("%w: publish takes a project and a results file", errUsage)
+	}
+	if publish.Branch == "" || publish.TlogIndex <= 0 {
+		return fmt.Errorf("%w: --branch and --tlog-index are required", errUsage)
+	}
+	platform, org, repo, err := parseProject(args[0])
+	if err != nil {
+		return err
+	}
+	result, err := os.ReadFile(args[1])
+	if err != nil {
+		return fmt.Errorf("reading results: %w", err)
+	}
+	publish.Result = string(result)
+	params := results.NewPostResultParamsWithContext(ctx).
+		WithPlatform(platform).WithOrg(org).WithRepo(repo).
+		WithPublish(publish)
+	resp, err := c.api.Results.PostResult(params)
+	if err != nil {
+		return apiError(err)
+	}
+	if c.output == outputJSON {
+		// The API answers with a status message, not the location of the result.
+		return writeJSON(c.out, map[string]string{"message": resp.GetPayload()})
+	}
+	fmt.Fprintf(c.out, "Published results for %s: %s\n", args[0], resp.GetPayload())
+	return nil
+}
+
+type historyEntry struct {
+	Commit string  `json:"commit"`
+	Date   string  `json:"date"`
+	Source string  `json:"source,omitempty"`
+	Score  float64 `json:"score"`
+}
+
+// runHistory lists the branch's recent commits on GitHub, since results are stored by commit,
+// and looks up the result of each.
+func runHistory(ctx context.Context, c *cli, args []string, branch string, limit int) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%w: history takes one project", errUsage)
+	}
+	platform, org, repo, err := parseProject(args[0])
+	if err != nil {
+		return err
+	}
+	if platform != githubPlatform {
+		return fmt.Errorf("%w: history only supports %s projects", errUsage, githubPlatform)
+	}
+	if limit < 1 || limit > 100 {
+		return fmt.Errorf("%w: --limit must be between 1 and 100", errUsage)
+	}
+	commits, _, err := c.github.Repositories.ListCommits(ctx, org, repo, &github.CommitsListOptions{
+		SHA:         branch,
+		ListOptions: github.ListOptions{PerPage: limit},
+	})
+	if err != nil {
+		return fmt.Errorf("listing commits: %w", err)
+	}
+
+	entries := []historyEntry{}
+	for _, commit := range commits {
+		sha := commit.GetSHA()
+		resp, err := c.api.Results.GetResult(results.NewGetResultParamsWithContext(ctx).
+			WithPlatform(platform).WithOrg(org).WithRepo(repo).WithCommit(&sha))
+		if isNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("getting the result of %s: %w", sha, apiError(err))
+		}
+		entries = append(entries, historyEntry{
+			Commit: sha,
+			Date:   resp.GetPayload().Date,
+			Score:  resp.GetPayload().Score,
+			Source: resp.XScorecardSource,
+		})
+	}
+
+	if c.output == outputJSON {
+		return writeJSON(c.out, entries)
+	}
+	if len(entries) == 0 {
+		return fmt.Errorf("%w for the last %d commits of %s", errNoResult, len(commits), args[0])
+	}
+	t := newTable(c.out, "DATE", "COMMIT", "SCORE", "SOURCE")
+	for _, e := range entries {
+		t.row(e.Date, e.Commit, formatScore(e.Score), e.Source)
+	}
+	return t.flush()
+}
+
+type workflowVerification struct {
+	Error   string `json:"error,omitempty"`
+	File    string `json:"file"`
+	Allowed bool   `json:"allowed"`
+}
+
+func runVerifyWorkflow(ctx context.Context, c *cli, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%w: verify-workflow takes one workflow file", errUsage)
+	}
+	var content []byte
+	var err error
+	if args[0] == "-" {
+		content, err = io.ReadAll(os.Stdin)
+	} else {
+		content, err = os.ReadFile(args[0])
+	}
+	if err != nil {
+		return fmt.Errorf("reading workflow: %w", err)
+	}
+
+	ret := workflowVerification{File: args[0], Allowed: true}
+	verr := server.VerifyWorkflow(ctx, string(content), c.github)
+	if verr != nil {
+		ret.Allowed, ret.Error = false, verr.Error()
+	}
+	if c.output == outputJSON {
+		if err := writeJSON(c.out, ret); err != nil {
+			return err
+		}
+	} else if ret.Allowed {
+		fmt.Fprintf(c.out, "%s can publish results\n", ret.File)
+	}
+	if verr != nil {
+		return fmt.Errorf("%w: %w", errWorkflowNotAllowed, verr)
+	}
+	return nil
+}
+
+type publishFiles struct {
+	rekorEntry, workflow string
+	repo, branch         string
+}
+
+type verificationStep struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+	Note   string `json:"note,omitempty"`
+}
+
+// runVerifyPublish reproduces the server's verification of a publish request from saved files,
+// e.g. a user's results file and its Rekor entry fetched with
+// curl https://rekor.sigstore.dev/api/v1/log/entries?logIndex=<tlog index>.
+func runVerifyPublish(c *cli, args []string, files *publishFiles) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%w: verify-publish takes one results file", errUsage)
+	}
+	if files.rekorEntry == "" || files.workflow == "" {
+		return fmt.Errorf("%w: --rekor-entry and --workflow are required", errUsage)
+	}
+	req := &server.OfflinePublish{Repo: files.repo, Branch: files.branch}
+	for path, dst := range map[string]*[]byte{
+		args[0]: &req.Result, files.rekorEntry: &req.RekorEntry, files.workflow: &req.Workflow,
+	} {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", path, err)
+		}
+		*dst = b
+	}
+
+	var steps []verificationStep
+	failed := false
+	for _, step := range server.VerifyPublishOffline(req) {
+		s := verificationStep{Name: step.Name, Status: step.Status, Note: step.Note}
+		if step.Status == server.StepFailed {
+			s.Error, failed = step.Err.Error(), true
+		}
+		steps = append(steps, s)
+	}
+
+	if c.output == outputJSON {
+		if err := writeJSON(c.out, steps); err != nil {
+			return err
+		}
+	} else {
+		t := newTable(c.out, "STEP", "STATUS", "DETAILS")
+		for _, s := range steps {
+			details := s.Note
+			if s.Error != "" {
+				details = s.Error
+			}
+			t.row(s.Name, s.Status, details)
+		}
+		if err := t.flush(); err != nil {
+			return err
+		}
+	}
+	if failed {
+		return errVerificationFailed
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command scorecard-webapp-cli queries and publishes Scorecard results through the API,
+// e.g.:
+//
+//	scorecard-webapp-cli get github.com/ossf/scorecard
+//	scorecard-webapp-cli history --output json github.com/ossf/scorecard
+//	scorecard-webapp-cli publish --tlog-index 12345 --branch main github.com/org/repo results.json
+//	scorecard-webapp-cli verify-publish --rekor-entry entry.json --workflow scorecard.yml results.json
+//
+// The API defaults to $SCORECARD_API_URL, or https://api.scorecard.dev if unset.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v65/github"
+	flag "github.com/spf13/pflag"
+
+	"github.com/ossf/scorecard-webapp/app/generated/client"
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+const (
+	defaultAPIURL = "https://api.scorecard.dev"
+
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+var errUsage = errors.New("invalid usage")
+
+// runFunc runs a subcommand with the arguments left after parsing its flags.
+type runFunc func(ctx context.Context, c *cli, args []string) error
+
+type command struct {
+	// setup registers the command's flags and returns the function running it.
+	setup func(fs *flag.FlagSet) runFunc
+	usage string
+	short string
+}
+
+var commands = map[string]*command{
+	"get":             getCommand,
+	"badge-url":       badgeURLCommand,
+	"publish":         publishCommand,
+	"history":         historyCommand,
+	"verify-publish":  verifyPublishCommand,
+	"verify-workflow": verifyWorkflowCommand,
+}
+
+// cli holds the clients and settings shared by the subcommands.
+type cli struct {
+	api    *client.OpenSSFScorecardAPI
+	github *github.Client
+	out    io.Writer
+	apiURL *url.URL
+	output string
+}
+
+func main() {
+	if err := run(context.Background(), os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		if errors.Is(err, errUsage) {
+			os.Exit(2)
+		}
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, out io.Writer) error {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "--help" || args[0] == "help" {
+		printUsage(out)
+		return nil
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		printUsage(os.Stderr)
+		return fmt.Errorf("%w: unknown command %q", errUsage, args[0])
+	}
+
+	fs := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	apiURL := fs.String("api-url", envOr("SCORECARD_API_URL", defaultAPIURL), "base URL of the Scorecard API")
+	output := fs.StringP("output", "o", outputTable, "output format: table or json")
+	runCmd := cmd.setup(fs)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage:\n  scorecard-webapp-cli %s %s\n\n%s\n\n%s",
+			args[0], cmd.usage, cmd.short, fs.FlagUsages())
+	}
+	if err := fs.Parse(args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return fmt.Errorf("%w: %v", errUsage, err)
+	}
+	if *output != outputTable && *output != outputJSON {
+		return fmt.Errorf("%w: unknown output format %q", errUsage, *output)
+	}
+
+	c, err := newCLI(*apiURL, *output, out)
+	if err != nil {
+		return err
+	}
+	return runCmd(ctx, c, fs.Args())
+}
+
+func newCLI(apiURL, output string, out io.Writer) (*cli, error) {
+	u, err := url.Parse(apiURL)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, fmt.Errorf("%w: invalid API URL %q", errUsage, apiURL)
+	}
+	basePath := u.Path
+	if basePath == "" {
+		basePath = client.DefaultBasePath
+	}
+	api := client.NewHTTPClientWithConfig(nil, client.DefaultTransportConfig().
+		WithHost(u.Host).
+		WithBasePath(basePath).
+		WithSchemes([]string{u.Scheme}))
+
+	gh := github.NewClient(nil)
+	if token := os.Getenv("GITHUB_TOKEN"); token != "" {
+		gh = gh.WithAuthToken(token)
+	}
+	return &cli{api: api, github: gh, out: out, apiURL: u, output: output}, nil
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprint(w, "Usage:\n  scorecard-webapp-cli <command> [flags]\n\nCommands:\n")
+	for _, name := range sortedCommands() {
+		fmt.Fprintf(w, "  %-16s %s\n", name, commands[name].short)
+	}
+	fmt.Fprint(w, "\nRun \"scorecard-webapp-cli <command> --help\" for the command's flags.\n")
+}
+
+func sortedCommands() []string {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parseProject splits a project name like github.com/org/repo.
+func parseProject(name string) (platform, org, repo string, err error) {
+	parts := strings.Split(strings.TrimSuffix(name, "/"), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("%w: expected a project like github.com/org/repo, got %q", errUsage, name)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func isNotFound(err error) bool {
+	var resp interface{ IsCode(code int) bool }
+	return errors.As(err, &resp) && resp.IsCode(http.StatusNotFound)
+}
+
+// apiError adds the server's error message and invalid fields, if any, to err.
+func apiError(err error) error {
+	var resp interface{ GetPayload() *models.Error }
+	if !errors.As(err, &resp) || resp.GetPayload() == nil || resp.GetPayload().Message == "" {
+		return err
+	}
+	payload := resp.GetPayload()
+	msg := payload.Message
+	for _, f := range payload.Fields {
+		msg += fmt.Sprintf("\n  %s: %s", f.Field, f.Message)
+	}
+	return fmt.Errorf("%w: %s", err, msg)
+}
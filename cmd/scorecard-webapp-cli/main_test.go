@@ -0,0 +1,289 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v65/github"
+
+	"github.com/ossf/scorecard-webapp/app/generated/models"
+)
+
+const testResult = `{"date":"2024-05-01","repo":{"name":"github.com/org/repo","commit":"%s"},` +
+	`"score":7.5,"checks":[{"name":"License","score":10,"reason":"license file detected"},` +
+	`{"name":"Fuzzing","score":-1,"reason":"internal error"}]}`
+
+// newTestAPI serves the results of the given commits, "" being the latest one.
+func newTestAPI(t *testing.T, commits ...string) *httptest.Server {
+	t.Helper()
+	known := map[string]bool{}
+	for _, c := range commits {
+		known[c] = true
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /projects/github.com/org/repo", func(w http.ResponseWriter, r *http.Request) {
+		commit := r.URL.Query().Get("commit")
+		if !known[commit] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if commit == "" {
+			commit = "latest"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Scorecard-Source", "cron")
+		fmt.Fprintf(w, testResult, commit)
+	})
+	mux.HandleFunc("POST /projects/github.com/org/repo", func(w http.ResponseWriter, r *http.Request) {
+		var publish models.VerifiedScorecardResult
+		if err := json.NewDecoder(r.Body).Decode(&publish); err != nil || publish.TlogIndex != 42 ||
+			publish.Branch != "main" || publish.Result != "signed results" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"code":400,"message":"invalid request","fields":[{"field":"result","message":"is invalid"}]}`)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `"successfully verified and published ScorecardResult"`)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+	srv := newTestAPI(t, "", "abc")
+	tests := []struct {
+		wantErr error
+		name    string
+		want    []string
+		args    []string
+	}{
+		{
+			name: "table",
+			args: []string{"get", "github.com/org/repo"},
+			want: []string{
+				"Commit:     latest", "Score:      7.5", "Source:     cron",
+				"License  10     license file detected", "Fuzzing  ?      internal error",
+			},
+		},
+		{
+			name: "json",
+			args: []string{"get", "-o", "json", "--commit", "abc", "github.com/org/repo"},
+			want: []string{`"commit": "abc"`, `"score": 7.5`},
+		},
+		{
+			name:    "not found",
+			args:    []string{"get", "--commit", "def", "github.com/org/repo"},
+			wantErr: errNoResult,
+		},
+		{
+			name:    "malformed project",
+			args:    []string{"get", "org/repo"},
+			wantErr: errUsage,
+		},
+		{
+			name:    "unknown output",
+			args:    []string{"get", "-o", "xml", "github.com/org/repo"},
+			wantErr: errUsage,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var out bytes.Buffer
+			err := run(context.Background(), append(tt.args, "--api-url", srv.URL), &out)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("run() = %v, want %v", err, tt.wantErr)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("output doesn't contain %q:\n%s", want, out.String())
+				}
+			}
+		})
+	}
+}
+
+func TestBadgeURL(t *testing.T) {
+	t.Parallel()
+	var out bytes.Buffer
+	err := run(context.Background(), []string{
+		"badge-url", "-o", "json", "--style", "flat-square", "--api-url", "https://scorecard.example/api",
+		"github.com/org/repo",
+	}, &out)
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	var got badgeURL
+	if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	const badge = "https://scorecard.example/api/projects/github.com/org/repo/badge?style=flat-square"
+	want := badgeURL{
+		URL:      badge,
+		Markdown: "[![OpenSSF Scorecard](" + badge + ")](https://scorecard.dev/viewer/?uri=github.com/org/repo)",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("badge mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPublish(t *testing.T) {
+	t.Parallel()
+	srv := newTestAPI(t)
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("os.WriteFile: %v", err)
+		}
+		return path
+	}
+	valid, invalid := write("valid.json", "signed results"), write("invalid.json", "tampered results")
+
+	var out bytes.Buffer
+	args := []string{"publish", "--api-url", srv.URL, "--branch", "main", "--tlog-index", "42", "github.com/org/repo"}
+	if err := run(context.Background(), append(args, valid), &out); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if !strings.Contains(out.String(), "successfully verified and published ScorecardResult") {
+		t.Errorf("unexpected output: %s", out.String())
+	}
+	out.Reset()
+	if err := run(context.Background(), append(args, "-o", "json", valid), &out); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	var published map[string]string
+	if err := json.Unmarshal(out.Bytes(), &published); err != nil ||
+		published["message"] != "successfully verified and published ScorecardResult" {
+		t.Errorf("unexpected JSON output: %s (%v)", out.String(), err)
+	}
+
+	err := run(context.Background(), append(args, invalid), &out)
+	if err == nil || !strings.Contains(err.Error(), "result: is invalid") {
+		t.Errorf("run() = %v, want the invalid fields", err)
+	}
+	err = run(context.Background(), []string{"publish", "--api-url", srv.URL, "github.com/org/repo", valid}, &out)
+	if !errors.Is(err, errUsage) {
+		t.Errorf("run() without --tlog-index = %v, want errUsage", err)
+	}
+}
+
+func TestHistory(t *testing.T) {
+	t.Parallel()
+	api := newTestAPI(t, "aaa", "ccc")
+	gh := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/org/repo/commits" || r.URL.Query().Get("sha") != "main" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `[{"sha":"ccc"},{"sha":"bbb"},{"sha":"aaa"}]`)
+	}))
+	t.Cleanup(gh.Close)
+
+	var out bytes.Buffer
+	c, err := newCLI(api.URL, outputJSON, &out)
+	if err != nil {
+		t.Fatalf("newCLI: %v", err)
+	}
+	c.github = github.NewClient(nil)
+	if c.github.BaseURL, err = url.Parse(gh.URL + "/"); err != nil {
+		t.Fatalf("url.Parse: %v", err)
+	}
+	if err := runHistory(context.Background(), c, []string{"github.com/org/repo"}, "main", 3); err != nil {
+		t.Fatalf("runHistory: %v", err)
+	}
+	var got []historyEntry
+	if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	want := []historyEntry{
+		{Commit: "ccc", Date: "2024-05-01", Score: 7.5, Source: "cron"},
+		{Commit: "aaa", Date: "2024-05-01", Score: 7.5, Source: "cron"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("history mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := runHistory(context.Background(), c, []string{"gitlab.com/org/repo"}, "", 3); !errors.Is(err, errUsage) {
+		t.Errorf("runHistory() on gitlab = %v, want errUsage", err)
+	}
+}
+
+func TestVerifyWorkflow(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "scorecard.yml")
+	workflow := "on: push\njobs:\n  build:\n    runs-on: ubuntu-latest\n    steps:\n      - run: make\n"
+	if err := os.WriteFile(path, []byte(workflow), 0o600); err != nil {
+		t.Fatalf("os.WriteFile: %v", err)
+	}
+	var out bytes.Buffer
+	err := run(context.Background(), []string{"verify-workflow", "-o", "json", path}, &out)
+	if !errors.Is(err, errWorkflowNotAllowed) {
+		t.Fatalf("run() = %v, want errWorkflowNotAllowed", err)
+	}
+	var got workflowVerification
+	if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if got.Allowed || !strings.Contains(got.Error, "no job that calls ossf/scorecard-action") {
+		t.Errorf("unexpected verification: %+v", got)
+	}
+}
+
+func TestVerifyPublish(t *testing.T) {
+	t.Parallel()
+	const testdata = "../../app/server/testdata/"
+	var out bytes.Buffer
+	err := run(context.Background(), []string{
+		"verify-publish",
+		"--rekor-entry", testdata + "rekor/log-entries-response.json",
+		"--workflow", testdata + "workflow-valid.yml",
+		testdata + "results/valid-payload.json",
+	}, &out)
+	if !errors.Is(err, errVerificationFailed) {
+		t.Fatalf("run() = %v, want errVerificationFailed", err)
+	}
+	for _, want := range []string{
+		"inclusion proof              pass",
+		"payload matches entry        fail    tlog entry does not match payload",
+		"request matches certificate  skip    no repository given",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output doesn't contain %q:\n%s", want, out.String())
+		}
+	}
+
+	err = run(context.Background(), []string{"verify-publish", testdata + "results/valid-payload.json"}, &out)
+	if !errors.Is(err, errUsage) {
+		t.Errorf("run() without --rekor-entry = %v, want errUsage", err)
+	}
+}